@@ -32,7 +32,11 @@ func main() {
 func exampleBuildFromSeparateVars() {
 	// Example input values (e.g. from your CSMS logic)
 	status := types.Accepted
-	expiry := time.Now().Add(48 * time.Hour).UTC()
+	expiry, err := types.DateTime(time.Now().Add(48 * time.Hour))
+	if err != nil {
+		log.Fatalf("failed to create expiryDate: %v", err)
+	}
+
 	parentStr := "PARENT1234"
 
 	// Create optional parentIdTag
@@ -74,7 +78,10 @@ func exampleValidateStatusOnly() {
 }
 
 func exampleValidateWithExpiry() {
-	expiry := time.Now().Add(2 * time.Hour).UTC()
+	expiry, err := types.DateTime(time.Now().Add(2 * time.Hour))
+	if err != nil {
+		log.Fatalf("failed to create expiryDate: %v", err)
+	}
 
 	info := types.IdTagInfoType{
 		Status:      types.Accepted,
@@ -113,7 +120,11 @@ func exampleValidateWithParent() {
 }
 
 func exampleValidateFullyPopulated() {
-	expiry := time.Now().Add(1 * time.Hour).UTC()
+	expiry, err := types.DateTime(time.Now().Add(1 * time.Hour))
+	if err != nil {
+		log.Fatalf("failed to create expiryDate: %v", err)
+	}
+
 	parent, err := types.IdToken("FULLTAG")
 	if err != nil {
 		log.Fatalf("failed to create parentIdTag: %v", err)
@@ -7,17 +7,9 @@ import (
 	"strings"
 
 	"github.com/aasanchez/ocpp16messages/messages/authorize"
-	"github.com/aasanchez/ocpp16messages/types"
+	"github.com/aasanchez/ocpp16messages/ocppj"
 )
 
-// FullMessage represents the full structure of a typical OCPP message
-type FullMessage struct {
-	MessageTypeId int                      `json:"MessageTypeId"`
-	UniqueId      string                   `json:"UniqueId"`
-	Action        string                   `json:"Action"`
-	Payload       authorize.RequestMessage `json:"Payload"`
-}
-
 func main() {
 	// Simulate receiving a full JSON message (header + payload)
 	receivedJSON := `[
@@ -31,73 +23,32 @@ func main() {
 
 	fmt.Println(strings.Repeat("#", 120))
 
-	// Step 1: Deserialize the full message (header + payload)
-	var rawMsg []interface{}
-	err := json.Unmarshal([]byte(receivedJSON), &rawMsg)
+	// Step 1: Parse the OCPP-J frame (message type, uniqueId, action and shape are checked)
+	msg, err := ocppj.Parse([]byte(receivedJSON))
 	if err != nil {
-		log.Fatalf("Error unmarshaling JSON: %v", err)
-	}
-
-	// Extract MessageTypeId, UniqueId, and Action
-	messageTypeId, ok := rawMsg[0].(float64)
-	if !ok {
-		log.Fatalf("Invalid MessageTypeId type")
-	}
-
-	uniqueId, ok := rawMsg[1].(string)
-	if !ok {
-		log.Fatalf("Invalid UniqueId type")
+		log.Fatalf("Error parsing frame: %v", err)
 	}
 
-	action, ok := rawMsg[2].(string)
+	call, ok := msg.(ocppj.CallMessage)
 	if !ok {
-		log.Fatalf("Invalid Action type")
-	}
-
-	// Step 2: Deserialize the payload part (the 'idTag' field)
-	payloadJSON, err := json.Marshal(rawMsg[3])
-	if err != nil {
-		log.Fatalf("Error marshaling payload: %v", err)
+		log.Fatalf("Expected a CALL, got %s", msg.MessageType())
 	}
 
-	// Deserialize the Payload into a map to extract 'idTag'
-	var payloadMap map[string]interface{}
-	err = json.Unmarshal(payloadJSON, &payloadMap)
-	if err != nil {
-		log.Fatalf("Error unmarshaling payload: %v", err)
-	}
-
-	// Manually extract and validate the 'idTag' field from the payload
-	idTagStr, ok := payloadMap["idTag"].(string)
-	if !ok {
-		log.Fatalf("Error: idTag field is missing or invalid")
-	}
-
-	// Create an IdTag object using the extracted string
-	idTag, err := types.IdToken(idTagStr)
-	if err != nil {
-		log.Fatalf("Error creating IdTag: %v", err)
-	}
-
-	// Create the RequestMessage with the validated IdTag
-	payload := authorize.RequestMessage{IdTag: idTag}
-
-	// Step 3: Verify the Action and MessageTypeId (assume these are valid for simplicity)
-	fmt.Printf("Received Message Type: %d\n", int(messageTypeId))
-	fmt.Printf("Received Action: %s\n", action)
-	fmt.Printf("Received UniqueId: %s\n", uniqueId)
-
-	// Step 4: Validate the payload (the RequestMessage)
-	if err := payload.Validate(); err != nil {
+	// Step 2: Decode the payload; decoding runs the same validation as authorize.Request
+	var payload authorize.RequestMessage
+	if err := json.Unmarshal(call.Payload, &payload); err != nil {
 		log.Fatalf("Validation failed: %v", err)
 	}
 
-	// Step 5: Handle the deserialized request (e.g., print it out)
+	fmt.Printf("Received Message Type: %d\n", int(call.MessageType()))
+	fmt.Printf("Received Action: %s\n", call.Action)
+	fmt.Printf("Received UniqueId: %s\n", call.UniqueID)
+
+	// Step 3: Handle the deserialized request (e.g., print it out)
 	// This will print the formatted message with {idTag=...}
 	fmt.Printf("Received and Validated RequestMessage: %s\n", payload.String())
 
 	// Additional line to print just the idTag value
-	// This will now print only the idTag value, as requested
 	fmt.Printf("idTag: %s\n", payload.IdTag.String())
 
 	fmt.Println(strings.Repeat("#", 120))
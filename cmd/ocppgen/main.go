@@ -0,0 +1,201 @@
+// Command ocppgen generates the messages/<action> packages from the official
+// OCPP 1.6J JSON schemas.
+//
+// For every action it reads <Action>.json and <Action>Response.json and writes a
+// package with the same shape as messages/authorize: RequestMessage and
+// ConfirmationMessage with constructors, Validate, ValidateAll, String and JSON
+// encoding, plus the enumerations and nested objects of the schemas. String fields
+// become the CiString types of the types package, idTag fields become
+// types.IdTokenType, idTagInfo, meter values, charging profiles, charging
+// schedules, configuration keys and local authorization list entries become the
+// matching types of the types package (for example types.ChargingProfileType), and
+// date-time fields become types.DateTimeType.
+//
+// Every package also gets a generated_test.go, built from sample payloads, with a
+// JSON round trip test of each message, tests rejecting a missing required field
+// or an unknown enumeration value, and a test of every enumeration value.
+//
+// Packages that contain hand-written (non-test) Go files are never overwritten, so
+// an action can graduate from generated to hand-maintained code by removing the
+// "Code generated" header from its files. Hand-written tests next to generated
+// files are kept.
+//
+// Usage:
+//
+//	ocppgen [-schemas dir] [-out dir] [action ...]
+//
+// Without arguments every action found in the schema directory is generated. The
+// generator is run from the messages package with:
+//
+//	go generate ./messages
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// generatedHeader prefixes the first line of every generated file.
+const generatedHeader = "// Code generated by ocppgen"
+
+func main() {
+	schemasDir := flag.String("schemas", "schemas/json", "directory holding the OCPP 1.6J JSON schemas")
+	outDir := flag.String("out", "messages", "directory in which the message packages are written")
+
+	flag.Parse()
+
+	if err := run(*schemasDir, *outDir, flag.Args(), os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "ocppgen:", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the packages of actions, or of every action in schemasDir if
+// actions is empty, and reports progress to out.
+func run(schemasDir, outDir string, actions []string, out io.Writer) error {
+	if len(actions) == 0 {
+		var err error
+
+		actions, err = listActions(schemasDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, action := range actions {
+		dir := filepath.Join(outDir, packageName(action))
+
+		handWritten, err := isHandWritten(dir)
+		if err != nil {
+			return err
+		}
+
+		if handWritten {
+			fmt.Fprintf(out, "skipping %s: package is maintained by hand\n", dir)
+
+			continue
+		}
+
+		files, err := generate(schemasDir, action)
+		if err != nil {
+			return fmt.Errorf("%s: %w", action, err)
+		}
+
+		if err := writePackage(dir, files); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(out, "generated %s\n", dir)
+	}
+
+	return nil
+}
+
+// listActions returns the actions of the request schemas found in dir.
+func listActions(dir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []string
+
+	for _, match := range matches {
+		name := strings.TrimSuffix(filepath.Base(match), ".json")
+		if !strings.HasSuffix(name, responseSuffix) {
+			actions = append(actions, name)
+		}
+	}
+
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("no schemas found in %s", dir)
+	}
+
+	slices.Sort(actions)
+
+	return actions, nil
+}
+
+// isHandWritten reports whether dir contains a non-test Go file without the
+// generated header.
+func isHandWritten(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		generated, err := isGenerated(filepath.Join(dir, name))
+		if err != nil {
+			return false, err
+		}
+
+		if !generated {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isGenerated reports whether the file at path starts with the generated header.
+func isGenerated(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.HasPrefix(data, []byte(generatedHeader)), nil
+}
+
+// writePackage writes files into dir and removes generated files that are no
+// longer produced.
+func writePackage(dir string, files map[string][]byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if _, keep := files[entry.Name()]; keep || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+
+		if generated, err := isGenerated(path); err != nil || !generated {
+			continue
+		}
+
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
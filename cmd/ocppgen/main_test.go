@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const schemasDir = "../../schemas/json"
+
+func TestRunWritesPackagesAndSkipsHandWrittenOnes(t *testing.T) {
+	t.Parallel()
+
+	out := t.TempDir()
+
+	handWritten := filepath.Join(out, "heartbeat")
+	if err := os.MkdirAll(handWritten, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(handWritten, "request.go"), []byte("package heartbeat\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var log bytes.Buffer
+	if err := run(schemasDir, out, []string{"Heartbeat", "Reset"}, &log); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(log.String(), "skipping "+handWritten) {
+		t.Errorf("expected hand-written package to be skipped, got log:\n%s", log.String())
+	}
+
+	if data, _ := os.ReadFile(filepath.Join(handWritten, "request.go")); string(data) != "package heartbeat\n" {
+		t.Errorf("hand-written file was overwritten: %s", data)
+	}
+
+	for _, name := range []string{"doc.go", "request.go", "confirmation.go", "enums.go", generatedTestFile} {
+		if _, err := os.Stat(filepath.Join(out, "reset", name)); err != nil {
+			t.Errorf("expected reset/%s to be generated: %v", name, err)
+		}
+	}
+}
+
+func TestRunRemovesStaleGeneratedFiles(t *testing.T) {
+	t.Parallel()
+
+	out := t.TempDir()
+	dir := filepath.Join(out, "clearcache")
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		"objects.go":      generatedHeader + " from an older schema. DO NOT EDIT.\n\npackage clearcache\n",
+		"request_test.go": "package clearcache\n",
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := run(schemasDir, out, []string{"ClearCache"}, &bytes.Buffer{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "objects.go")); !os.IsNotExist(err) {
+		t.Errorf("expected stale objects.go to be removed, got %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "request_test.go")); err != nil {
+		t.Errorf("expected tests to be kept: %v", err)
+	}
+}
+
+func TestRunUnknownAction(t *testing.T) {
+	t.Parallel()
+
+	if err := run(schemasDir, t.TempDir(), []string{"Authorise"}, &bytes.Buffer{}); err == nil {
+		t.Error("expected error for unknown action, got nil")
+	}
+
+	if err := run(t.TempDir(), t.TempDir(), nil, &bytes.Buffer{}); err == nil {
+		t.Error("expected error for empty schema directory, got nil")
+	}
+}
+
+// TestGeneratedPackagesAreUpToDate fails when the generated message packages do not
+// match the output of the generator; run "go generate ./messages" to fix it.
+func TestGeneratedPackagesAreUpToDate(t *testing.T) {
+	t.Parallel()
+
+	actions, err := listActions(schemasDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, action := range actions {
+		dir := filepath.Join("../../messages", packageName(action))
+
+		if handWritten, err := isHandWritten(dir); err != nil || handWritten {
+			continue
+		}
+
+		files, err := generate(schemasDir, action)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", action, err)
+		}
+
+		for name, want := range files {
+			got, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil || !bytes.Equal(got, want) {
+				t.Errorf("%s/%s is out of date, run go generate ./messages", dir, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	errUnsupportedSchema = errors.New("unsupported schema")
+	errConflictingType   = errors.New("conflicting type definitions")
+)
+
+// typeNames gives the specification names of the enumerations and objects that the
+// schemas leave anonymous. Keys are "<Action>.<property>" or, for names shared by
+// several actions, the bare property name.
+var typeNames = map[string]string{
+	"BootNotification.status":              "RegistrationStatus",
+	"CancelReservation.status":             "CancelReservationStatus",
+	"ChangeAvailability.status":            "AvailabilityStatus",
+	"ChangeAvailability.type":              "AvailabilityType",
+	"ChangeConfiguration.status":           "ConfigurationStatus",
+	"ClearCache.status":                    "ClearCacheStatus",
+	"ClearChargingProfile.status":          "ClearChargingProfileStatus",
+	"DataTransfer.status":                  "DataTransferStatus",
+	"DiagnosticsStatusNotification.status": "DiagnosticsStatus",
+	"FirmwareStatusNotification.status":    "FirmwareStatus",
+	"GetCompositeSchedule.status":          "GetCompositeScheduleStatus",
+	"RemoteStartTransaction.status":        "RemoteStartStopStatus",
+	"RemoteStopTransaction.status":         "RemoteStartStopStatus",
+	"ReserveNow.status":                    "ReservationStatus",
+	"Reset.status":                         "ResetStatus",
+	"Reset.type":                           "ResetType",
+	"SendLocalList.status":                 "UpdateStatus",
+	"SetChargingProfile.status":            "ChargingProfileStatus",
+	"StatusNotification.status":            "ChargePointStatus",
+	"TriggerMessage.status":                "TriggerMessageStatus",
+	"UnlockConnector.status":               "UnlockStatus",
+
+	"chargingProfilePurpose": "ChargingProfilePurposeType",
+	"chargingRateUnit":       "ChargingRateUnitType",
+	"context":                "ReadingContext",
+	"errorCode":              "ChargePointErrorCode",
+	"format":                 "ValueFormat",
+	"location":               "Location",
+	"measurand":              "Measurand",
+	"phase":                  "Phase",
+	"reason":                 "Reason",
+	"requestedMessage":       "MessageTrigger",
+	"unit":                   "UnitOfMeasure",
+	"updateType":             "UpdateType",
+}
+
+// sharedObjects maps object properties to the types package type that models them,
+// without the "Type" suffix. Their nested objects and enumerations are not generated.
+var sharedObjects = map[string]string{
+	"chargingProfile":        "ChargingProfile",
+	"chargingSchedule":       "ChargingSchedule",
+	"configurationKey":       "KeyValue",
+	"csChargingProfiles":     "ChargingProfile",
+	"idTagInfo":              "IdTagInfo",
+	"localAuthorizationList": "AuthorizationData",
+	"meterValue":             "MeterValue",
+	"transactionData":        "MeterValue",
+}
+
+// idTokenFields are the string properties holding an IdToken.
+var idTokenFields = []string{"idTag", "parentIdTag"}
+
+// kind classifies the Go representation of a schema type.
+type kind int
+
+const (
+	kindCiString kind = iota // types.CiString<N>Type
+	kindIdToken              // types.IdTokenType
+	kindDateTime             // types.DateTimeType
+	kindString               // string
+	kindEnum                 // generated string enumeration
+	kindInt                  // int
+	kindFloat                // float64
+	kindBool                 // bool
+	kindObject               // generated struct or shared types object
+	kindList                 // slice of another type
+)
+
+// typeRef describes the Go type of a field.
+type typeRef struct {
+	kind   kind
+	goType string   // Go type, e.g. "types.CiString20Type" or "ResetType"
+	parse  string   // function converting a wire string, for string kinds
+	label  string   // short description used in doc comments
+	enum   *enumDef // for kindEnum
+	elem   *typeRef // for kindList
+}
+
+// validatable reports whether values of the type implement types.Validatable.
+func (t *typeRef) validatable() bool {
+	switch t.kind {
+	case kindCiString, kindIdToken, kindDateTime, kindObject:
+		return true
+	default:
+		return false
+	}
+}
+
+// stringer reports whether values of the type implement fmt.Stringer.
+func (t *typeRef) stringer() bool {
+	return t.validatable() || t.kind == kindEnum
+}
+
+// parsed reports whether the type is carried as a string on the wire and converted
+// with a parse function.
+func (t *typeRef) parsed() bool {
+	return t.parse != ""
+}
+
+// field is a property of a message or nested object.
+type field struct {
+	json     string
+	goName   string
+	local    string // identifier for locals and parameters
+	required bool
+	ref      *typeRef
+}
+
+// goType returns the Go type of the field, a pointer for optional scalars.
+func (f field) goType() string {
+	if f.required || f.ref.kind == kindList {
+		return f.ref.goType
+	}
+
+	return "*" + f.ref.goType
+}
+
+// enumDef is a generated string enumeration.
+type enumDef struct {
+	name   string
+	values []string
+	usage  string // first field using the enumeration, e.g. "status field of Reset.conf"
+}
+
+// objectDef is a generated struct for a nested object.
+type objectDef struct {
+	name   string
+	fields []field
+	usage  string
+}
+
+// message is a request or confirmation message.
+type message struct {
+	name   string // "Reset.req"
+	schema string // schema file name without extension
+	fields []field
+}
+
+// model holds everything generated for one action.
+type model struct {
+	action       string
+	pkg          string
+	request      message
+	confirmation message
+	enums        []*enumDef
+	objects      []*objectDef
+}
+
+// packageName returns the Go package name of an action.
+func packageName(action string) string {
+	return strings.ToLower(action)
+}
+
+// buildModel builds the model of action from its request and confirmation schemas.
+func buildModel(action string, request, confirmation *schemaNode) (*model, error) {
+	m := &model{
+		action:       action,
+		pkg:          packageName(action),
+		request:      message{name: action + ".req", schema: action, fields: nil},
+		confirmation: message{name: action + ".conf", schema: action + responseSuffix, fields: nil},
+		enums:        nil,
+		objects:      nil,
+	}
+
+	var err error
+
+	if m.request.fields, err = m.fields(request, m.request.name); err != nil {
+		return nil, fmt.Errorf("%s: %w", m.request.schema, err)
+	}
+
+	if m.confirmation.fields, err = m.fields(confirmation, m.confirmation.name); err != nil {
+		return nil, fmt.Errorf("%s: %w", m.confirmation.schema, err)
+	}
+
+	return m, nil
+}
+
+// fields returns the fields of an object schema, owned by the named message or object.
+func (m *model) fields(object *schemaNode, owner string) ([]field, error) {
+	if object.Type != "object" {
+		return nil, fmt.Errorf("%w: %s is not an object", errUnsupportedSchema, owner)
+	}
+
+	fields := make([]field, 0, len(object.Properties))
+
+	for _, prop := range object.Properties {
+		ref, err := m.typeFor(prop.Name, prop.Schema, fmt.Sprintf("%s field of %s", prop.Name, owner))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", prop.Name, err)
+		}
+
+		fields = append(fields, field{
+			json:     prop.Name,
+			goName:   exportedName(prop.Name),
+			local:    localName(prop.Name),
+			required: object.isRequired(prop.Name),
+			ref:      ref,
+		})
+	}
+
+	return fields, nil
+}
+
+// typeFor returns the Go type of the property name with the given schema.
+func (m *model) typeFor(name string, schema *schemaNode, usage string) (*typeRef, error) {
+	switch schema.Type {
+	case "string":
+		return m.stringType(name, schema, usage)
+	case "integer":
+		return &typeRef{kind: kindInt, goType: "int", parse: "", label: "integer", enum: nil, elem: nil}, nil
+	case "number":
+		return &typeRef{kind: kindFloat, goType: "float64", parse: "", label: "decimal", enum: nil, elem: nil}, nil
+	case "boolean":
+		return &typeRef{kind: kindBool, goType: "bool", parse: "", label: "boolean", enum: nil, elem: nil}, nil
+	case "object":
+		return m.objectType(name, schema, usage)
+	case "array":
+		if schema.Items == nil {
+			return nil, fmt.Errorf("%w: array without items", errUnsupportedSchema)
+		}
+
+		elem, err := m.typeFor(name, schema.Items, usage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &typeRef{
+			kind: kindList, goType: "[]" + elem.goType, parse: "", label: "list of " + elem.label, enum: nil, elem: elem,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: type %q", errUnsupportedSchema, schema.Type)
+	}
+}
+
+func (m *model) stringType(name string, schema *schemaNode, usage string) (*typeRef, error) {
+	switch {
+	case len(schema.Enum) > 0:
+		enum, err := m.enum(m.typeName(name), schema.Enum, usage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &typeRef{
+			kind: kindEnum, goType: enum.name, parse: "Parse" + enum.name, label: enum.name, enum: enum, elem: nil,
+		}, nil
+	case schema.Format == "date-time":
+		return &typeRef{
+			kind: kindDateTime, goType: "types.DateTimeType", parse: "types.ParseDateTime", label: "dateTime",
+			enum: nil, elem: nil,
+		}, nil
+	case slices.Contains(idTokenFields, name):
+		return &typeRef{
+			kind: kindIdToken, goType: "types.IdTokenType", parse: "types.IdToken", label: "IdToken",
+			enum: nil, elem: nil,
+		}, nil
+	case schema.MaxLength != nil:
+		switch n := *schema.MaxLength; n {
+		case 20, 25, 50, 255, 500:
+			size := strconv.Itoa(n)
+
+			return &typeRef{
+				kind: kindCiString, goType: "types.CiString" + size + "Type", parse: "types.CiString" + size,
+				label: "CiString[" + size + "]", enum: nil, elem: nil,
+			}, nil
+		default:
+			return nil, fmt.Errorf("%w: no CiString type for maxLength %d", errUnsupportedSchema, n)
+		}
+	default:
+		return &typeRef{kind: kindString, goType: "string", parse: "", label: "string", enum: nil, elem: nil}, nil
+	}
+}
+
+func (m *model) objectType(name string, schema *schemaNode, usage string) (*typeRef, error) {
+	if shared, ok := sharedObjects[name]; ok {
+		return &typeRef{
+			kind: kindObject, goType: "types." + shared + "Type", parse: "", label: shared, enum: nil, elem: nil,
+		}, nil
+	}
+
+	typeName := m.typeName(name)
+	ref := &typeRef{kind: kindObject, goType: typeName, parse: "", label: typeName, enum: nil, elem: nil}
+
+	var existing *objectDef
+
+	for _, object := range m.objects {
+		if object.name == typeName {
+			existing = object
+		}
+	}
+
+	// Declare new objects before their nested objects, so that they are generated
+	// in the order of the schema.
+	object := &objectDef{name: typeName, fields: nil, usage: usage}
+	if existing == nil {
+		m.objects = append(m.objects, object)
+	}
+
+	fields, err := m.fields(schema, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil && !sameFields(existing.fields, fields) {
+		return nil, fmt.Errorf("%w: %s", errConflictingType, typeName)
+	}
+
+	object.fields = fields
+
+	return ref, nil
+}
+
+// enum returns the enumeration named name, declaring it on first use.
+func (m *model) enum(name string, values []string, usage string) (*enumDef, error) {
+	for _, existing := range m.enums {
+		if existing.name != name {
+			continue
+		}
+
+		if !slices.Equal(existing.values, values) {
+			return nil, fmt.Errorf("%w: %s", errConflictingType, name)
+		}
+
+		return existing, nil
+	}
+
+	enum := &enumDef{name: name, values: values, usage: usage}
+	m.enums = append(m.enums, enum)
+
+	return enum, nil
+}
+
+// typeName returns the Go name of the enumeration or object held by property name.
+func (m *model) typeName(name string) string {
+	if typeName, ok := typeNames[m.action+"."+name]; ok {
+		return typeName
+	}
+
+	if typeName, ok := typeNames[name]; ok {
+		return typeName
+	}
+
+	return exportedName(name)
+}
+
+func sameFields(a, b []field) bool {
+	return slices.EqualFunc(a, b, func(x, y field) bool {
+		return x.json == y.json && x.required == y.required && x.ref.goType == y.ref.goType
+	})
+}
+
+// exportedName returns the exported Go identifier for a JSON property name,
+// keeping the casing of the rest of the name (e.g. "idTag" becomes "IdTag").
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// reservedLocals are identifiers used by the generated code, which property names
+// must not shadow.
+var reservedLocals = []string{
+	"data", "err", "errs", "errors", "fields", "fmt", "json", "m", "msg", "payload",
+	"r", "strconv", "strings", "time", "types",
+}
+
+// localName returns an identifier for locals and parameters holding the property name.
+func localName(name string) string {
+	if token.IsKeyword(name) || slices.Contains(reservedLocals, name) {
+		return name + "Value"
+	}
+
+	return name
+}
+
+// constantName returns the Go identifier of an enumeration value, e.g.
+// "Interruption.Begin" becomes "InterruptionBegin" and "L1-N" becomes "L1N".
+func constantName(enum, value string) string {
+	parts := strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var name strings.Builder
+
+	name.WriteString(enum)
+
+	for _, part := range parts {
+		name.WriteString(exportedName(part))
+	}
+
+	return name.String()
+}
+
+// words splits a Go identifier into lowercase words, e.g. "ChargePointStatus"
+// becomes "charge point status".
+func words(name string) string {
+	var out strings.Builder
+
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			out.WriteByte(' ')
+		}
+
+		out.WriteRune(unicode.ToLower(r))
+	}
+
+	return out.String()
+}
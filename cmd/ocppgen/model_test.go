@@ -0,0 +1,198 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNaming(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		got, want string
+	}{
+		{exportedName("idTag"), "IdTag"},
+		{exportedName(""), ""},
+		{localName("type"), "typeValue"},
+		{localName("data"), "dataValue"},
+		{localName("connectorId"), "connectorId"},
+		{constantName("ReadingContext", "Interruption.Begin"), "ReadingContextInterruptionBegin"},
+		{constantName("Phase", "L1-N"), "PhaseL1N"},
+		{constantName("UnitOfMeasure", "kWh"), "UnitOfMeasureKWh"},
+		{words("ChargePointErrorCode"), "charge point error code"},
+		{packageName("RemoteStartTransaction"), "remotestarttransaction"},
+	}
+
+	for _, tc := range tests {
+		if tc.got != tc.want {
+			t.Errorf("want %q, got %q", tc.want, tc.got)
+		}
+	}
+}
+
+func TestBuildModelUsesSpecificationNames(t *testing.T) {
+	t.Parallel()
+
+	request, _ := loadSchema(schemasDir, "StatusNotification")
+	confirmation, _ := loadSchema(schemasDir, "StatusNotificationResponse")
+
+	m, err := buildModel("StatusNotification", request, confirmation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	types := map[string]string{}
+	for _, f := range m.request.fields {
+		types[f.json] = f.goType()
+	}
+
+	want := map[string]string{
+		"connectorId":     "int",
+		"errorCode":       "ChargePointErrorCode",
+		"info":            "*types.CiString50Type",
+		"status":          "ChargePointStatus",
+		"timestamp":       "*types.DateTimeType",
+		"vendorId":        "*types.CiString255Type",
+		"vendorErrorCode": "*types.CiString50Type",
+	}
+
+	for name, goType := range want {
+		if types[name] != goType {
+			t.Errorf("%s: want %s, got %s", name, goType, types[name])
+		}
+	}
+
+	if len(m.enums) != 2 || len(m.objects) != 0 || len(m.confirmation.fields) != 0 {
+		t.Errorf("unexpected model: %d enums, %d objects, %d confirmation fields",
+			len(m.enums), len(m.objects), len(m.confirmation.fields))
+	}
+}
+
+func TestBuildModelSharedTypes(t *testing.T) {
+	t.Parallel()
+
+	request, _ := loadSchema(schemasDir, "StartTransaction")
+	confirmation, _ := loadSchema(schemasDir, "StartTransactionResponse")
+
+	m, err := buildModel("StartTransaction", request, confirmation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := m.request.fields[1].goType(); got != "types.IdTokenType" {
+		t.Errorf("expected idTag to be an IdToken, got %s", got)
+	}
+
+	if got := m.confirmation.fields[0].goType(); got != "types.IdTagInfoType" {
+		t.Errorf("expected idTagInfo to be an IdTagInfo, got %s", got)
+	}
+
+	request, _ = loadSchema(schemasDir, "StopTransaction")
+	confirmation, _ = loadSchema(schemasDir, "StopTransactionResponse")
+
+	m, err = buildModel("StopTransaction", request, confirmation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := m.request.fields[5].goType(); got != "[]types.MeterValueType" {
+		t.Errorf("expected transactionData to be a list of MeterValue, got %s", got)
+	}
+
+	if len(m.objects) != 0 || len(m.enums) != 1 {
+		t.Errorf("expected only the Reason enum to be generated, got %d objects and %d enums", len(m.objects), len(m.enums))
+	}
+
+	request, _ = loadSchema(schemasDir, "GetConfiguration")
+	confirmation, _ = loadSchema(schemasDir, "GetConfigurationResponse")
+
+	m, err = buildModel("GetConfiguration", request, confirmation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := m.confirmation.fields[0].goType(); got != "[]types.KeyValueType" || len(m.objects) != 0 {
+		t.Errorf("expected configurationKey to be a list of KeyValue, got %s and %d objects", got, len(m.objects))
+	}
+
+	request, _ = loadSchema(schemasDir, "SendLocalList")
+	confirmation, _ = loadSchema(schemasDir, "SendLocalListResponse")
+
+	m, err = buildModel("SendLocalList", request, confirmation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := m.request.fields[1].goType(); got != "[]types.AuthorizationDataType" || len(m.objects) != 0 {
+		t.Errorf("expected localAuthorizationList to be a list of AuthorizationData, got %s and %d objects", got, len(m.objects))
+	}
+}
+
+func TestBuildModelRejectsUnsupportedSchemas(t *testing.T) {
+	t.Parallel()
+
+	empty, _ := parseSchema([]byte(`{"type":"object"}`))
+
+	tests := []string{
+		`{"type":"string"}`,
+		`{"type":"object","properties":{"a":{"type":"string","maxLength":30}}}`,
+		`{"type":"object","properties":{"a":{"type":"null"}}}`,
+		`{"type":"object","properties":{"a":{"type":"array"}}}`,
+	}
+
+	for _, schema := range tests {
+		request, err := parseSchema([]byte(schema))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := buildModel("Test", request, empty); !errors.Is(err, errUnsupportedSchema) {
+			t.Errorf("%s: expected errUnsupportedSchema, got %v", schema, err)
+		}
+	}
+}
+
+func TestBuildModelRejectsConflictingTypes(t *testing.T) {
+	t.Parallel()
+
+	request, _ := parseSchema([]byte(`{"type":"object","properties":{"phase":{"type":"string","enum":["L1"]}}}`))
+	confirmation, _ := parseSchema([]byte(`{"type":"object","properties":{"phase":{"type":"string","enum":["L2"]}}}`))
+
+	if _, err := buildModel("Test", request, confirmation); !errors.Is(err, errConflictingType) {
+		t.Errorf("expected errConflictingType for enums, got %v", err)
+	}
+
+	request, _ = parseSchema([]byte(`{"type":"object","properties":{"schedule":{"type":"object","properties":{"a":{"type":"integer"}}}}}`))
+	confirmation, _ = parseSchema([]byte(`{"type":"object","properties":{"schedule":{"type":"object","properties":{"b":{"type":"integer"}}}}}`))
+
+	if _, err := buildModel("Test", request, confirmation); !errors.Is(err, errConflictingType) {
+		t.Errorf("expected errConflictingType for objects, got %v", err)
+	}
+}
+
+func TestSamplePayloads(t *testing.T) {
+	t.Parallel()
+
+	request, _ := loadSchema(schemasDir, "TriggerMessage")
+	confirmation, _ := loadSchema(schemasDir, "TriggerMessageResponse")
+
+	m, err := buildModel("TriggerMessage", request, confirmation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		got, want string
+	}{
+		{m.samplePayload(m.request.fields, false, "", ""), `{"requestedMessage":"BootNotification"}`},
+		{m.samplePayload(m.request.fields, true, "", ""), `{"requestedMessage":"BootNotification","connectorId":1}`},
+		{m.samplePayload(m.request.fields, false, "requestedMessage", ""), `{}`},
+		{m.samplePayload(m.request.fields, true, "", "requestedMessage"), `{"requestedMessage":"Invalid","connectorId":1}`},
+	}
+
+	for _, tc := range tests {
+		if tc.got != tc.want {
+			t.Errorf("want %s, got %s", tc.want, tc.got)
+		}
+	}
+}
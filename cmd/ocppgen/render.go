@@ -0,0 +1,869 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// knownImports maps the package names used by generated code to their import paths.
+var knownImports = map[string]string{
+	"enumtest": "github.com/aasanchez/ocpp16messages/internal/enumtest",
+	"errors":   "errors",
+	"fmt":      "fmt",
+	"json":     "encoding/json",
+	"strconv":  "strconv",
+	"strings":  "strings",
+	"testing":  "testing",
+	"time":     "time",
+	"types":    "github.com/aasanchez/ocpp16messages/types",
+}
+
+// generate returns the Go files of the package of action, keyed by file name.
+func generate(schemasDir, action string) (map[string][]byte, error) {
+	request, err := loadSchema(schemasDir, action)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmation, err := loadSchema(schemasDir, action+responseSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := buildModel(action, request, confirmation)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.render()
+}
+
+// printer accumulates generated source code.
+type printer struct {
+	buf bytes.Buffer
+}
+
+// line writes a formatted line.
+func (p *printer) line(format string, args ...any) {
+	fmt.Fprintf(&p.buf, format, args...)
+	p.buf.WriteByte('\n')
+}
+
+// comment writes a doc comment, one "//" line per argument. Empty arguments
+// produce an empty comment line.
+func (p *printer) comment(indent string, lines ...string) {
+	for _, line := range lines {
+		if line == "" {
+			p.line("%s//", indent)
+		} else {
+			p.line("%s// %s", indent, line)
+		}
+	}
+}
+
+// render returns the formatted files of the model.
+func (m *model) render() (map[string][]byte, error) {
+	renderers := map[string]func(*printer){
+		"request.go": func(p *printer) {
+			m.renderMessage(p, m.request, "RequestMessage", "Request", "r", "req", "requestPayload")
+		},
+		"confirmation.go": func(p *printer) {
+			m.renderMessage(p, m.confirmation, "ConfirmationMessage", "Confirmation", "m", "conf", "confirmationPayload")
+		},
+	}
+
+	if len(m.enums) > 0 {
+		renderers["enums.go"] = m.renderEnums
+	}
+
+	if len(m.objects) > 0 {
+		renderers["objects.go"] = m.renderObjects
+	}
+
+	renderers[generatedTestFile] = m.renderTests
+
+	files := map[string][]byte{"doc.go": m.renderDoc()}
+
+	for name, render := range renderers {
+		var p printer
+
+		render(&p)
+
+		src, err := m.assemble(p.buf.String())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		files[name] = src
+	}
+
+	return files, nil
+}
+
+// header returns the generated code header of every file of the model.
+func (m *model) header() string {
+	return fmt.Sprintf("%s from %s.json and %s.json. DO NOT EDIT.\n\n",
+		generatedHeader, m.request.schema, m.confirmation.schema)
+}
+
+func (m *model) renderDoc() []byte {
+	var p printer
+
+	p.buf.WriteString(m.header())
+	p.comment("",
+		fmt.Sprintf("Package %s implements the OCPP 1.6J %s and %s messages.", m.pkg, m.request.name, m.confirmation.name),
+		"",
+		fmt.Sprintf("The message types, their validation and their JSON encoding are generated by"),
+		fmt.Sprintf("cmd/ocppgen from the official %s.json and %s.json schemas.", m.request.schema, m.confirmation.schema),
+		"",
+		"This package should be imported using:",
+		"",
+	)
+	p.line("//\timport \"github.com/aasanchez/ocpp16messages/messages/%s\"", m.pkg)
+	p.line("package %s", m.pkg)
+
+	return p.buf.Bytes()
+}
+
+// assemble adds the header, package clause and imports to body and formats it.
+func (m *model) assemble(body string) ([]byte, error) {
+	imports, err := usedImports("package " + m.pkg + "\n\n" + body)
+	if err != nil {
+		return nil, err
+	}
+
+	var src strings.Builder
+
+	src.WriteString(m.header())
+	src.WriteString("package " + m.pkg + "\n\n")
+
+	if len(imports) > 0 {
+		src.WriteString("import (\n")
+
+		for _, path := range imports {
+			if path == knownImports["types"] {
+				src.WriteString("\n")
+			}
+
+			src.WriteString("\t" + strconv.Quote(path) + "\n")
+		}
+
+		src.WriteString(")\n\n")
+	}
+
+	src.WriteString(body)
+
+	formatted, err := format.Source([]byte(src.String()))
+	if err != nil {
+		return nil, fmt.Errorf("%w\n%s", err, src.String())
+	}
+
+	return formatted, nil
+}
+
+// usedImports returns the sorted import paths of the packages referenced by src.
+func usedImports(src string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("%w\n%s", err, src)
+	}
+
+	var imports []string
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		if ident, ok := selector.X.(*ast.Ident); ok {
+			if path, known := knownImports[ident.Name]; known && !slices.Contains(imports, path) {
+				imports = append(imports, path)
+			}
+		}
+
+		return true
+	})
+
+	slices.SortFunc(imports, func(a, b string) int {
+		// The module's own packages go last, in their own group.
+		aLocal, bLocal := strings.Contains(a, "."), strings.Contains(b, ".")
+		if aLocal != bLocal {
+			if aLocal {
+				return 1
+			}
+
+			return -1
+		}
+
+		return strings.Compare(a, b)
+	})
+
+	return imports, nil
+}
+
+// structSpec describes a generated struct: a message or a nested object.
+type structSpec struct {
+	name     string // Go type name
+	receiver string
+	payload  string // name of the wire representation type
+	label    string // "Reset.req", or "" for nested objects
+	fields   []field
+}
+
+// isMessage reports whether the struct is a top-level message.
+func (s structSpec) isMessage() bool {
+	return s.label != ""
+}
+
+// wrapError returns the statement returning err from a message or object method.
+func (s structSpec) wrapError(zero string) string {
+	if s.isMessage() {
+		return fmt.Sprintf("return %sfmt.Errorf(\"%s validation failed: %%w\", err)", zero, s.name)
+	}
+
+	return "return " + zero + "err"
+}
+
+func (m *model) renderMessage(p *printer, msg message, name, ctor, receiver, local, payload string) {
+	spec := structSpec{name: name, receiver: receiver, payload: payload, label: msg.name, fields: msg.fields}
+
+	p.comment("",
+		fmt.Sprintf("%s represents the OCPP 1.6J %s message.", name, msg.name),
+		"",
+		fmt.Sprintf("It is generated from the %s.json schema.", msg.schema),
+	)
+
+	if hasOptional(msg.fields) {
+		p.comment("", "Optional fields are nil when they are not set.")
+	}
+
+	p.comment("", "", "Specification Reference:")
+	p.line("//   - OCPP 1.6J, %s", msg.name)
+	renderStructType(p, spec)
+	renderConstructor(p, spec, ctor, local)
+	renderValidate(p, spec)
+	renderValidateAll(p, spec)
+	renderString(p, spec)
+	renderPayload(p, spec)
+	renderMarshalJSON(p, spec)
+	renderUnmarshalJSON(p, spec)
+}
+
+// hasOptional reports whether any of the fields is optional.
+func hasOptional(fields []field) bool {
+	for _, f := range fields {
+		if !f.required {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *model) renderObjects(p *printer) {
+	for _, object := range m.objects {
+		spec := structSpec{
+			name:     object.name,
+			receiver: strings.ToLower(object.name[:1]),
+			payload:  strings.ToLower(object.name[:1]) + object.name[1:] + "Payload",
+			label:    "",
+			fields:   object.fields,
+		}
+
+		p.comment("",
+			fmt.Sprintf("%s is the object held by the %s.", object.name, object.usage),
+			"",
+			"Build it with a struct literal and check it with Validate.",
+		)
+
+		if hasOptional(object.fields) {
+			p.comment("", "Optional fields are nil when they are not set.")
+		}
+
+		renderStructType(p, spec)
+		renderValidate(p, spec)
+		renderValidateAll(p, spec)
+		renderString(p, spec)
+		renderPayload(p, spec)
+		renderMarshalJSON(p, spec)
+		renderUnmarshalJSON(p, spec)
+	}
+}
+
+func renderStructType(p *printer, s structSpec) {
+	if len(s.fields) == 0 {
+		p.line("type %s struct{}", s.name)
+		p.line("")
+
+		return
+	}
+
+	p.line("type %s struct {", s.name)
+
+	for i, f := range s.fields {
+		if i > 0 {
+			p.line("")
+		}
+
+		occurrence := "optional"
+		if f.required {
+			occurrence = "required"
+		}
+
+		p.comment("\t", fmt.Sprintf("%s is the %s field (%s, %s).", f.goName, f.json, f.ref.label, occurrence))
+		p.line("\t%s %s", f.goName, f.goType())
+	}
+
+	p.line("}")
+	p.line("")
+}
+
+func renderConstructor(p *printer, s structSpec, ctor, local string) {
+	var required []field
+
+	for _, f := range s.fields {
+		if f.required {
+			required = append(required, f)
+		}
+	}
+
+	if len(s.fields) == 0 {
+		p.comment("",
+			fmt.Sprintf("%s constructs a new %s.", ctor, s.name),
+			"",
+			fmt.Sprintf("%s has no fields, so %s never fails.", s.label, ctor),
+		)
+		p.line("func %s() (%s, error) {", ctor, s.name)
+		p.line("\treturn %s{}, nil", s.name)
+		p.line("}")
+		p.line("")
+
+		return
+	}
+
+	params := make([]string, 0, len(required))
+
+	for _, f := range required {
+		switch f.ref.kind {
+		case kindCiString, kindIdToken:
+			params = append(params, f.local+" string")
+		case kindDateTime:
+			params = append(params, f.local+" time.Time")
+		default:
+			params = append(params, f.local+" "+f.goType())
+		}
+	}
+
+	p.comment("",
+		fmt.Sprintf("%s constructs a new %s from its required fields.", ctor, s.name),
+		"",
+	)
+
+	if len(required) < len(s.fields) {
+		p.comment("", "Optional fields are nil and can be set on the returned message afterwards.")
+	}
+
+	p.comment("", "It returns an error if a value violates the OCPP 1.6J constraints.")
+	p.line("func %s(%s) (%s, error) {", ctor, strings.Join(params, ", "), s.name)
+
+	var errs []string
+
+	values := make(map[string]string, len(required))
+
+	for _, f := range required {
+		values[f.json] = f.local
+
+		switch f.ref.kind {
+		case kindCiString, kindIdToken:
+			p.line("\t%sValue, %sErr := types.Required(%q, &%s, %s)", f.local, f.local, f.json, f.local, f.ref.parse)
+			errs = append(errs, f.local+"Err")
+			values[f.json] = f.local + "Value"
+		case kindDateTime:
+			p.line("\t%sValue, %sErr := types.DateTime(%s)", f.local, f.local, f.local)
+			errs = append(errs, fmt.Sprintf("types.WithPath(%q, %sErr)", f.json, f.local))
+			values[f.json] = f.local + "Value"
+		default:
+		}
+	}
+
+	if len(errs) > 0 {
+		p.line("")
+		renderJoin(p, errs, fmt.Sprintf("return %s{}, fmt.Errorf(\"failed to create %s: %%w\", err)", s.name, s.name))
+	}
+
+	p.line("\t%s := %s{", local, s.name)
+
+	for _, f := range s.fields {
+		value, ok := values[f.json]
+		if !ok {
+			value = "nil"
+		}
+
+		p.line("\t\t%s: %s,", f.goName, value)
+	}
+
+	p.line("\t}")
+	p.line("")
+	p.line("\tif err := %s.Validate(); err != nil {", local)
+	p.line("\t\treturn %s{}, err", s.name)
+	p.line("\t}")
+	p.line("")
+	p.line("\treturn %s, nil", local)
+	p.line("}")
+	p.line("")
+}
+
+// renderJoin writes a types.Join of errs followed by the failure statement.
+func renderJoin(p *printer, errs []string, failure string) {
+	p.line("\terr := types.Join(")
+
+	for _, e := range errs {
+		p.line("\t\t%s,", e)
+	}
+
+	p.line("\t)")
+	p.line("\tif err != nil {")
+	p.line("\t\t%s", failure)
+	p.line("\t}")
+	p.line("")
+}
+
+func renderValidate(p *printer, s structSpec) {
+	p.comment("", fmt.Sprintf("Validate checks the %s against the OCPP 1.6J constraints and returns the", s.name),
+		"first failure.")
+	p.line("func (%s %s) Validate() error {", s.receiver, s.name)
+
+	if s.isMessage() {
+		p.line("\tif err := types.FirstError(%s.ValidateAll()); err != nil {", s.receiver)
+		p.line("\t\t%s", s.wrapError(""))
+		p.line("\t}")
+		p.line("")
+		p.line("\treturn nil")
+	} else {
+		p.line("\treturn types.FirstError(%s.ValidateAll())", s.receiver)
+	}
+
+	p.line("}")
+	p.line("")
+}
+
+func renderValidateAll(p *printer, s structSpec) {
+	p.comment("", fmt.Sprintf("ValidateAll checks every field of the %s and returns all failures joined", s.name),
+		"with errors.Join, each as a *types.ValidationError with its JSON path.")
+	p.line("func (%s %s) ValidateAll() error {", s.receiver, s.name)
+
+	var checks printer
+
+	for _, f := range s.fields {
+		value := s.receiver + "." + f.goName
+
+		switch {
+		case f.ref.kind == kindList && f.ref.elem.validatable():
+			checks.line("\terrs = append(errs, types.ListField(%q, %s))", f.json, value)
+		case f.ref.validatable() && f.required:
+			checks.line("\terrs = append(errs, types.Field(%q, %s))", f.json, value)
+		case f.ref.validatable():
+			checks.line("\terrs = append(errs, types.OptionalField(%q, %s))", f.json, value)
+		case f.ref.kind == kindEnum && f.required:
+			checks.line("\tif !%s.IsValid() {", value)
+			checks.line("\t\terrs = append(errs, types.EnumError(%q, %s, ErrInvalid%s))", f.json, value, f.ref.goType)
+			checks.line("\t}")
+		case f.ref.kind == kindEnum:
+			checks.line("\tif %s != nil && !%s.IsValid() {", value, value)
+			checks.line("\t\terrs = append(errs, types.EnumError(%q, *%s, ErrInvalid%s))", f.json, value, f.ref.goType)
+			checks.line("\t}")
+		default:
+			continue
+		}
+
+		checks.line("")
+	}
+
+	if checks.buf.Len() == 0 {
+		p.line("\treturn nil")
+	} else {
+		p.line("\tvar errs []error")
+		p.line("")
+		p.buf.Write(checks.buf.Bytes())
+		p.line("\treturn types.Join(errs...)")
+	}
+
+	p.line("}")
+	p.line("")
+}
+
+// stringExpr returns the expression formatting the field held in value.
+func stringExpr(f field, value string) string {
+	deref := value
+	if !f.required {
+		deref = "*" + value
+	}
+
+	switch {
+	case f.ref.kind == kindList:
+		return "types.FormatList(" + value + ")"
+	case f.ref.stringer():
+		return value + ".String()"
+	case f.ref.kind == kindInt:
+		return "strconv.Itoa(" + deref + ")"
+	case f.ref.kind == kindFloat:
+		return "strconv.FormatFloat(" + deref + ", 'f', -1, 64)"
+	case f.ref.kind == kindBool:
+		return "strconv.FormatBool(" + deref + ")"
+	default:
+		return deref
+	}
+}
+
+func renderString(p *printer, s structSpec) {
+	p.comment("", fmt.Sprintf("String returns a human-readable representation of the %s.", s.name))
+
+	if hasOptional(s.fields) {
+		p.comment("", "", "Optional fields are only included when set.")
+	}
+
+	p.line("func (%s %s) String() string {", s.receiver, s.name)
+
+	if len(s.fields) == 0 {
+		p.line("\treturn %q", s.label+"{}")
+		p.line("}")
+		p.line("")
+
+		return
+	}
+
+	p.line("\tfields := make([]string, 0, %d)", len(s.fields))
+
+	for _, f := range s.fields {
+		value := s.receiver + "." + f.goName
+		entry := fmt.Sprintf("%q+%s", f.json+"=", stringExpr(f, value))
+
+		if f.required {
+			p.line("\tfields = append(fields, %s)", entry)
+		} else {
+			p.line("")
+			p.line("\tif %s != nil {", value)
+			p.line("\t\tfields = append(fields, %s)", entry)
+			p.line("\t}")
+		}
+	}
+
+	p.line("")
+	p.line("\treturn %q + strings.Join(fields, \", \") + \"}\"", s.label+"{")
+	p.line("}")
+	p.line("")
+}
+
+// wireType returns the type of the field in the wire representation.
+func wireType(f field) string {
+	switch {
+	case f.ref.kind == kindList:
+		return "[]json.RawMessage"
+	case f.ref.kind == kindObject:
+		return "json.RawMessage"
+	case f.ref.parsed() || f.ref.kind == kindString:
+		return "*string"
+	default:
+		return "*" + f.ref.goType
+	}
+}
+
+func renderPayload(p *printer, s structSpec) {
+	subject := s.label
+	if !s.isMessage() {
+		subject = s.name
+	}
+
+	p.comment("", fmt.Sprintf("%s is the OCPP 1.6J wire representation of %s.", s.payload, subject))
+
+	if len(s.fields) == 0 {
+		p.line("type %s struct{}", s.payload)
+		p.line("")
+
+		return
+	}
+
+	p.line("type %s struct {", s.payload)
+
+	for _, f := range s.fields {
+		tag := f.json
+		if !f.required {
+			tag += ",omitempty"
+		}
+
+		p.line("\t%s %s `json:%q`", f.goName, wireType(f), tag)
+	}
+
+	p.line("}")
+	p.line("")
+}
+
+func renderMarshalJSON(p *printer, s structSpec) {
+	subject := "an OCPP 1.6J " + s.label + " payload"
+	if !s.isMessage() {
+		subject = "OCPP 1.6J JSON"
+	}
+
+	p.comment("",
+		fmt.Sprintf("MarshalJSON encodes the %s as %s.", s.name, subject),
+		"",
+	)
+
+	if hasOptional(s.fields) {
+		p.comment("", "Unset optional fields are omitted.")
+	}
+
+	p.comment("", fmt.Sprintf("The value is validated first, so an invalid %s is never encoded.", s.name))
+	p.line("func (%s %s) MarshalJSON() ([]byte, error) {", s.receiver, s.name)
+	p.line("\tif err := %s.Validate(); err != nil {", s.receiver)
+	p.line("\t\treturn nil, err")
+	p.line("\t}")
+	p.line("")
+
+	values := make([]string, 0, len(s.fields))
+
+	for _, f := range s.fields {
+		value := s.receiver + "." + f.goName
+
+		switch {
+		case f.ref.kind == kindList:
+			renderEncode(p, f, "types.EncodeList("+value+")")
+			values = append(values, f.local)
+		case f.ref.kind == kindObject && f.required:
+			renderEncode(p, f, "json.Marshal("+value+")")
+			values = append(values, f.local)
+		case f.ref.kind == kindObject:
+			renderEncode(p, f, "types.EncodeOptional("+value+")")
+			values = append(values, f.local)
+		case f.ref.stringer() && f.required:
+			p.line("\t%s := %s.String()", f.local, value)
+			p.line("")
+			values = append(values, "&"+f.local)
+		case f.ref.stringer():
+			values = append(values, "types.OptionalString("+value+")")
+		case f.required:
+			values = append(values, "&"+value)
+		default:
+			values = append(values, value)
+		}
+	}
+
+	if len(s.fields) == 0 {
+		p.line("\treturn json.Marshal(%s{})", s.payload)
+	} else {
+		p.line("\treturn json.Marshal(%s{", s.payload)
+
+		for i, f := range s.fields {
+			p.line("\t\t%s: %s,", f.goName, values[i])
+		}
+
+		p.line("\t})")
+	}
+
+	p.line("}")
+	p.line("")
+}
+
+// renderEncode writes the encoding of a nested value into a local.
+func renderEncode(p *printer, f field, call string) {
+	p.line("\t%s, err := %s", f.local, call)
+	p.line("\tif err != nil {")
+	p.line("\t\treturn nil, err")
+	p.line("\t}")
+	p.line("")
+}
+
+func renderUnmarshalJSON(p *printer, s structSpec) {
+	subject := "an OCPP 1.6J " + s.label + " payload"
+	if !s.isMessage() {
+		subject = "OCPP 1.6J JSON"
+	}
+
+	p.comment("",
+		fmt.Sprintf("UnmarshalJSON decodes %s into the %s.", subject, s.name),
+		"",
+		"Every field is validated while decoding and all failures are reported together,",
+		fmt.Sprintf("so a successfully decoded %s is always valid.", s.name),
+	)
+	p.line("func (%s *%s) UnmarshalJSON(data []byte) error {", s.receiver, s.name)
+	p.line("\tvar payload %s", s.payload)
+	p.line("\tif err := json.Unmarshal(data, &payload); err != nil {")
+	p.line("\t\t%s", s.wrapError(""))
+	p.line("\t}")
+	p.line("")
+
+	var errs []string
+
+	values := make([]string, 0, len(s.fields))
+
+	for _, f := range s.fields {
+		raw := "payload." + f.goName
+		decode := ""
+
+		switch {
+		case f.ref.kind == kindList && f.required:
+			decode = fmt.Sprintf("types.DecodeRequiredList[%s](%q, %s)", f.ref.elem.goType, f.json, raw)
+		case f.ref.kind == kindList:
+			decode = fmt.Sprintf("types.DecodeList[%s](%q, %s)", f.ref.elem.goType, f.json, raw)
+		case f.ref.kind == kindObject && f.required:
+			decode = fmt.Sprintf("types.DecodeRequired[%s](%q, %s)", f.ref.goType, f.json, raw)
+		case f.ref.kind == kindObject:
+			decode = fmt.Sprintf("types.DecodeOptional[%s](%q, %s)", f.ref.goType, f.json, raw)
+		case f.ref.parsed() && f.required:
+			decode = fmt.Sprintf("types.Required(%q, %s, %s)", f.json, raw, f.ref.parse)
+		case f.ref.parsed():
+			decode = fmt.Sprintf("types.Optional(%q, %s, %s)", f.json, raw, f.ref.parse)
+		case f.required:
+			decode = fmt.Sprintf("types.RequiredValue(%q, %s)", f.json, raw)
+		default:
+			values = append(values, raw)
+
+			continue
+		}
+
+		p.line("\t%s, %sErr := %s", f.local, f.local, decode)
+		errs = append(errs, f.local+"Err")
+		values = append(values, f.local)
+	}
+
+	if len(errs) > 0 {
+		p.line("")
+		renderJoin(p, errs, s.wrapError(""))
+	}
+
+	if len(s.fields) == 0 {
+		p.line("\t*%s = %s{}", s.receiver, s.name)
+	} else {
+		p.line("\t*%s = %s{", s.receiver, s.name)
+
+		for i, f := range s.fields {
+			p.line("\t\t%s: %s,", f.goName, values[i])
+		}
+
+		p.line("\t}")
+	}
+
+	p.line("")
+	p.line("\treturn nil")
+	p.line("}")
+	p.line("")
+}
+
+func (m *model) renderEnums(p *printer) {
+	for _, enum := range m.enums {
+		receiver := strings.ToLower(enum.name[:1])
+
+		p.comment("",
+			fmt.Sprintf("ErrInvalid%s indicates that a %s is not one of the values defined by", enum.name, enum.name),
+			"OCPP 1.6J.",
+		)
+		p.line("var ErrInvalid%s = errors.New(%q)", enum.name, "invalid "+words(enum.name))
+		p.line("")
+		p.comment("", fmt.Sprintf("%s enumerates the values allowed for the %s.", enum.name, enum.usage))
+		p.line("type %s string", enum.name)
+		p.line("")
+		p.line("const (")
+
+		for i, value := range enum.values {
+			if i > 0 {
+				p.line("")
+			}
+
+			name := constantName(enum.name, value)
+			p.comment("\t", fmt.Sprintf("%s is the %q value of %s.", name, value, enum.name))
+			p.line("\t%s %s = %q", name, enum.name, value)
+		}
+
+		p.line(")")
+		p.line("")
+		p.comment("", fmt.Sprintf("IsValid returns true if the %s is one of the values defined by OCPP 1.6J.", enum.name))
+		p.line("func (%s %s) IsValid() bool {", receiver, enum.name)
+		p.line("\tswitch %s {", receiver)
+		p.line("\tcase %s:", caseList(enum))
+		p.line("\t\treturn true")
+		p.line("\tdefault:")
+		p.line("\t\treturn false")
+		p.line("\t}")
+		p.line("}")
+		p.line("")
+		p.comment("", fmt.Sprintf("String returns the wire value of the %s.", enum.name))
+		p.line("func (%s %s) String() string {", receiver, enum.name)
+		p.line("\treturn string(%s)", receiver)
+		p.line("}")
+		p.line("")
+		p.comment("",
+			fmt.Sprintf("Parse%s converts a wire value into a %s. Unknown values are rejected with a", enum.name, enum.name),
+			fmt.Sprintf("*types.ValidationError wrapping ErrInvalid%s.", enum.name),
+		)
+		p.line("func Parse%s(value string) (%s, error) {", enum.name, enum.name)
+		p.line("\treturn types.ParseEnum[%s](value, ErrInvalid%s)", enum.name, enum.name)
+		p.line("}")
+		p.line("")
+		p.comment("", fmt.Sprintf("MarshalText implements encoding.TextMarshaler. It fails with ErrInvalid%s if the", enum.name),
+			"value is not recognized.")
+		p.line("func (%s %s) MarshalText() ([]byte, error) {", receiver, enum.name)
+		p.line("\treturn types.MarshalEnum(%s, ErrInvalid%s)", receiver, enum.name)
+		p.line("}")
+		p.line("")
+		p.comment("", fmt.Sprintf("UnmarshalText implements encoding.TextUnmarshaler. It fails with ErrInvalid%s if", enum.name),
+			"the input is not recognized.")
+		p.line("func (%s *%s) UnmarshalText(text []byte) error {", receiver, enum.name)
+		p.line("\tparsed, err := Parse%s(string(text))", enum.name)
+		p.line("\tif err != nil {")
+		p.line("\t\treturn err")
+		p.line("\t}")
+		p.line("")
+		p.line("\t*%s = parsed", receiver)
+		p.line("")
+		p.line("\treturn nil")
+		p.line("}")
+		p.line("")
+		p.comment("", "MarshalJSON implements json.Marshaler, encoding the value as a JSON string.")
+		p.line("func (%s %s) MarshalJSON() ([]byte, error) {", receiver, enum.name)
+		p.line("\ttext, err := %s.MarshalText()", receiver)
+		p.line("\tif err != nil {")
+		p.line("\t\treturn nil, err")
+		p.line("\t}")
+		p.line("")
+		p.line("\treturn json.Marshal(string(text))")
+		p.line("}")
+		p.line("")
+		p.comment("", "UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.")
+		p.line("func (%s *%s) UnmarshalJSON(data []byte) error {", receiver, enum.name)
+		p.line("\treturn types.UnmarshalJSONEnum(data, %s, Parse%s)", receiver, enum.name)
+		p.line("}")
+		p.line("")
+	}
+}
+
+// caseList returns the constants of enum as a switch case list, wrapped to keep
+// lines short.
+func caseList(enum *enumDef) string {
+	const perLine = 3
+
+	var list strings.Builder
+
+	for i, value := range enum.values {
+		if i > 0 {
+			list.WriteString(",")
+
+			if i%perLine == 0 {
+				list.WriteString("\n\t\t")
+			} else {
+				list.WriteString(" ")
+			}
+		}
+
+		list.WriteString(constantName(enum.name, value))
+	}
+
+	return list.String()
+}
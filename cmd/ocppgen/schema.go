@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// responseSuffix is appended to the action name in confirmation schema files.
+const responseSuffix = "Response"
+
+// schemaNode is the part of a JSON schema that drives code generation. Unlike a
+// map, it keeps properties in the order of the schema document, so that generated
+// fields follow the specification.
+type schemaNode struct {
+	Type       string
+	Format     string
+	MaxLength  *int
+	Enum       []string
+	Required   []string
+	Properties []property
+	Items      *schemaNode
+}
+
+// property is a named property of an object schema.
+type property struct {
+	Name   string
+	Schema *schemaNode
+}
+
+// rawSchema is the JSON form of schemaNode.
+type rawSchema struct {
+	Type       string          `json:"type"`
+	Format     string          `json:"format"`
+	MaxLength  *int            `json:"maxLength"`
+	Enum       []string        `json:"enum"`
+	Required   []string        `json:"required"`
+	Properties json.RawMessage `json:"properties"`
+	Items      json.RawMessage `json:"items"`
+}
+
+// loadSchema reads and parses the schema file name.json in dir.
+func loadSchema(dir, name string) (*schemaNode, error) {
+	data, err := os.ReadFile(filepath.Join(dir, name+".json"))
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := parseSchema(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s.json: %w", name, err)
+	}
+
+	return node, nil
+}
+
+// parseSchema parses a schema document or subschema.
+func parseSchema(data []byte) (*schemaNode, error) {
+	var raw rawSchema
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	node := &schemaNode{
+		Type:       raw.Type,
+		Format:     raw.Format,
+		MaxLength:  raw.MaxLength,
+		Enum:       raw.Enum,
+		Required:   raw.Required,
+		Properties: nil,
+		Items:      nil,
+	}
+
+	if len(raw.Items) > 0 {
+		items, err := parseSchema(raw.Items)
+		if err != nil {
+			return nil, fmt.Errorf("items: %w", err)
+		}
+
+		node.Items = items
+	}
+
+	if len(raw.Properties) == 0 {
+		return node, nil
+	}
+
+	names, err := orderedKeys(raw.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(raw.Properties, &properties); err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		schema, err := parseSchema(properties[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		node.Properties = append(node.Properties, property{Name: name, Schema: schema})
+	}
+
+	return node, nil
+}
+
+// orderedKeys returns the keys of a JSON object in document order.
+func orderedKeys(data []byte) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if token != json.Delim('{') {
+		return nil, fmt.Errorf("expected a JSON object, got %v", token)
+	}
+
+	var keys []string
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok := token.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected token %v", token)
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// isRequired reports whether the object schema requires the property name.
+func (n *schemaNode) isRequired(name string) bool {
+	for _, required := range n.Required {
+		if required == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseSchemaKeepsPropertyOrder(t *testing.T) {
+	t.Parallel()
+
+	node, err := parseSchema([]byte(`{
+		"type": "object",
+		"properties": {
+			"zeta": {"type": "string", "maxLength": 20},
+			"alpha": {"type": "array", "items": {"type": "integer"}}
+		},
+		"required": ["alpha"]
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := make([]string, 0, len(node.Properties))
+	for _, prop := range node.Properties {
+		names = append(names, prop.Name)
+	}
+
+	if !slices.Equal(names, []string{"zeta", "alpha"}) {
+		t.Errorf("unexpected property order: %v", names)
+	}
+
+	if node.isRequired("zeta") || !node.isRequired("alpha") {
+		t.Errorf("unexpected required properties: %v", node.Required)
+	}
+
+	if node.Properties[1].Schema.Items == nil || node.Properties[1].Schema.Items.Type != "integer" {
+		t.Errorf("expected integer items, got %+v", node.Properties[1].Schema.Items)
+	}
+}
+
+func TestParseSchemaInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, schema := range []string{
+		`[]`,
+		`{"properties": []}`,
+		`{"properties": {"a": []}}`,
+		`{"items": "string"}`,
+	} {
+		if _, err := parseSchema([]byte(schema)); err == nil {
+			t.Errorf("expected error for %s, got nil", schema)
+		}
+	}
+}
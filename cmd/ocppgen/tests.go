@@ -0,0 +1,198 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// generatedTestFile is the name of the generated test file of every package. It
+// differs from the names used for hand-written tests, so that those are kept.
+const generatedTestFile = "generated_test.go"
+
+// invalidEnumValue is a wire value that no enumeration of OCPP 1.6J defines.
+const invalidEnumValue = "Invalid"
+
+// sharedSamples holds a valid JSON value of every shared types object, in the
+// canonical form produced by its MarshalJSON.
+var sharedSamples = map[string]string{
+	"AuthorizationData": `{"idTag":"ABC123","idTagInfo":{"status":"Accepted"}}`,
+	"ChargingProfile": `{"chargingProfileId":1,"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile",` +
+		`"chargingProfileKind":"Relative","chargingSchedule":{"chargingRateUnit":"A",` +
+		`"chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}`,
+	"ChargingSchedule": `{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}`,
+	"IdTagInfo":        `{"status":"Accepted"}`,
+	"KeyValue":         `{"key":"HeartbeatInterval","readonly":false,"value":"300"}`,
+	"MeterValue":       `{"timestamp":"2025-01-02T03:04:05.000Z","sampledValue":[{"value":"1500"}]}`,
+}
+
+// sample returns a valid JSON value of the field type. With all set, optional
+// fields of nested objects are included as well.
+func (m *model) sample(ref *typeRef, all bool) string {
+	switch ref.kind {
+	case kindCiString, kindIdToken, kindString:
+		return `"ABC123"`
+	case kindDateTime:
+		return `"2025-01-02T03:04:05.000Z"`
+	case kindEnum:
+		return strconv.Quote(ref.enum.values[0])
+	case kindInt:
+		return "1"
+	case kindFloat:
+		return "1.5"
+	case kindBool:
+		return "true"
+	case kindList:
+		return "[" + m.sample(ref.elem, all) + "]"
+	default:
+		if shared, ok := sharedSamples[ref.label]; ok {
+			return shared
+		}
+
+		for _, object := range m.objects {
+			if object.name == ref.goType {
+				return m.samplePayload(object.fields, all, "", "")
+			}
+		}
+
+		return "{}"
+	}
+}
+
+// samplePayload returns a valid JSON object with the required fields, or every
+// field when all is set. The field named skip is left out and the field named
+// replace is given an invalid enumeration value.
+func (m *model) samplePayload(fields []field, all bool, skip, replace string) string {
+	members := make([]string, 0, len(fields))
+
+	for _, f := range fields {
+		if f.json == skip || (!f.required && !all) {
+			continue
+		}
+
+		value := m.sample(f.ref, all)
+		if f.json == replace {
+			value = strconv.Quote(invalidEnumValue)
+		}
+
+		members = append(members, strconv.Quote(f.json)+":"+value)
+	}
+
+	return "{" + strings.Join(members, ",") + "}"
+}
+
+// renderTests writes the tests of both messages and of the enumerations.
+func (m *model) renderTests(p *printer) {
+	m.renderMessageTests(p, m.request, "RequestMessage", "Request")
+	m.renderMessageTests(p, m.confirmation, "ConfirmationMessage", "Confirmation")
+
+	if len(m.enums) > 0 {
+		m.renderEnumTests(p)
+	}
+}
+
+// renderMessageTests writes a JSON round trip test of msg and a test rejecting
+// payloads without a required field or with an unknown enumeration value.
+func (m *model) renderMessageTests(p *printer, msg message, name, kind string) {
+	valid := []string{m.samplePayload(msg.fields, false, "", "")}
+	if full := m.samplePayload(msg.fields, true, "", ""); full != valid[0] {
+		valid = append(valid, full)
+	}
+
+	p.line("func Test%s%sJSON(t *testing.T) {", m.action, kind)
+	p.line("\tt.Parallel()")
+	p.line("")
+	p.line("\tfor _, data := range []string{")
+
+	for _, data := range valid {
+		p.line("\t\t`%s`,", data)
+	}
+
+	p.line("\t} {")
+	p.line("\t\tvar msg %s", name)
+	p.line("\t\tif err := json.Unmarshal([]byte(data), &msg); err != nil {")
+	p.line("\t\t\tt.Fatalf(\"%%s: unexpected error unmarshaling: %%v\", data, err)")
+	p.line("\t\t}")
+	p.line("")
+	p.line("\t\tout, err := json.Marshal(msg)")
+	p.line("\t\tif err != nil || string(out) != data {")
+	p.line("\t\t\tt.Errorf(\"unexpected JSON round trip:\\nwant: %%s\\ngot : %%s, %%v\", data, out, err)")
+	p.line("\t\t}")
+	p.line("\t}")
+	p.line("}")
+	p.line("")
+
+	type invalidCase struct{ data, path, constraint string }
+
+	var invalid []invalidCase
+
+	for _, f := range msg.fields {
+		if f.required {
+			invalid = append(invalid, invalidCase{
+				m.samplePayload(msg.fields, false, f.json, ""), f.json, "types.ConstraintRequired",
+			})
+		}
+
+		if f.ref.kind == kindEnum {
+			invalid = append(invalid, invalidCase{
+				m.samplePayload(msg.fields, true, "", f.json), f.json, "types.ConstraintEnum",
+			})
+		}
+	}
+
+	p.line("func Test%s%sJSONInvalid(t *testing.T) {", m.action, kind)
+	p.line("\tt.Parallel()")
+	p.line("")
+
+	if len(invalid) > 0 {
+		p.line("\ttests := []struct {")
+		p.line("\t\tdata       string")
+		p.line("\t\tpath       string")
+		p.line("\t\tconstraint string")
+		p.line("\t}{")
+
+		for _, tc := range invalid {
+			p.line("\t\t{`%s`, %q, %s},", tc.data, tc.path, tc.constraint)
+		}
+
+		p.line("\t}")
+		p.line("")
+		p.line("\tfor _, tc := range tests {")
+		p.line("\t\tvar msg %s", name)
+		p.line("")
+		p.line("\t\terr := json.Unmarshal([]byte(tc.data), &msg)")
+		p.line("")
+		p.line("\t\tvar verr *types.ValidationError")
+		p.line("\t\tif !errors.As(err, &verr) || verr.Path != tc.path || verr.Constraint != tc.constraint {")
+		p.line("\t\t\tt.Errorf(\"%%s: expected %%s failure at %%s, got %%v\", tc.data, tc.constraint, tc.path, err)")
+		p.line("\t\t}")
+		p.line("\t}")
+		p.line("")
+	}
+
+	p.line("\tvar msg %s", name)
+	p.line("\tif err := json.Unmarshal([]byte(`[]`), &msg); err == nil {")
+	p.line("\t\tt.Error(\"expected error for non-object payload, got nil\")")
+	p.line("\t}")
+	p.line("}")
+	p.line("")
+}
+
+// renderEnumTests writes a test of every enumeration, checking all its values and
+// rejecting an empty and an unknown value.
+func (m *model) renderEnumTests(p *printer) {
+	for _, enum := range m.enums {
+		p.line("func Test%s(t *testing.T) {", enum.name)
+		p.line("\tt.Parallel()")
+		p.line("")
+		p.line("\tenumtest.Run(t, enumtest.Case[%s]{", enum.name)
+		p.line("\t\tValues: []%s{", enum.name)
+		p.line("\t\t\t%s,", caseList(enum))
+		p.line("\t\t},")
+		p.line("\t\tInvalid:  []string{\"\", %q},", invalidEnumValue)
+		p.line("\t\tSentinel: ErrInvalid%s,", enum.name)
+		p.line("\t\tParse:    Parse%s,", enum.name)
+		p.line("\t})")
+		p.line("}")
+		p.line("")
+	}
+}
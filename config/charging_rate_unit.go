@@ -0,0 +1,57 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidChargingRateUnit indicates that a ChargingRateUnit is not Current or
+// Power.
+var ErrInvalidChargingRateUnit = errors.New("invalid charging rate unit")
+
+// ChargingRateUnit is a unit a Charge Point accepts in charging schedules, as listed
+// in ChargingScheduleAllowedChargingRateUnit.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 9.4: Smart Charging Profile, ChargingScheduleAllowedChargingRateUnit
+type ChargingRateUnit string
+
+const (
+	// ChargingRateUnitCurrent means schedules may limit the current, in A.
+	ChargingRateUnitCurrent ChargingRateUnit = "Current"
+
+	// ChargingRateUnitPower means schedules may limit the power, in W.
+	ChargingRateUnitPower ChargingRateUnit = "Power"
+)
+
+// IsValid returns true if the ChargingRateUnit is Current or Power.
+func (u ChargingRateUnit) IsValid() bool {
+	return u == ChargingRateUnitCurrent || u == ChargingRateUnitPower
+}
+
+// String returns the wire value of the ChargingRateUnit.
+func (u ChargingRateUnit) String() string {
+	return string(u)
+}
+
+// Type returns the chargingRateUnit of charging schedules that corresponds to the
+// ChargingRateUnit: types.ChargingRateUnitTypeA for Current and
+// types.ChargingRateUnitTypeW for Power.
+func (u ChargingRateUnit) Type() types.ChargingRateUnitType {
+	if u == ChargingRateUnitPower {
+		return types.ChargingRateUnitTypeW
+	}
+
+	return types.ChargingRateUnitTypeA
+}
+
+// parseChargingRateUnit converts a wire value into a ChargingRateUnit, rejecting
+// unknown values.
+func parseChargingRateUnit(value string) (ChargingRateUnit, error) {
+	if !ChargingRateUnit(value).IsValid() {
+		return "", types.EnumError("", value, ErrInvalidChargingRateUnit)
+	}
+
+	return ChargingRateUnit(value), nil
+}
@@ -0,0 +1,28 @@
+// Package config catalogs the standard configuration keys of OCPP 1.6J and parses
+// their values.
+//
+// Charge Points expose their configuration as string key/value pairs, read with
+// GetConfiguration.req and changed with ChangeConfiguration.req. The specification
+// defines a set of standard keys, each belonging to a feature profile, readable
+// (R) or also writable (RW), with a value type (boolean, integer or a
+// comma-separated list of allowed values), an optional unit, and required or
+// optional for Charge Points implementing the profile.
+//
+// Lookup and Definitions expose that catalog. Parse checks a value against the
+// definition of its key, so that a wrongly formatted value is rejected before it is
+// sent to a Charge Point that would otherwise refuse or ignore it. Change builds a
+// ChangeConfiguration.req from a validated value, ValidateChange checks an existing
+// request, and ParseConfiguration reads the values reported in a
+// GetConfiguration.conf.
+//
+// Keys outside the catalog are vendor-specific: Lookup and Parse report them with
+// ErrUnknownKey, while ValidateChange and ParseConfiguration leave them unchecked.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 3.1: Feature Profiles
+//   - OCPP 1.6J, Section 9: Standard Configuration Key Names & Values
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/config"
+package config
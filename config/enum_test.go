@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestProfileIsValid(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []Profile{
+		ProfileCore, ProfileFirmwareManagement, ProfileLocalAuthListManagement,
+		ProfileReservation, ProfileSmartCharging, ProfileRemoteTrigger,
+	} {
+		if parsed, err := parseProfile(value.String()); err != nil || parsed != value {
+			t.Errorf("unexpected parseProfile result: %s, %v", parsed, err)
+		}
+	}
+
+	if _, err := parseProfile("core"); !errors.Is(err, ErrInvalidProfile) {
+		t.Errorf("expected ErrInvalidProfile, got %v", err)
+	}
+}
+
+func TestConnectorPhaseRotation(t *testing.T) {
+	t.Parallel()
+
+	rotation, err := parseConnectorPhaseRotation("2.STR")
+	if err != nil || rotation.String() != "2.STR" {
+		t.Errorf("unexpected phase rotation: %v, %v", rotation, err)
+	}
+
+	for _, value := range []string{"", "RST", "x.RST", "1.rst", "1.RST.2"} {
+		if _, err := parseConnectorPhaseRotation(value); !errors.Is(err, ErrInvalidPhaseRotation) {
+			t.Errorf("%q: expected ErrInvalidPhaseRotation, got %v", value, err)
+		}
+	}
+}
+
+func TestChargingRateUnit(t *testing.T) {
+	t.Parallel()
+
+	if ChargingRateUnitCurrent.Type() != types.ChargingRateUnitTypeA || ChargingRateUnitPower.Type() != types.ChargingRateUnitTypeW {
+		t.Error("unexpected charging schedule units")
+	}
+
+	if _, err := parseChargingRateUnit("W"); !errors.Is(err, ErrInvalidChargingRateUnit) {
+		t.Errorf("expected ErrInvalidChargingRateUnit, got %v", err)
+	}
+}
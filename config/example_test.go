@@ -0,0 +1,48 @@
+package config_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/config"
+	"github.com/aasanchez/ocpp16messages/messages/getconfiguration"
+)
+
+func ExampleChange() {
+	req, err := config.Change("MeterValuesSampledData", "Energy.Active.Import.Register, Power.Active.Import")
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+
+	fmt.Println(req.Value)
+
+	if _, err := config.Change("HeartbeatInterval", "5 minutes"); errors.Is(err, config.ErrInvalidValue) {
+		fmt.Println(err)
+	}
+	// Output:
+	// Energy.Active.Import.Register,Power.Active.Import
+	// value: invalid configuration value: "5 minutes" is not an integer
+}
+
+func ExampleParseConfiguration() {
+	data := `{"configurationKey":[{"key":"HeartbeatInterval","readonly":false,"value":"300"},` +
+		`{"key":"SupportedFeatureProfiles","readonly":true,"value":"Core,SmartCharging"}]}`
+
+	var conf getconfiguration.ConfirmationMessage
+	if err := json.Unmarshal([]byte(data), &conf); err != nil {
+		log.Fatalf("failed to decode confirmation: %v", err)
+	}
+
+	configuration, err := config.ParseConfiguration(conf)
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+
+	fmt.Println(configuration[config.KeyHeartbeatInterval].Int())
+	fmt.Println(configuration[config.KeySupportedFeatureProfiles].Profiles())
+	// Output:
+	// 300
+	// [Core SmartCharging]
+}
@@ -0,0 +1,368 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrUnknownKey indicates that a configuration key is not one of the standard keys
+// of OCPP 1.6J.
+var ErrUnknownKey = errors.New("unknown configuration key")
+
+// Key is the name of a standard configuration key.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 9: Standard Configuration Key Names & Values
+type Key string
+
+// Standard configuration keys of the Core Profile.
+const (
+	// KeyAllowOfflineTxForUnknownId allows transactions for unknown identifiers
+	// while offline.
+	KeyAllowOfflineTxForUnknownId Key = "AllowOfflineTxForUnknownId"
+
+	// KeyAuthorizationCacheEnabled enables the Authorization Cache.
+	KeyAuthorizationCacheEnabled Key = "AuthorizationCacheEnabled"
+
+	// KeyAuthorizeRemoteTxRequests makes remotely started transactions go through
+	// authorization first. Charge Points may expose it as read-only.
+	KeyAuthorizeRemoteTxRequests Key = "AuthorizeRemoteTxRequests"
+
+	// KeyBlinkRepeat is the number of times to blink the Charge Point lighting
+	// when signalling.
+	KeyBlinkRepeat Key = "BlinkRepeat"
+
+	// KeyClockAlignedDataInterval is the interval between clock-aligned meter
+	// values, 0 disables them.
+	KeyClockAlignedDataInterval Key = "ClockAlignedDataInterval"
+
+	// KeyConnectionTimeOut is the time to plug in the cable after the identifier
+	// was presented.
+	KeyConnectionTimeOut Key = "ConnectionTimeOut"
+
+	// KeyConnectorPhaseRotation lists the phase rotation of every connector.
+	KeyConnectorPhaseRotation Key = "ConnectorPhaseRotation"
+
+	// KeyConnectorPhaseRotationMaxLength is the maximum number of entries of
+	// ConnectorPhaseRotation.
+	KeyConnectorPhaseRotationMaxLength Key = "ConnectorPhaseRotationMaxLength"
+
+	// KeyGetConfigurationMaxKeys is the maximum number of keys in a
+	// GetConfiguration.req.
+	KeyGetConfigurationMaxKeys Key = "GetConfigurationMaxKeys"
+
+	// KeyHeartbeatInterval is the interval between Heartbeat.req messages when
+	// idle.
+	KeyHeartbeatInterval Key = "HeartbeatInterval"
+
+	// KeyLightIntensity is the intensity of the Charge Point lighting.
+	KeyLightIntensity Key = "LightIntensity"
+
+	// KeyLocalAuthorizeOffline allows starting transactions for locally authorized
+	// identifiers while offline.
+	KeyLocalAuthorizeOffline Key = "LocalAuthorizeOffline"
+
+	// KeyLocalPreAuthorize allows starting transactions for locally authorized
+	// identifiers without waiting for the Central System.
+	KeyLocalPreAuthorize Key = "LocalPreAuthorize"
+
+	// KeyMaxEnergyOnInvalidId is the energy delivered after an identifier was
+	// invalidated by the Central System.
+	KeyMaxEnergyOnInvalidId Key = "MaxEnergyOnInvalidId"
+
+	// KeyMeterValuesAlignedData lists the measurands of clock-aligned
+	// MeterValues.req messages.
+	KeyMeterValuesAlignedData Key = "MeterValuesAlignedData"
+
+	// KeyMeterValuesAlignedDataMaxLength is the maximum number of entries of
+	// MeterValuesAlignedData.
+	KeyMeterValuesAlignedDataMaxLength Key = "MeterValuesAlignedDataMaxLength"
+
+	// KeyMeterValuesSampledData lists the measurands of sampled MeterValues.req
+	// messages.
+	KeyMeterValuesSampledData Key = "MeterValuesSampledData"
+
+	// KeyMeterValuesSampledDataMaxLength is the maximum number of entries of
+	// MeterValuesSampledData.
+	KeyMeterValuesSampledDataMaxLength Key = "MeterValuesSampledDataMaxLength"
+
+	// KeyMeterValueSampleInterval is the interval between sampled meter values
+	// during a transaction, 0 disables them.
+	KeyMeterValueSampleInterval Key = "MeterValueSampleInterval"
+
+	// KeyMinimumStatusDuration is the time a status must last before a
+	// StatusNotification.req is sent.
+	KeyMinimumStatusDuration Key = "MinimumStatusDuration"
+
+	// KeyNumberOfConnectors is the number of physical connectors of the Charge
+	// Point.
+	KeyNumberOfConnectors Key = "NumberOfConnectors"
+
+	// KeyResetRetries is the number of attempts to reset the Charge Point.
+	KeyResetRetries Key = "ResetRetries"
+
+	// KeyStopTransactionOnEVSideDisconnect stops the transaction when the cable is
+	// unplugged from the EV.
+	KeyStopTransactionOnEVSideDisconnect Key = "StopTransactionOnEVSideDisconnect"
+
+	// KeyStopTransactionOnInvalidId stops the transaction when the Central System
+	// rejects its identifier.
+	KeyStopTransactionOnInvalidId Key = "StopTransactionOnInvalidId"
+
+	// KeyStopTxnAlignedData lists the clock-aligned measurands included in
+	// StopTransaction.req.
+	KeyStopTxnAlignedData Key = "StopTxnAlignedData"
+
+	// KeyStopTxnAlignedDataMaxLength is the maximum number of entries of
+	// StopTxnAlignedData.
+	KeyStopTxnAlignedDataMaxLength Key = "StopTxnAlignedDataMaxLength"
+
+	// KeyStopTxnSampledData lists the sampled measurands included in
+	// StopTransaction.req.
+	KeyStopTxnSampledData Key = "StopTxnSampledData"
+
+	// KeyStopTxnSampledDataMaxLength is the maximum number of entries of
+	// StopTxnSampledData.
+	KeyStopTxnSampledDataMaxLength Key = "StopTxnSampledDataMaxLength"
+
+	// KeySupportedFeatureProfiles lists the feature profiles implemented by the
+	// Charge Point.
+	KeySupportedFeatureProfiles Key = "SupportedFeatureProfiles"
+
+	// KeySupportedFeatureProfilesMaxLength is the maximum number of entries of
+	// SupportedFeatureProfiles.
+	KeySupportedFeatureProfilesMaxLength Key = "SupportedFeatureProfilesMaxLength"
+
+	// KeyTransactionMessageAttempts is the number of attempts to deliver a
+	// transaction-related message.
+	KeyTransactionMessageAttempts Key = "TransactionMessageAttempts"
+
+	// KeyTransactionMessageRetryInterval is the base interval between attempts to
+	// deliver a transaction-related message.
+	KeyTransactionMessageRetryInterval Key = "TransactionMessageRetryInterval"
+
+	// KeyUnlockConnectorOnEVSideDisconnect unlocks the connector when the cable is
+	// unplugged from the EV.
+	KeyUnlockConnectorOnEVSideDisconnect Key = "UnlockConnectorOnEVSideDisconnect"
+
+	// KeyWebSocketPingInterval is the interval between WebSocket pings, 0 disables
+	// them.
+	KeyWebSocketPingInterval Key = "WebSocketPingInterval"
+)
+
+// Standard configuration keys of the Local Auth List Management Profile.
+const (
+	// KeyLocalAuthListEnabled enables the Local Authorization List.
+	KeyLocalAuthListEnabled Key = "LocalAuthListEnabled"
+
+	// KeyLocalAuthListMaxLength is the maximum number of identifiers in the Local
+	// Authorization List.
+	KeyLocalAuthListMaxLength Key = "LocalAuthListMaxLength"
+
+	// KeySendLocalListMaxLength is the maximum number of identifiers in a
+	// SendLocalList.req.
+	KeySendLocalListMaxLength Key = "SendLocalListMaxLength"
+)
+
+// Standard configuration keys of the Reservation Profile.
+const (
+	// KeyReserveConnectorZeroSupported tells whether connector 0 can be reserved.
+	KeyReserveConnectorZeroSupported Key = "ReserveConnectorZeroSupported"
+)
+
+// Standard configuration keys of the Smart Charging Profile.
+const (
+	// KeyChargeProfileMaxStackLevel is the highest stackLevel of a charging
+	// profile.
+	KeyChargeProfileMaxStackLevel Key = "ChargeProfileMaxStackLevel"
+
+	// KeyChargingScheduleAllowedChargingRateUnit lists the units accepted in
+	// charging schedules.
+	KeyChargingScheduleAllowedChargingRateUnit Key = "ChargingScheduleAllowedChargingRateUnit"
+
+	// KeyChargingScheduleMaxPeriods is the maximum number of periods of a charging
+	// schedule.
+	KeyChargingScheduleMaxPeriods Key = "ChargingScheduleMaxPeriods"
+
+	// KeyConnectorSwitch3to1PhaseSupported tells whether the number of phases can
+	// change during a transaction.
+	KeyConnectorSwitch3to1PhaseSupported Key = "ConnectorSwitch3to1PhaseSupported"
+
+	// KeyMaxChargingProfilesInstalled is the maximum number of installed charging
+	// profiles.
+	KeyMaxChargingProfilesInstalled Key = "MaxChargingProfilesInstalled"
+)
+
+// String returns the name of the Key.
+func (k Key) String() string {
+	return string(k)
+}
+
+// Accessibility tells whether the Central System can change a configuration key.
+type Accessibility string
+
+const (
+	// AccessibilityReadOnly keys can only be read with GetConfiguration.req.
+	AccessibilityReadOnly Accessibility = "R"
+
+	// AccessibilityReadWrite keys can also be changed with ChangeConfiguration.req.
+	AccessibilityReadWrite Accessibility = "RW"
+)
+
+// ValueType is the format of the value of a configuration key.
+type ValueType string
+
+const (
+	// ValueTypeBoolean values are "true" or "false".
+	ValueTypeBoolean ValueType = "boolean"
+
+	// ValueTypeInteger values are non-negative decimal integers.
+	ValueTypeInteger ValueType = "integer"
+
+	// ValueTypeMeasurandList values are comma-separated lists of types.Measurand.
+	ValueTypeMeasurandList ValueType = "CSL of Measurand"
+
+	// ValueTypePhaseRotationList values are comma-separated lists of
+	// ConnectorPhaseRotation entries, such as "0.RST,1.RTS".
+	ValueTypePhaseRotationList ValueType = "CSL of ConnectorPhaseRotation"
+
+	// ValueTypeProfileList values are comma-separated lists of Profile.
+	ValueTypeProfileList ValueType = "CSL of Profile"
+
+	// ValueTypeChargingRateUnitList values are comma-separated lists of
+	// ChargingRateUnit.
+	ValueTypeChargingRateUnitList ValueType = "CSL of ChargingRateUnit"
+)
+
+// Unit is the unit of an integer configuration value.
+type Unit string
+
+const (
+	// UnitNone is used for values without a unit, such as counts and lists.
+	UnitNone Unit = ""
+
+	// UnitSeconds is used for durations and intervals.
+	UnitSeconds Unit = "seconds"
+
+	// UnitTimes is used for numbers of attempts and repetitions.
+	UnitTimes Unit = "times"
+
+	// UnitPercent is used for percentages, from 0 to 100.
+	UnitPercent Unit = "%"
+
+	// UnitWattHours is used for amounts of energy.
+	UnitWattHours Unit = "Wh"
+)
+
+// Definition describes a standard configuration key.
+type Definition struct {
+	// Key is the name of the configuration key.
+	Key Key
+
+	// Profile is the feature profile the key belongs to.
+	Profile Profile
+
+	// Accessibility tells whether the key can be changed by the Central System.
+	Accessibility Accessibility
+
+	// Type is the format of the value of the key.
+	Type ValueType
+
+	// Unit is the unit of an integer value.
+	Unit Unit
+
+	// Required is true when every Charge Point implementing Profile must support the
+	// key.
+	Required bool
+}
+
+// Writable returns true if the key can be changed with ChangeConfiguration.req.
+func (d Definition) Writable() bool {
+	return d.Accessibility == AccessibilityReadWrite
+}
+
+// catalog lists the standard configuration keys in the order of the specification.
+var catalog = []Definition{
+	{Key: KeyAllowOfflineTxForUnknownId, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeBoolean, Unit: UnitNone, Required: false},
+	{Key: KeyAuthorizationCacheEnabled, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeBoolean, Unit: UnitNone, Required: false},
+	{Key: KeyAuthorizeRemoteTxRequests, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeBoolean, Unit: UnitNone, Required: true},
+	{Key: KeyBlinkRepeat, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitTimes, Required: false},
+	{Key: KeyClockAlignedDataInterval, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitSeconds, Required: true},
+	{Key: KeyConnectionTimeOut, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitSeconds, Required: true},
+	{Key: KeyConnectorPhaseRotation, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypePhaseRotationList, Unit: UnitNone, Required: true},
+	{Key: KeyConnectorPhaseRotationMaxLength, Profile: ProfileCore, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: false},
+	{Key: KeyGetConfigurationMaxKeys, Profile: ProfileCore, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: true},
+	{Key: KeyHeartbeatInterval, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitSeconds, Required: true},
+	{Key: KeyLightIntensity, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitPercent, Required: false},
+	{Key: KeyLocalAuthorizeOffline, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeBoolean, Unit: UnitNone, Required: true},
+	{Key: KeyLocalPreAuthorize, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeBoolean, Unit: UnitNone, Required: true},
+	{Key: KeyMaxEnergyOnInvalidId, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitWattHours, Required: false},
+	{Key: KeyMeterValuesAlignedData, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeMeasurandList, Unit: UnitNone, Required: true},
+	{Key: KeyMeterValuesAlignedDataMaxLength, Profile: ProfileCore, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: false},
+	{Key: KeyMeterValuesSampledData, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeMeasurandList, Unit: UnitNone, Required: true},
+	{Key: KeyMeterValuesSampledDataMaxLength, Profile: ProfileCore, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: false},
+	{Key: KeyMeterValueSampleInterval, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitSeconds, Required: true},
+	{Key: KeyMinimumStatusDuration, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitSeconds, Required: false},
+	{Key: KeyNumberOfConnectors, Profile: ProfileCore, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: true},
+	{Key: KeyResetRetries, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitTimes, Required: true},
+	{Key: KeyStopTransactionOnEVSideDisconnect, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeBoolean, Unit: UnitNone, Required: true},
+	{Key: KeyStopTransactionOnInvalidId, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeBoolean, Unit: UnitNone, Required: true},
+	{Key: KeyStopTxnAlignedData, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeMeasurandList, Unit: UnitNone, Required: true},
+	{Key: KeyStopTxnAlignedDataMaxLength, Profile: ProfileCore, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: false},
+	{Key: KeyStopTxnSampledData, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeMeasurandList, Unit: UnitNone, Required: true},
+	{Key: KeyStopTxnSampledDataMaxLength, Profile: ProfileCore, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: false},
+	{Key: KeySupportedFeatureProfiles, Profile: ProfileCore, Accessibility: AccessibilityReadOnly, Type: ValueTypeProfileList, Unit: UnitNone, Required: true},
+	{Key: KeySupportedFeatureProfilesMaxLength, Profile: ProfileCore, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: false},
+	{Key: KeyTransactionMessageAttempts, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitTimes, Required: true},
+	{Key: KeyTransactionMessageRetryInterval, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitSeconds, Required: true},
+	{Key: KeyUnlockConnectorOnEVSideDisconnect, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeBoolean, Unit: UnitNone, Required: true},
+	{Key: KeyWebSocketPingInterval, Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: ValueTypeInteger, Unit: UnitSeconds, Required: false},
+
+	{Key: KeyLocalAuthListEnabled, Profile: ProfileLocalAuthListManagement, Accessibility: AccessibilityReadWrite, Type: ValueTypeBoolean, Unit: UnitNone, Required: true},
+	{Key: KeyLocalAuthListMaxLength, Profile: ProfileLocalAuthListManagement, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: true},
+	{Key: KeySendLocalListMaxLength, Profile: ProfileLocalAuthListManagement, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: true},
+
+	{Key: KeyReserveConnectorZeroSupported, Profile: ProfileReservation, Accessibility: AccessibilityReadOnly, Type: ValueTypeBoolean, Unit: UnitNone, Required: false},
+
+	{Key: KeyChargeProfileMaxStackLevel, Profile: ProfileSmartCharging, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: true},
+	{Key: KeyChargingScheduleAllowedChargingRateUnit, Profile: ProfileSmartCharging, Accessibility: AccessibilityReadOnly, Type: ValueTypeChargingRateUnitList, Unit: UnitNone, Required: true},
+	{Key: KeyChargingScheduleMaxPeriods, Profile: ProfileSmartCharging, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: true},
+	{Key: KeyConnectorSwitch3to1PhaseSupported, Profile: ProfileSmartCharging, Accessibility: AccessibilityReadOnly, Type: ValueTypeBoolean, Unit: UnitNone, Required: false},
+	{Key: KeyMaxChargingProfilesInstalled, Profile: ProfileSmartCharging, Accessibility: AccessibilityReadOnly, Type: ValueTypeInteger, Unit: UnitNone, Required: true},
+}
+
+// index maps the lower-case name of every standard key to its definition, since
+// configuration keys are case-insensitive.
+var index = indexCatalog(catalog)
+
+// indexCatalog builds the index of definitions.
+func indexCatalog(definitions []Definition) map[string]Definition {
+	byName := make(map[string]Definition, len(definitions))
+	for _, definition := range definitions {
+		byName[strings.ToLower(definition.Key.String())] = definition
+	}
+
+	return byName
+}
+
+// Lookup returns the Definition of the standard configuration key named key,
+// ignoring case.
+//
+// It returns an error wrapping ErrUnknownKey if key is not a standard key, for
+// example because it is vendor-specific.
+func Lookup(key string) (Definition, error) {
+	definition, ok := index[strings.ToLower(key)]
+	if !ok {
+		return Definition{}, fmt.Errorf("%w: %s", ErrUnknownKey, key)
+	}
+
+	return definition, nil
+}
+
+// Definitions returns the definitions of every standard configuration key, grouped
+// by feature profile in the order of the specification.
+func Definitions() []Definition {
+	return slices.Clone(catalog)
+}
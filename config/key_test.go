@@ -0,0 +1,56 @@
+package config
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	definition, err := Lookup("heartbeatinterval")
+	if err != nil {
+		t.Fatalf("unexpected error looking up HeartbeatInterval: %v", err)
+	}
+
+	want := Definition{
+		Key:           KeyHeartbeatInterval,
+		Profile:       ProfileCore,
+		Accessibility: AccessibilityReadWrite,
+		Type:          ValueTypeInteger,
+		Unit:          UnitSeconds,
+		Required:      true,
+	}
+	if definition != want || !definition.Writable() {
+		t.Errorf("unexpected definition: %+v", definition)
+	}
+
+	if _, err := Lookup("VendorSpecificKey"); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("expected ErrUnknownKey, got %v", err)
+	}
+}
+
+func TestDefinitions(t *testing.T) {
+	t.Parallel()
+
+	definitions := Definitions()
+	if len(definitions) != len(index) {
+		t.Fatalf("expected %d unique keys, got %d definitions", len(index), len(definitions))
+	}
+
+	for _, definition := range definitions {
+		switch {
+		case !definition.Profile.IsValid():
+			t.Errorf("%s: invalid profile %q", definition.Key, definition.Profile)
+		case definition.Accessibility != AccessibilityReadOnly && definition.Accessibility != AccessibilityReadWrite:
+			t.Errorf("%s: invalid accessibility %q", definition.Key, definition.Accessibility)
+		case definition.Unit != UnitNone && definition.Type != ValueTypeInteger:
+			t.Errorf("%s: unit %q on a non-integer key", definition.Key, definition.Unit)
+		}
+	}
+
+	definitions[0].Required = !definitions[0].Required
+	if catalog[0].Required == definitions[0].Required {
+		t.Error("expected Definitions to return a copy of the catalog")
+	}
+}
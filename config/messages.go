@@ -0,0 +1,143 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aasanchez/ocpp16messages/messages/changeconfiguration"
+	"github.com/aasanchez/ocpp16messages/messages/getconfiguration"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConstraintReadOnly is reported in types.ValidationError.Constraint when a
+// read-only key is changed, or reported as writable by a Charge Point.
+const ConstraintReadOnly = "readOnly"
+
+// ErrReadOnlyKey indicates that a read-only configuration key is changed, or
+// reported as writable.
+var ErrReadOnlyKey = errors.New("configuration key is read-only")
+
+// Change builds a ChangeConfiguration.req setting the standard key named key to
+// value.
+//
+// The value is checked with Parse and sent in its canonical form, so that for
+// example "TRUE" is sent as "true". It returns an error wrapping ErrUnknownKey for a
+// key outside the catalog, ErrReadOnlyKey for a read-only key, or ErrInvalidValue
+// for a value that does not match the definition of the key.
+//
+// Example usage:
+//
+//	req, err := config.Change("MeterValuesSampledData", "Energy.Active.Import.Register, Power.Active.Import")
+//	if err != nil {
+//	    log.Fatalf("invalid configuration: %v", err)
+//	}
+func Change(key, value string) (changeconfiguration.RequestMessage, error) {
+	parsed, err := Parse(key, value)
+	if err == nil {
+		err = readOnlyError("key", parsed.Definition(), true)
+	}
+
+	if err != nil {
+		return changeconfiguration.RequestMessage{}, err
+	}
+
+	return changeconfiguration.Request(parsed.Definition().Key.String(), parsed.String())
+}
+
+// ValidateChange checks a ChangeConfiguration.req against the catalog.
+//
+// Requests for keys outside the catalog are vendor-specific and always pass. For a
+// standard key, the key must be writable and the value must match its definition;
+// all failures are reported together, as *types.ValidationError values at path
+// "key" or "value".
+func ValidateChange(req changeconfiguration.RequestMessage) error {
+	definition, err := Lookup(req.Key.String())
+	if err != nil {
+		return nil
+	}
+
+	_, valueErr := definition.Parse(req.Value.String())
+
+	return types.Join(readOnlyError("key", definition, true), valueErr)
+}
+
+// Configuration holds the values of the standard configuration keys reported by a
+// Charge Point.
+type Configuration map[Key]Value
+
+// ParseConfiguration reads the values of the standard configuration keys reported
+// in a GetConfiguration.conf.
+//
+// Keys outside the catalog and keys reported without a value are skipped. A value
+// that does not match the definition of its key, and a read-only key reported as
+// writable, are reported as *types.ValidationError values with their JSON path, for
+// example "configurationKey[2].value". All failures are reported together, and the
+// returned Configuration holds every valid value even when the error is not nil.
+func ParseConfiguration(conf getconfiguration.ConfirmationMessage) (Configuration, error) {
+	configuration := make(Configuration, len(conf.ConfigurationKey))
+	errs := make([]error, 0, len(conf.ConfigurationKey))
+
+	for i, kv := range conf.ConfigurationKey {
+		definition, err := Lookup(kv.Key.String())
+		if err != nil {
+			continue
+		}
+
+		path := types.ElementPath("configurationKey", i)
+		errs = append(errs, types.WithPath(path, readOnlyError("readonly", definition, !kv.Readonly)))
+
+		if kv.Value == nil {
+			continue
+		}
+
+		value, err := definition.Parse(kv.Value.String())
+		if err != nil {
+			errs = append(errs, types.WithPath(path, err))
+
+			continue
+		}
+
+		configuration[definition.Key] = value
+	}
+
+	return configuration, types.Join(errs...)
+}
+
+// readOnlyError reports a read-only key at path when writable is true.
+func readOnlyError(path string, definition Definition, writable bool) error {
+	if !writable || definition.Writable() {
+		return nil
+	}
+
+	return &types.ValidationError{
+		Path:       path,
+		Constraint: ConstraintReadOnly,
+		Value:      definition.Key,
+		Err:        fmt.Errorf("%w: %s", ErrReadOnlyKey, definition.Key),
+	}
+}
+
+// Missing returns the required keys of profiles that have no value in the
+// Configuration, in the order of the specification. Without profiles, the Core
+// profile is checked.
+//
+// It is meant to be used on the Configuration of a GetConfiguration.req without
+// keys, to which a Charge Point answers with its whole configuration.
+func (c Configuration) Missing(profiles ...Profile) []Key {
+	if len(profiles) == 0 {
+		profiles = []Profile{ProfileCore}
+	}
+
+	var missing []Key
+
+	for _, definition := range catalog {
+		if _, ok := c[definition.Key]; ok || !definition.Required || !slices.Contains(profiles, definition.Profile) {
+			continue
+		}
+
+		missing = append(missing, definition.Key)
+	}
+
+	return missing
+}
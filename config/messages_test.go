@@ -0,0 +1,109 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/messages/changeconfiguration"
+	"github.com/aasanchez/ocpp16messages/messages/getconfiguration"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestChange(t *testing.T) {
+	t.Parallel()
+
+	req, err := Change("localauthlistenabled", "TRUE")
+	if err != nil {
+		t.Fatalf("unexpected error building request: %v", err)
+	}
+
+	if req.String() != "ChangeConfiguration.req{key=LocalAuthListEnabled, value=true}" {
+		t.Errorf("unexpected request: %s", req.String())
+	}
+
+	var verr *types.ValidationError
+	if _, err := Change("NumberOfConnectors", "2"); !errors.Is(err, ErrReadOnlyKey) || !errors.As(err, &verr) || verr.Path != "key" {
+		t.Errorf("expected read-only key failure, got %v", err)
+	}
+
+	if _, err := Change("HeartbeatInterval", "often"); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("expected ErrInvalidValue, got %v", err)
+	}
+
+	if _, err := Change("VendorSpecificKey", "1"); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("expected ErrUnknownKey, got %v", err)
+	}
+}
+
+func TestValidateChange(t *testing.T) {
+	t.Parallel()
+
+	valid, _ := changeconfiguration.Request("MeterValueSampleInterval", "60")
+	vendor, _ := changeconfiguration.Request("VendorSpecificKey", "anything")
+
+	for _, req := range []changeconfiguration.RequestMessage{valid, vendor} {
+		if err := ValidateChange(req); err != nil {
+			t.Errorf("%s: unexpected error: %v", req, err)
+		}
+	}
+
+	invalid, _ := changeconfiguration.Request("GetConfigurationMaxKeys", "ten")
+
+	err := ValidateChange(invalid)
+	if !errors.Is(err, ErrReadOnlyKey) || !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("expected read-only key and invalid value failures, got %v", err)
+	}
+}
+
+func TestParseConfiguration(t *testing.T) {
+	t.Parallel()
+
+	data := `{"configurationKey":[` +
+		`{"key":"HeartbeatInterval","readonly":false,"value":"300"},` +
+		`{"key":"NumberOfConnectors","readonly":false,"value":"2"},` +
+		`{"key":"MeterValuesSampledData","readonly":false,"value":"Energy"},` +
+		`{"key":"LocalPreAuthorize","readonly":false},` +
+		`{"key":"VendorSpecificKey","readonly":true,"value":"x"}]}`
+
+	var conf getconfiguration.ConfirmationMessage
+	if err := json.Unmarshal([]byte(data), &conf); err != nil {
+		t.Fatalf("unexpected error decoding confirmation: %v", err)
+	}
+
+	configuration, err := ParseConfiguration(conf)
+
+	paths := make([]string, 0, 2)
+
+	for _, e := range []error{ErrReadOnlyKey, ErrInvalidValue} {
+		var verr *types.ValidationError
+		if !errors.Is(err, e) {
+			t.Errorf("expected %v in %v", e, err)
+		} else if errors.As(err, &verr) {
+			paths = append(paths, verr.Path)
+		}
+	}
+
+	if len(paths) == 0 || paths[0] != "configurationKey[1].readonly" {
+		t.Errorf("expected the first failure at configurationKey[1].readonly, got %v", paths)
+	}
+
+	if len(configuration) != 2 || configuration[KeyHeartbeatInterval].Int() != 300 || configuration[KeyNumberOfConnectors].Int() != 2 {
+		t.Errorf("unexpected configuration: %v", configuration)
+	}
+
+	missing := configuration.Missing()
+	if !slices.Contains(missing, KeyLocalPreAuthorize) || !slices.Contains(missing, KeyMeterValuesSampledData) ||
+		slices.Contains(missing, KeyHeartbeatInterval) || slices.Contains(missing, KeyWebSocketPingInterval) {
+		t.Errorf("unexpected missing keys: %v", missing)
+	}
+
+	if got := configuration.Missing(ProfileReservation); got != nil {
+		t.Errorf("expected no required Reservation keys, got %v", got)
+	}
+
+	if got := configuration.Missing(ProfileLocalAuthListManagement); len(got) != 3 {
+		t.Errorf("expected 3 missing Local Auth List Management keys, got %v", got)
+	}
+}
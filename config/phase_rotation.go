@@ -0,0 +1,94 @@
+package config
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidPhaseRotation indicates that a PhaseRotation is not one of the values
+// defined by OCPP 1.6J.
+var ErrInvalidPhaseRotation = errors.New("invalid phase rotation")
+
+// PhaseRotation is the order in which the phases of a connector are wired with
+// respect to its meter, as reported in ConnectorPhaseRotation. R, S and T stand for
+// the phases L1, L2 and L3.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 9.1: Core Profile, ConnectorPhaseRotation
+type PhaseRotation string
+
+const (
+	// PhaseRotationNotApplicable is used for single phase and DC connectors.
+	PhaseRotationNotApplicable PhaseRotation = "NotApplicable"
+
+	// PhaseRotationUnknown means the phase rotation is not (yet) known.
+	PhaseRotationUnknown PhaseRotation = "Unknown"
+
+	// PhaseRotationRST is the standard reference phasing.
+	PhaseRotationRST PhaseRotation = "RST"
+
+	// PhaseRotationRTS is a reversed reference phasing.
+	PhaseRotationRTS PhaseRotation = "RTS"
+
+	// PhaseRotationSRT is a reversed 240 degree rotation.
+	PhaseRotationSRT PhaseRotation = "SRT"
+
+	// PhaseRotationSTR is the standard 120 degree rotation.
+	PhaseRotationSTR PhaseRotation = "STR"
+
+	// PhaseRotationTRS is the standard 240 degree rotation.
+	PhaseRotationTRS PhaseRotation = "TRS"
+
+	// PhaseRotationTSR is a reversed 120 degree rotation.
+	PhaseRotationTSR PhaseRotation = "TSR"
+)
+
+// IsValid returns true if the PhaseRotation is one of the values defined by OCPP
+// 1.6J.
+func (p PhaseRotation) IsValid() bool {
+	switch p {
+	case PhaseRotationNotApplicable, PhaseRotationUnknown, PhaseRotationRST, PhaseRotationRTS,
+		PhaseRotationSRT, PhaseRotationSTR, PhaseRotationTRS, PhaseRotationTSR:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the PhaseRotation.
+func (p PhaseRotation) String() string {
+	return string(p)
+}
+
+// ConnectorPhaseRotation is one entry of the ConnectorPhaseRotation key, written
+// "<connectorId>.<rotation>", for example "1.RST". Connector 0 describes the
+// rotation between the grid connection and the main energy meter.
+type ConnectorPhaseRotation struct {
+	// ConnectorId is the connector the rotation applies to. It is not negative.
+	ConnectorId int
+
+	// Rotation is the phase rotation of the connector.
+	Rotation PhaseRotation
+}
+
+// String returns the "<connectorId>.<rotation>" form of the ConnectorPhaseRotation.
+func (c ConnectorPhaseRotation) String() string {
+	return strconv.Itoa(c.ConnectorId) + "." + c.Rotation.String()
+}
+
+// parseConnectorPhaseRotation converts a "<connectorId>.<rotation>" entry into a
+// ConnectorPhaseRotation, rejecting malformed entries, negative connector ids and
+// unknown rotations.
+func parseConnectorPhaseRotation(value string) (ConnectorPhaseRotation, error) {
+	connector, rotation, found := strings.Cut(value, ".")
+
+	connectorId, err := strconv.Atoi(connector)
+	if !found || err != nil || connectorId < 0 || !PhaseRotation(rotation).IsValid() {
+		return ConnectorPhaseRotation{}, types.EnumError("", value, ErrInvalidPhaseRotation)
+	}
+
+	return ConnectorPhaseRotation{ConnectorId: connectorId, Rotation: PhaseRotation(rotation)}, nil
+}
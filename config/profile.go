@@ -0,0 +1,64 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidProfile indicates that a Profile is not one of the feature profiles
+// defined by OCPP 1.6J.
+var ErrInvalidProfile = errors.New("invalid feature profile")
+
+// Profile is an OCPP 1.6J feature profile, a group of functionality that a Charge
+// Point may implement. Every configuration key belongs to one profile.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 3.1: Feature Profiles
+type Profile string
+
+const (
+	// ProfileCore is the basic functionality every Charge Point implements.
+	ProfileCore Profile = "Core"
+
+	// ProfileFirmwareManagement covers firmware updates and diagnostics.
+	ProfileFirmwareManagement Profile = "FirmwareManagement"
+
+	// ProfileLocalAuthListManagement covers the Local Authorization List.
+	ProfileLocalAuthListManagement Profile = "LocalAuthListManagement"
+
+	// ProfileReservation covers connector reservations.
+	ProfileReservation Profile = "Reservation"
+
+	// ProfileSmartCharging covers charging profiles and schedules.
+	ProfileSmartCharging Profile = "SmartCharging"
+
+	// ProfileRemoteTrigger covers messages triggered by the Central System.
+	ProfileRemoteTrigger Profile = "RemoteTrigger"
+)
+
+// IsValid returns true if the Profile is one of the feature profiles defined by
+// OCPP 1.6J.
+func (p Profile) IsValid() bool {
+	switch p {
+	case ProfileCore, ProfileFirmwareManagement, ProfileLocalAuthListManagement, ProfileReservation,
+		ProfileSmartCharging, ProfileRemoteTrigger:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the name of the Profile, as listed in SupportedFeatureProfiles.
+func (p Profile) String() string {
+	return string(p)
+}
+
+// parseProfile converts a profile name into a Profile, rejecting unknown values.
+func parseProfile(value string) (Profile, error) {
+	if !Profile(value).IsValid() {
+		return "", types.EnumError("", value, ErrInvalidProfile)
+	}
+
+	return Profile(value), nil
+}
@@ -0,0 +1,243 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// Constraint names reported in types.ValidationError.Constraint for configuration
+// values, in addition to those of the types package.
+const (
+	// ConstraintBoolean is reported when the value of a boolean key is not "true"
+	// or "false".
+	ConstraintBoolean = "boolean"
+
+	// ConstraintInteger is reported when the value of an integer key is not a
+	// decimal integer.
+	ConstraintInteger = "integer"
+)
+
+// ErrInvalidValue indicates that a configuration value does not match the
+// definition of its key.
+var ErrInvalidValue = errors.New("invalid configuration value")
+
+// maxPercent is the largest value of a percentage.
+const maxPercent = 100
+
+// Value is the value of a standard configuration key, checked against the
+// definition of the key. The zero Value is not valid; use Parse or
+// Definition.Parse to obtain one.
+//
+// The accessors return the value in the Go type that matches the ValueType of the
+// key, and the zero value of that type for keys of another type.
+type Value struct {
+	definition Definition
+	boolean    bool
+	integer    int
+	list       []string
+}
+
+// Parse checks value against the definition of the standard configuration key
+// named key, ignoring the case of key.
+//
+// It returns an error wrapping ErrUnknownKey if key is not a standard key, or the
+// errors of Definition.Parse.
+//
+// Example usage:
+//
+//	value, err := config.Parse("HeartbeatInterval", "300")
+//	if err != nil {
+//	    log.Fatalf("invalid value: %v", err)
+//	}
+//	interval := time.Duration(value.Int()) * time.Second
+func Parse(key, value string) (Value, error) {
+	definition, err := Lookup(key)
+	if err != nil {
+		return Value{}, err
+	}
+
+	return definition.Parse(value)
+}
+
+// Parse checks value against the Definition and returns the parsed Value.
+//
+// Booleans are "true" or "false" in any case. Integers are non-negative decimal
+// numbers, and percentages are at most 100. Lists are comma-separated, may be empty
+// and may contain spaces around their entries, and every entry must be one of the
+// allowed values.
+//
+// Failures are reported as *types.ValidationError values at path "value" wrapping
+// ErrInvalidValue; every invalid list entry is reported.
+func (d Definition) Parse(value string) (Value, error) {
+	parsed := Value{definition: d, boolean: false, integer: 0, list: nil}
+
+	var err error
+
+	switch d.Type {
+	case ValueTypeBoolean:
+		parsed.boolean, err = parseBoolean(value)
+	case ValueTypeInteger:
+		parsed.integer, err = parseInteger(value, d.Unit)
+	case ValueTypeMeasurandList:
+		parsed.list, err = parseList(value, types.ParseMeasurand)
+	case ValueTypePhaseRotationList:
+		parsed.list, err = parseList(value, parseConnectorPhaseRotation)
+	case ValueTypeProfileList:
+		parsed.list, err = parseList(value, parseProfile)
+	case ValueTypeChargingRateUnitList:
+		parsed.list, err = parseList(value, parseChargingRateUnit)
+	default:
+		err = fmt.Errorf("%w: unsupported value type %q of %s", ErrInvalidValue, d.Type, d.Key)
+	}
+
+	if err != nil {
+		return Value{}, err
+	}
+
+	return parsed, nil
+}
+
+// parseBoolean parses the value of a boolean key.
+func parseBoolean(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, &types.ValidationError{
+			Path:       "value",
+			Constraint: ConstraintBoolean,
+			Value:      value,
+			Err:        fmt.Errorf("%w: %q is not a boolean", ErrInvalidValue, value),
+		}
+	}
+}
+
+// parseInteger parses the value of an integer key with the given unit. A leading
+// plus sign, which strconv.Atoi accepts, is not part of a decimal integer.
+func parseInteger(value string, unit Unit) (int, error) {
+	integer, err := strconv.Atoi(value)
+
+	switch {
+	case err != nil || strings.HasPrefix(value, "+"):
+		return 0, &types.ValidationError{
+			Path:       "value",
+			Constraint: ConstraintInteger,
+			Value:      value,
+			Err:        fmt.Errorf("%w: %q is not an integer", ErrInvalidValue, value),
+		}
+	case integer < 0:
+		return 0, types.MinimumError("value", integer, 0, ErrInvalidValue)
+	case unit == UnitPercent && integer > maxPercent:
+		return 0, types.MaximumError("value", integer, maxPercent, ErrInvalidValue)
+	default:
+		return integer, nil
+	}
+}
+
+// parseList splits a comma-separated list and checks every entry with parse. It
+// returns the trimmed entries, or nil for an empty list.
+func parseList[T any](value string, parse func(string) (T, error)) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	entries := strings.Split(value, ",")
+	errs := make([]error, 0, len(entries))
+
+	for i, entry := range entries {
+		entries[i] = strings.TrimSpace(entry)
+		if _, err := parse(entries[i]); err != nil {
+			errs = append(errs, types.EnumError("value", entries[i], ErrInvalidValue))
+		}
+	}
+
+	if err := types.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Definition returns the definition of the key the Value belongs to.
+func (v Value) Definition() Definition {
+	return v.definition
+}
+
+// String returns the canonical form of the Value, as sent in a
+// ChangeConfiguration.req: booleans in lower case, integers without leading zeros
+// and lists without spaces.
+func (v Value) String() string {
+	switch v.definition.Type {
+	case ValueTypeBoolean:
+		return strconv.FormatBool(v.boolean)
+	case ValueTypeInteger:
+		return strconv.Itoa(v.integer)
+	default:
+		return strings.Join(v.list, ",")
+	}
+}
+
+// Bool returns the value of a boolean key.
+func (v Value) Bool() bool {
+	return v.boolean
+}
+
+// Int returns the value of an integer key, in the Unit of its definition.
+func (v Value) Int() int {
+	return v.integer
+}
+
+// Measurands returns the entries of a measurand list, such as
+// MeterValuesSampledData.
+func (v Value) Measurands() []types.Measurand {
+	return listOf[types.Measurand](v, ValueTypeMeasurandList)
+}
+
+// Profiles returns the entries of a feature profile list, such as
+// SupportedFeatureProfiles.
+func (v Value) Profiles() []Profile {
+	return listOf[Profile](v, ValueTypeProfileList)
+}
+
+// ChargingRateUnits returns the entries of ChargingScheduleAllowedChargingRateUnit.
+func (v Value) ChargingRateUnits() []ChargingRateUnit {
+	return listOf[ChargingRateUnit](v, ValueTypeChargingRateUnitList)
+}
+
+// PhaseRotations returns the entries of ConnectorPhaseRotation.
+func (v Value) PhaseRotations() []ConnectorPhaseRotation {
+	if v.definition.Type != ValueTypePhaseRotationList || v.list == nil {
+		return nil
+	}
+
+	rotations := make([]ConnectorPhaseRotation, 0, len(v.list))
+
+	for _, entry := range v.list {
+		// Entries were checked by Parse.
+		rotation, _ := parseConnectorPhaseRotation(entry)
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations
+}
+
+// listOf returns the entries of a list Value of the given type as T, or nil if the
+// Value has another type.
+func listOf[T ~string](v Value, valueType ValueType) []T {
+	if v.definition.Type != valueType || v.list == nil {
+		return nil
+	}
+
+	entries := make([]T, 0, len(v.list))
+	for _, entry := range v.list {
+		entries = append(entries, T(entry))
+	}
+
+	return entries
+}
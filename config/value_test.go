@@ -0,0 +1,114 @@
+package config
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestParseValid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		key, value, canonical string
+	}{
+		{"LocalAuthListEnabled", "TRUE", "true"},
+		{"StopTransactionOnInvalidId", "false", "false"},
+		{"HeartbeatInterval", "0300", "300"},
+		{"LightIntensity", "100", "100"},
+		{"MeterValuesSampledData", "Energy.Active.Import.Register, Current.Import", "Energy.Active.Import.Register,Current.Import"},
+		{"MeterValuesAlignedData", "", ""},
+		{"ConnectorPhaseRotation", "0.RST, 1.NotApplicable", "0.RST,1.NotApplicable"},
+		{"SupportedFeatureProfiles", "Core,SmartCharging", "Core,SmartCharging"},
+		{"ChargingScheduleAllowedChargingRateUnit", "Current,Power", "Current,Power"},
+	}
+
+	for _, tc := range tests {
+		value, err := Parse(tc.key, tc.value)
+		if err != nil {
+			t.Errorf("%s=%q: unexpected error: %v", tc.key, tc.value, err)
+
+			continue
+		}
+
+		if value.String() != tc.canonical {
+			t.Errorf("%s=%q: want canonical %q, got %q", tc.key, tc.value, tc.canonical, value.String())
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		key, value, constraint string
+	}{
+		{"LocalAuthListEnabled", "yes", ConstraintBoolean},
+		{"HeartbeatInterval", "5m", ConstraintInteger},
+		{"HeartbeatInterval", "+300", ConstraintInteger},
+		{"HeartbeatInterval", "-1", "minimum=0"},
+		{"LightIntensity", "101", "maximum=100"},
+		{"MeterValuesSampledData", "Energy.Active.Import.Register,Voltage.L1", types.ConstraintEnum},
+		{"ConnectorPhaseRotation", "1.RST,2-RTS", types.ConstraintEnum},
+		{"ConnectorPhaseRotation", "-1.RST", types.ConstraintEnum},
+		{"ConnectorPhaseRotation", "1.RSX", types.ConstraintEnum},
+		{"SupportedFeatureProfiles", "Core,Smart", types.ConstraintEnum},
+		{"ChargingScheduleAllowedChargingRateUnit", "A", types.ConstraintEnum},
+	}
+
+	for _, tc := range tests {
+		_, err := Parse(tc.key, tc.value)
+
+		var verr *types.ValidationError
+		if !errors.Is(err, ErrInvalidValue) || !errors.As(err, &verr) || verr.Path != "value" || verr.Constraint != tc.constraint {
+			t.Errorf("%s=%q: expected %s failure at value, got %v", tc.key, tc.value, tc.constraint, err)
+		}
+	}
+
+	if _, err := Parse("VendorSpecificKey", "1"); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("expected ErrUnknownKey, got %v", err)
+	}
+
+	definition := Definition{Key: "Custom", Profile: ProfileCore, Accessibility: AccessibilityReadWrite, Type: "float", Unit: UnitNone, Required: false}
+	if _, err := definition.Parse("1.5"); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("expected ErrInvalidValue for an unsupported type, got %v", err)
+	}
+}
+
+func TestValueAccessors(t *testing.T) {
+	t.Parallel()
+
+	enabled, _ := Parse("LocalAuthListEnabled", "true")
+	interval, _ := Parse("HeartbeatInterval", "300")
+	measurands, _ := Parse("MeterValuesSampledData", "Energy.Active.Import.Register,Power.Active.Import")
+	rotations, _ := Parse("ConnectorPhaseRotation", "0.RST,1.TSR")
+	profiles, _ := Parse("SupportedFeatureProfiles", "Core,Reservation")
+	units, _ := Parse("ChargingScheduleAllowedChargingRateUnit", "Power")
+
+	if !enabled.Bool() || interval.Int() != 300 || interval.Definition().Key != KeyHeartbeatInterval {
+		t.Errorf("unexpected scalar values: %v, %v", enabled, interval)
+	}
+
+	if got := measurands.Measurands(); !slices.Equal(got, []types.Measurand{types.MeasurandEnergyActiveImportRegister, types.MeasurandPowerActiveImport}) {
+		t.Errorf("unexpected measurands: %v", got)
+	}
+
+	want := []ConnectorPhaseRotation{{ConnectorId: 0, Rotation: PhaseRotationRST}, {ConnectorId: 1, Rotation: PhaseRotationTSR}}
+	if got := rotations.PhaseRotations(); !slices.Equal(got, want) {
+		t.Errorf("unexpected phase rotations: %v", got)
+	}
+
+	if got := profiles.Profiles(); !slices.Equal(got, []Profile{ProfileCore, ProfileReservation}) {
+		t.Errorf("unexpected profiles: %v", got)
+	}
+
+	if got := units.ChargingRateUnits(); !slices.Equal(got, []ChargingRateUnit{ChargingRateUnitPower}) {
+		t.Errorf("unexpected charging rate units: %v", got)
+	}
+
+	if interval.Measurands() != nil || interval.PhaseRotations() != nil || measurands.Profiles() != nil || enabled.Int() != 0 {
+		t.Error("expected accessors of another value type to return zero values")
+	}
+}
@@ -0,0 +1,109 @@
+// Package enumtest checks the string enumerations of the message packages, so
+// that every enumeration is tested alike from one table entry.
+package enumtest
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// Enumeration is implemented by the string enumerations of the message packages.
+type Enumeration interface {
+	types.Enumeration
+	String() string
+	MarshalText() ([]byte, error)
+}
+
+// Case describes an enumeration under test.
+type Case[T Enumeration] struct {
+	// Values are all the values OCPP 1.6J defines for the enumeration.
+	Values []T
+
+	// Invalid are wire values the enumeration must reject.
+	Invalid []string
+
+	// Sentinel is the error invalid values are rejected with.
+	Sentinel error
+
+	// Parse is the ParseX function of the enumeration.
+	Parse func(string) (T, error)
+}
+
+// Run checks that every value of c is valid and keeps its wire value through
+// Parse, text and JSON encoding, and that every invalid value is rejected with
+// the sentinel by IsValid, Parse, MarshalJSON and UnmarshalJSON.
+func Run[T Enumeration](t *testing.T, c Case[T]) {
+	t.Helper()
+
+	for _, value := range c.Values {
+		checkValid(t, c, value)
+	}
+
+	for _, value := range c.Invalid {
+		checkInvalid(t, c, value)
+	}
+}
+
+// checkValid checks a value defined by OCPP 1.6J.
+func checkValid[T Enumeration](t *testing.T, c Case[T], value T) {
+	t.Helper()
+
+	if !value.IsValid() || value.String() != string(value) {
+		t.Errorf("%T: expected %q to be valid and its own String()", value, value)
+	}
+
+	if parsed, err := c.Parse(string(value)); err != nil || parsed != value {
+		t.Errorf("%T: unexpected result parsing %q: %q, %v", value, value, parsed, err)
+	}
+
+	var decoded T
+
+	text, err := value.MarshalText()
+	if unmarshaler, ok := any(&decoded).(encoding.TextUnmarshaler); !ok || err != nil ||
+		unmarshaler.UnmarshalText(text) != nil || decoded != value {
+		t.Errorf("%T: unexpected text round trip of %q: %q, %v", value, value, decoded, err)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil || string(data) != strconv.Quote(string(value)) {
+		t.Errorf("%T: unexpected JSON encoding of %q: %s, %v", value, value, data, err)
+	}
+
+	decoded = ""
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != value {
+		t.Errorf("%T: unexpected JSON decoding of %s: %q, %v", value, data, decoded, err)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &decoded); err != nil || decoded != value {
+		t.Errorf("%T: expected null to leave %q unchanged, got %q, %v", value, value, decoded, err)
+	}
+}
+
+// checkInvalid checks a wire value the enumeration must reject.
+func checkInvalid[T Enumeration](t *testing.T, c Case[T], value string) {
+	t.Helper()
+
+	if T(value).IsValid() {
+		t.Errorf("%T: expected %q to be invalid", T(value), value)
+	}
+
+	var verr *types.ValidationError
+	if _, err := c.Parse(value); !errors.Is(err, c.Sentinel) || !errors.As(err, &verr) ||
+		verr.Constraint != types.ConstraintEnum {
+		t.Errorf("%T: expected enum error parsing %q, got %v", T(value), value, err)
+	}
+
+	if _, err := json.Marshal(T(value)); !errors.Is(err, c.Sentinel) {
+		t.Errorf("%T: expected %v encoding %q, got %v", T(value), c.Sentinel, value, err)
+	}
+
+	var decoded T
+	if err := json.Unmarshal([]byte(strconv.Quote(value)), &decoded); !errors.Is(err, c.Sentinel) {
+		t.Errorf("%T: expected %v decoding %q, got %v", T(value), c.Sentinel, value, err)
+	}
+}
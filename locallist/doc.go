@@ -0,0 +1,34 @@
+// Package locallist keeps the Local Authorization List of a Charge Point.
+//
+// The Local Authorization List holds the idTags a Charge Point can authorize
+// without contacting the Central System, each with its IdTagInfo. The Central
+// System maintains it with SendLocalList.req messages:
+//
+//   - A Full update replaces the list with the entries of the request, whatever
+//     the version of the current list. It is how a Central System recovers from a
+//     version mismatch.
+//   - A Differential update adds or replaces the entries with idTagInfo and
+//     removes those without. It only applies to a list with a lower version, so an
+//     update that is stale or was already applied is answered with
+//     VersionMismatch.
+//
+// An update that cannot be applied, because it names an idTag twice, would exceed
+// the maximum length of the list, or cannot be persisted, is answered with Failed
+// and leaves the list unchanged. An empty list has version 0, as reported in a
+// GetLocalListVersion.conf, but a Differential update must still have a higher
+// version than the last applied update.
+//
+// List applies the updates in memory and hands every new version of the list to a
+// Persistence, so that it survives a restart. Charge Point firmware answers
+// SendLocalList.req and authorizes idTags with it, and a Central System can mirror
+// the list of each Charge Point by applying the updates the Charge Point accepted.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 3.4: Local Authorization List
+//   - OCPP 1.6J, Section 5.10: Get Local List Version
+//   - OCPP 1.6J, Section 5.15: Send Local List
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/locallist"
+package locallist
@@ -0,0 +1,37 @@
+package locallist_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/locallist"
+	"github.com/aasanchez/ocpp16messages/messages/sendlocallist"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleList_Apply() {
+	list, err := locallist.New(nil, 0)
+	if err != nil {
+		log.Fatalf("failed to create list: %v", err)
+	}
+
+	entry, _ := types.AuthorizationData("ABC123")
+	info, _ := types.IdTagInfo(types.Accepted)
+	entry.IdTagInfo = &info
+
+	full, _ := sendlocallist.Request(1, sendlocallist.UpdateTypeFull, entry)
+	status, _ := list.Apply(full)
+	fmt.Println(status, list.Version())
+
+	stale, _ := sendlocallist.Request(1, sendlocallist.UpdateTypeDifferential, entry)
+	status, err = list.Apply(stale)
+	fmt.Println(status, err)
+
+	idTag, _ := types.IdToken("abc123")
+	found, ok := list.Lookup(idTag)
+	fmt.Println(found.Status, ok)
+	// Output:
+	// Accepted 1
+	// VersionMismatch listVersion is not newer than the current list: got 1, last applied 1
+	// Accepted true
+}
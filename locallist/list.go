@@ -0,0 +1,237 @@
+package locallist
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aasanchez/ocpp16messages/messages/sendlocallist"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+var (
+	// ErrVersionMismatch indicates that a Differential update does not have a
+	// higher version than the last applied update.
+	ErrVersionMismatch = errors.New("listVersion is not newer than the current list")
+
+	// ErrDuplicateIdTag indicates that an update names the same idTag more than
+	// once.
+	ErrDuplicateIdTag = errors.New("duplicate idTag")
+
+	// ErrListFull indicates that an update would make the list longer than its
+	// maximum length.
+	ErrListFull = errors.New("local authorization list is full")
+
+	// ErrInvalidSnapshot indicates that a Persistence loaded a list that is not
+	// valid.
+	ErrInvalidSnapshot = errors.New("invalid local authorization list snapshot")
+)
+
+// List is a Local Authorization List. It is safe for concurrent use.
+type List struct {
+	mu          sync.RWMutex
+	persistence Persistence
+	maxLength   int
+	lastVersion int // version of the last applied update, kept when it emptied the list
+	entries     map[string]types.AuthorizationDataType
+}
+
+// New returns the List stored by persistence, or an empty List when persistence is
+// nil.
+//
+// maxLength is the maximum number of entries of the list, as reported by the
+// LocalAuthListMaxLength configuration key, and 0 leaves it unlimited. It returns
+// an error if the stored list cannot be loaded or is not valid.
+func New(persistence Persistence, maxLength int) (*List, error) {
+	list := &List{
+		mu:          sync.RWMutex{},
+		persistence: persistence,
+		maxLength:   maxLength,
+		lastVersion: 0,
+		entries:     make(map[string]types.AuthorizationDataType),
+	}
+
+	if persistence == nil {
+		return list, nil
+	}
+
+	stored, err := persistence.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load local authorization list: %w", err)
+	}
+
+	entries, err := storedEntries(stored)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+
+	list.lastVersion = stored.ListVersion
+	list.entries = entries
+
+	return list, nil
+}
+
+// storedEntries returns the entries of a stored list, which must be a valid Full
+// update, or empty with version 0 when no update was applied yet.
+func storedEntries(stored Snapshot) (map[string]types.AuthorizationDataType, error) {
+	if stored.ListVersion == 0 && len(stored.LocalAuthorizationList) == 0 {
+		return make(map[string]types.AuthorizationDataType), nil
+	}
+
+	req := sendlocallist.RequestMessage{
+		ListVersion:            stored.ListVersion,
+		LocalAuthorizationList: stored.LocalAuthorizationList,
+		UpdateType:             sendlocallist.UpdateTypeFull,
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]types.AuthorizationDataType, len(stored.LocalAuthorizationList))
+
+	return entries, merge(entries, stored.LocalAuthorizationList)
+}
+
+// Version returns the version of the list as reported in a
+// GetLocalListVersion.conf: that of the last applied update, or 0 when the list is
+// empty.
+func (l *List) Version() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if len(l.entries) == 0 {
+		return 0
+	}
+
+	return l.lastVersion
+}
+
+// Len returns the number of entries of the list.
+func (l *List) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return len(l.entries)
+}
+
+// Lookup returns the IdTagInfo of idTag, and false when the list has no entry for
+// it. IdTags are compared case-insensitively, as every CiString.
+//
+// The IdTagInfo is returned as sent by the Central System: the caller decides how
+// to treat an expired entry.
+func (l *List) Lookup(idTag types.IdTokenType) (types.IdTagInfoType, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	entry, ok := l.entries[key(idTag)]
+	if !ok {
+		return types.IdTagInfoType{}, false
+	}
+
+	return entry.IdTagInfo.Clone(), true
+}
+
+// Snapshot returns the entries of the list with the version of the last applied
+// update, as handed to the Persistence.
+func (l *List) Snapshot() Snapshot {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return snapshot(l.lastVersion, l.entries)
+}
+
+// Apply applies a SendLocalList.req to the list and returns the status to answer
+// it with.
+//
+// It returns Accepted and a nil error when the list was updated. Otherwise the list
+// is unchanged and the error tells why: ErrVersionMismatch with VersionMismatch,
+// and an invalid request, ErrDuplicateIdTag, ErrListFull or the error of the
+// Persistence with Failed.
+func (l *List) Apply(req sendlocallist.RequestMessage) (sendlocallist.UpdateStatus, error) {
+	if err := req.Validate(); err != nil {
+		return sendlocallist.UpdateStatusFailed, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries := make(map[string]types.AuthorizationDataType, len(l.entries)+len(req.LocalAuthorizationList))
+
+	if req.UpdateType == sendlocallist.UpdateTypeDifferential {
+		if req.ListVersion <= l.lastVersion {
+			return sendlocallist.UpdateStatusVersionMismatch,
+				fmt.Errorf("%w: got %d, last applied %d", ErrVersionMismatch, req.ListVersion, l.lastVersion)
+		}
+
+		maps.Copy(entries, l.entries)
+	}
+
+	if err := merge(entries, req.LocalAuthorizationList); err != nil {
+		return sendlocallist.UpdateStatusFailed, err
+	}
+
+	if l.maxLength > 0 && len(entries) > l.maxLength {
+		return sendlocallist.UpdateStatusFailed,
+			fmt.Errorf("%w: %d entries, at most %d allowed", ErrListFull, len(entries), l.maxLength)
+	}
+
+	if l.persistence != nil {
+		if err := l.persistence.Save(snapshot(req.ListVersion, entries)); err != nil {
+			return sendlocallist.UpdateStatusFailed, fmt.Errorf("failed to save local authorization list: %w", err)
+		}
+	}
+
+	l.lastVersion = req.ListVersion
+	l.entries = entries
+
+	return sendlocallist.UpdateStatusAccepted, nil
+}
+
+// merge adds the entries with IdTagInfo to list and removes those without. It
+// clones the IdTagInfo, so the caller cannot change the list through it or through
+// its ExpiryDate and ParentIdTag.
+//
+// It fails with ErrDuplicateIdTag if an idTag appears more than once.
+func merge(list map[string]types.AuthorizationDataType, entries []types.AuthorizationDataType) error {
+	seen := make(map[string]bool, len(entries))
+
+	for i, entry := range entries {
+		k := key(entry.IdTag)
+		if seen[k] {
+			return fmt.Errorf("%w: %s at localAuthorizationList[%d]", ErrDuplicateIdTag, entry.IdTag, i)
+		}
+
+		seen[k] = true
+
+		if entry.IdTagInfo == nil {
+			delete(list, k)
+		} else {
+			info := entry.IdTagInfo.Clone()
+			list[k] = types.AuthorizationDataType{IdTag: entry.IdTag, IdTagInfo: &info}
+		}
+	}
+
+	return nil
+}
+
+// key returns the map key of idTag. IdTags are case-insensitive, so it is the
+// upper case form.
+func key(idTag types.IdTokenType) string {
+	return strings.ToUpper(idTag.String())
+}
+
+// snapshot returns the Snapshot of a list, ordered by idTag.
+func snapshot(version int, entries map[string]types.AuthorizationDataType) Snapshot {
+	keys := slices.Sorted(maps.Keys(entries))
+	list := make([]types.AuthorizationDataType, 0, len(keys))
+
+	for _, k := range keys {
+		info := entries[k].IdTagInfo.Clone()
+		list = append(list, types.AuthorizationDataType{IdTag: entries[k].IdTag, IdTagInfo: &info})
+	}
+
+	return Snapshot{ListVersion: version, LocalAuthorizationList: list}
+}
@@ -0,0 +1,323 @@
+package locallist
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/messages/sendlocallist"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+var errDiskFull = errors.New("disk full")
+
+// memory is a Persistence keeping the last saved Snapshot as JSON.
+type memory struct {
+	mu    sync.Mutex
+	data  []byte
+	saves int
+	fail  bool
+}
+
+func (m *memory) Load() (Snapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var stored Snapshot
+	if m.data == nil {
+		return stored, nil
+	}
+
+	err := json.Unmarshal(m.data, &stored)
+
+	return stored, err
+}
+
+func (m *memory) Save(stored Snapshot) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.fail {
+		return errDiskFull
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	m.data = data
+	m.saves++
+
+	return nil
+}
+
+// entries returns AuthorizationData entries for the given idTags. An idTag
+// prefixed with "-" has no IdTagInfo, the others are Accepted.
+func entries(t *testing.T, idTags ...string) []types.AuthorizationDataType {
+	t.Helper()
+
+	list := make([]types.AuthorizationDataType, 0, len(idTags))
+
+	for _, idTag := range idTags {
+		entry, err := types.AuthorizationData(strings.TrimPrefix(idTag, "-"))
+		if err != nil {
+			t.Fatalf("unexpected error creating entry: %v", err)
+		}
+
+		if !strings.HasPrefix(idTag, "-") {
+			info, _ := types.IdTagInfo(types.Accepted)
+			entry.IdTagInfo = &info
+		}
+
+		list = append(list, entry)
+	}
+
+	return list
+}
+
+// update returns a SendLocalList.req, without validating it.
+func update(t *testing.T, listVersion int, updateType sendlocallist.UpdateType, idTags ...string) sendlocallist.RequestMessage {
+	t.Helper()
+
+	return sendlocallist.RequestMessage{
+		ListVersion:            listVersion,
+		LocalAuthorizationList: entries(t, idTags...),
+		UpdateType:             updateType,
+	}
+}
+
+// contents returns the idTags and reported version of the list as "A,B@version".
+func contents(list *List) string {
+	s := list.Snapshot()
+	idTags := make([]string, 0, len(s.LocalAuthorizationList))
+
+	for _, entry := range s.LocalAuthorizationList {
+		idTags = append(idTags, entry.IdTag.String())
+	}
+
+	return strings.Join(idTags, ",") + "@" + strconv.Itoa(list.Version())
+}
+
+func token(t *testing.T, idTag string) types.IdTokenType {
+	t.Helper()
+
+	tok, err := types.IdToken(idTag)
+	if err != nil {
+		t.Fatalf("unexpected error creating idTag: %v", err)
+	}
+
+	return tok
+}
+
+func TestListFullAndDifferentialUpdates(t *testing.T) {
+	t.Parallel()
+
+	list, err := New(nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error creating list: %v", err)
+	}
+
+	if list.Version() != 0 || list.Len() != 0 {
+		t.Fatalf("expected an empty list with version 0, got %s", contents(list))
+	}
+
+	steps := []struct {
+		req    sendlocallist.RequestMessage
+		status sendlocallist.UpdateStatus
+		want   string
+	}{
+		{update(t, 1, sendlocallist.UpdateTypeFull, "B", "A"), sendlocallist.UpdateStatusAccepted, "A,B@1"},
+		{update(t, 2, sendlocallist.UpdateTypeDifferential, "C", "-A"), sendlocallist.UpdateStatusAccepted, "B,C@2"},
+		{update(t, 2, sendlocallist.UpdateTypeDifferential, "D"), sendlocallist.UpdateStatusVersionMismatch, "B,C@2"},
+		{update(t, 1, sendlocallist.UpdateTypeDifferential, "D"), sendlocallist.UpdateStatusVersionMismatch, "B,C@2"},
+		{update(t, 3, sendlocallist.UpdateTypeDifferential, "-Z"), sendlocallist.UpdateStatusAccepted, "B,C@3"},
+		{update(t, 1, sendlocallist.UpdateTypeFull, "D"), sendlocallist.UpdateStatusAccepted, "D@1"},
+		{update(t, 2, sendlocallist.UpdateTypeDifferential, "-D"), sendlocallist.UpdateStatusAccepted, "@0"},
+		{update(t, 1, sendlocallist.UpdateTypeDifferential, "E"), sendlocallist.UpdateStatusVersionMismatch, "@0"},
+		{update(t, 3, sendlocallist.UpdateTypeDifferential, "E"), sendlocallist.UpdateStatusAccepted, "E@3"},
+		{update(t, 4, sendlocallist.UpdateTypeFull), sendlocallist.UpdateStatusAccepted, "@0"},
+		{update(t, 4, sendlocallist.UpdateTypeDifferential, "F"), sendlocallist.UpdateStatusVersionMismatch, "@0"},
+	}
+
+	for i, step := range steps {
+		status, err := list.Apply(step.req)
+		if status != step.status || (err == nil) != (status == sendlocallist.UpdateStatusAccepted) {
+			t.Errorf("step %d: expected %s, got %s, %v", i, step.status, status, err)
+		}
+
+		if status == sendlocallist.UpdateStatusVersionMismatch && !errors.Is(err, ErrVersionMismatch) {
+			t.Errorf("step %d: expected ErrVersionMismatch, got %v", i, err)
+		}
+
+		if got := contents(list); got != step.want {
+			t.Errorf("step %d: expected list %s, got %s", i, step.want, got)
+		}
+	}
+}
+
+func TestListStaleDifferentialAfterDeletingAll(t *testing.T) {
+	t.Parallel()
+
+	store := &memory{mu: sync.Mutex{}, data: nil, saves: 0, fail: false}
+	list, _ := New(store, 0)
+
+	for _, req := range []sendlocallist.RequestMessage{
+		update(t, 5, sendlocallist.UpdateTypeFull, "ABC"),
+		update(t, 6, sendlocallist.UpdateTypeDifferential, "-ABC"),
+	} {
+		if _, err := list.Apply(req); err != nil {
+			t.Fatalf("unexpected error applying %s: %v", req, err)
+		}
+	}
+
+	if list.Version() != 0 || list.Snapshot().ListVersion != 6 {
+		t.Errorf("expected version 0 reported and 6 stored, got %d and %d", list.Version(), list.Snapshot().ListVersion)
+	}
+
+	restored, err := New(store, 0)
+	if err != nil {
+		t.Fatalf("unexpected error restoring list: %v", err)
+	}
+
+	stale := update(t, 3, sendlocallist.UpdateTypeDifferential, "DEF")
+
+	for _, l := range []*List{list, restored} {
+		if status, err := l.Apply(stale); status != sendlocallist.UpdateStatusVersionMismatch ||
+			!errors.Is(err, ErrVersionMismatch) {
+			t.Errorf("expected VersionMismatch for a stale update, got %s, %v", status, err)
+		}
+	}
+}
+
+func TestListFailedUpdates(t *testing.T) {
+	t.Parallel()
+
+	list, _ := New(nil, 2)
+	if status, err := list.Apply(update(t, 1, sendlocallist.UpdateTypeFull, "A")); err != nil {
+		t.Fatalf("unexpected %s: %v", status, err)
+	}
+
+	tests := []struct {
+		req sendlocallist.RequestMessage
+		err error
+	}{
+		{update(t, 2, sendlocallist.UpdateTypeDifferential, "B", "C"), ErrListFull},
+		{update(t, 2, sendlocallist.UpdateTypeFull, "B", "C", "D"), ErrListFull},
+		{update(t, 2, sendlocallist.UpdateTypeDifferential, "B", "-b"), ErrDuplicateIdTag},
+		{update(t, 2, sendlocallist.UpdateTypeFull, "-B"), sendlocallist.ErrMissingIdTagInfo},
+		{update(t, 0, sendlocallist.UpdateTypeFull, "B"), sendlocallist.ErrInvalidListVersion},
+	}
+
+	for _, tc := range tests {
+		status, err := list.Apply(tc.req)
+		if status != sendlocallist.UpdateStatusFailed || !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected Failed with %v, got %s, %v", tc.req, tc.err, status, err)
+		}
+
+		if got := contents(list); got != "A@1" {
+			t.Errorf("%s: expected the list to be unchanged, got %s", tc.req, got)
+		}
+	}
+}
+
+func TestListLookup(t *testing.T) {
+	t.Parallel()
+
+	list, _ := New(nil, 0)
+	req := update(t, 1, sendlocallist.UpdateTypeFull, "ABC123")
+	expiry := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	expiryDate, _ := types.DateTime(expiry)
+	blocked, _ := types.IdTagInfo(types.Blocked)
+	blocked.ExpiryDate = &expiryDate
+	req.LocalAuthorizationList[0].IdTagInfo = &blocked
+
+	if _, err := list.Apply(req); err != nil {
+		t.Fatalf("unexpected error applying update: %v", err)
+	}
+
+	blocked.Status = types.Accepted
+	*blocked.ExpiryDate, _ = types.DateTime(expiry.Add(time.Hour))
+
+	info, ok := list.Lookup(token(t, "abc123"))
+	if !ok || info.Status != types.Blocked || !info.ExpiryDate.Time().Equal(expiry) {
+		t.Errorf("expected a case-insensitive match with status Blocked, got %v, %v", info, ok)
+	}
+
+	*info.ExpiryDate, _ = types.DateTime(expiry.Add(time.Hour))
+
+	if info, _ := list.Lookup(token(t, "ABC123")); !info.ExpiryDate.Time().Equal(expiry) {
+		t.Errorf("expected the list to be unchanged through a looked up expiryDate, got %v", info)
+	}
+
+	if _, ok := list.Lookup(token(t, "DEF456")); ok {
+		t.Error("expected no entry for DEF456")
+	}
+}
+
+func TestListPersistence(t *testing.T) {
+	t.Parallel()
+
+	store := &memory{mu: sync.Mutex{}, data: nil, saves: 0, fail: false}
+
+	list, err := New(store, 0)
+	if err != nil {
+		t.Fatalf("unexpected error creating list: %v", err)
+	}
+
+	if _, err := list.Apply(update(t, 3, sendlocallist.UpdateTypeFull, "B", "A")); err != nil {
+		t.Fatalf("unexpected error applying update: %v", err)
+	}
+
+	want := `{"listVersion":3,"localAuthorizationList":[{"idTag":"A","idTagInfo":{"status":"Accepted"}},` +
+		`{"idTag":"B","idTagInfo":{"status":"Accepted"}}]}`
+	if string(store.data) != want {
+		t.Errorf("unexpected stored list:\nwant: %s\ngot : %s", want, store.data)
+	}
+
+	store.fail = true
+
+	status, err := list.Apply(update(t, 4, sendlocallist.UpdateTypeDifferential, "C"))
+	if status != sendlocallist.UpdateStatusFailed || !errors.Is(err, errDiskFull) {
+		t.Errorf("expected Failed with errDiskFull, got %s, %v", status, err)
+	}
+
+	if _, ok := list.Lookup(token(t, "C")); ok || list.Version() != 3 {
+		t.Errorf("expected the list to be unchanged, got %s", contents(list))
+	}
+
+	store.fail = false
+
+	restored, err := New(store, 0)
+	if err != nil {
+		t.Fatalf("unexpected error restoring list: %v", err)
+	}
+
+	if contents(restored) != "A,B@3" || store.saves != 1 {
+		t.Errorf("expected the stored list to be restored, got %s after %d saves", contents(restored), store.saves)
+	}
+}
+
+func TestListInvalidSnapshot(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{
+		`{"listVersion":0,"localAuthorizationList":[{"idTag":"A","idTagInfo":{"status":"Accepted"}}]}`,
+		`{"listVersion":2,"localAuthorizationList":[{"idTag":"A"}]}`,
+		`{"listVersion":2,"localAuthorizationList":[{"idTag":"A","idTagInfo":{"status":"Accepted"}},` +
+			`{"idTag":"a","idTagInfo":{"status":"Blocked"}}]}`,
+		`{"listVersion":-1}`,
+	} {
+		if _, err := New(&memory{mu: sync.Mutex{}, data: []byte(data), saves: 0, fail: false}, 0); !errors.Is(err, ErrInvalidSnapshot) {
+			t.Errorf("%s: expected ErrInvalidSnapshot, got %v", data, err)
+		}
+	}
+
+	if _, err := New(&memory{mu: sync.Mutex{}, data: []byte(`[]`), saves: 0, fail: false}, 0); err == nil {
+		t.Error("expected error for a list that cannot be loaded, got nil")
+	}
+}
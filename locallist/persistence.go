@@ -0,0 +1,33 @@
+package locallist
+
+import "github.com/aasanchez/ocpp16messages/types"
+
+// Snapshot is a version of the Local Authorization List, as handed to a
+// Persistence.
+//
+// It encodes to JSON with the field names of a SendLocalList.req, so a Persistence
+// can store it with json.Marshal.
+type Snapshot struct {
+	// ListVersion is the version of the last applied update, 0 when none was
+	// applied. It is kept when that update emptied the list, so that stale
+	// Differential updates are still rejected after a restart; List.Version reports
+	// 0 for an empty list.
+	ListVersion int `json:"listVersion"`
+
+	// LocalAuthorizationList holds the entries of the list ordered by idTag. Every
+	// entry has IdTagInfo.
+	LocalAuthorizationList []types.AuthorizationDataType `json:"localAuthorizationList"`
+}
+
+// Persistence stores the Local Authorization List across restarts.
+//
+// Implementations must be safe for concurrent use if they are shared between
+// lists.
+type Persistence interface {
+	// Load returns the stored list, or an empty Snapshot when nothing is stored yet.
+	Load() (Snapshot, error)
+
+	// Save stores a new version of the list. The update is rejected with Failed
+	// when it returns an error.
+	Save(snapshot Snapshot) error
+}
@@ -1,6 +1,7 @@
 package authorize
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/aasanchez/ocpp16messages/types"
@@ -31,7 +32,7 @@ type ConfirmationMessage struct {
 // This function ensures correctness before serializing or dispatching the message.
 func Confirmation(info types.IdTagInfoType) (ConfirmationMessage, error) {
 	if err := info.Validate(); err != nil {
-		return ConfirmationMessage{}, fmt.Errorf("invalid idTagInfo: %w", err)
+		return ConfirmationMessage{}, fmt.Errorf("invalid idTagInfo: %w", types.WithPath("idTagInfo", err))
 	}
 
 	return ConfirmationMessage{
@@ -45,15 +46,66 @@ func Confirmation(info types.IdTagInfoType) (ConfirmationMessage, error) {
 // and is typically used after decoding messages from a transport layer (e.g., JSON or SOAP).
 func (m ConfirmationMessage) Validate() error {
 	if err := m.IdTagInfo.Validate(); err != nil {
-		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", types.WithPath("idTagInfo", err))
 	}
 
 	return nil
 }
 
+// ValidateAll checks every field of the ConfirmationMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+//
+// Unlike Validate, it does not stop at the first failure, which makes it suitable
+// for compliance reports listing every problem of a message at once.
+func (m ConfirmationMessage) ValidateAll() error {
+	return types.WithPath("idTagInfo", m.IdTagInfo.ValidateAll())
+}
+
 // String returns a human-readable representation of the ConfirmationMessage.
 //
 // Useful for debugging, logging, or visual inspection during development and testing.
 func (m ConfirmationMessage) String() string {
 	return fmt.Sprintf("Authorize.conf{%s}", m.IdTagInfo.String())
 }
+
+// confirmationPayload is the OCPP 1.6J wire representation of Authorize.conf.
+type confirmationPayload struct {
+	IdTagInfo json.RawMessage `json:"idTagInfo"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J Authorize.conf payload.
+//
+// Optional fields (expiryDate, parentIdTag) are omitted when unset. The message is
+// validated first, so an invalid ConfirmationMessage is never encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	idTagInfo, err := json.Marshal(m.IdTagInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(confirmationPayload{IdTagInfo: idTagInfo})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J Authorize.conf payload into the ConfirmationMessage.
+//
+// The decoded values go through the same checks as Confirmation, so a successfully
+// decoded ConfirmationMessage is always valid.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	idTagInfo, err := types.DecodeRequired[types.IdTagInfoType]("idTagInfo", payload.IdTagInfo)
+	if err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	*m = ConfirmationMessage{IdTagInfo: idTagInfo}
+
+	return nil
+}
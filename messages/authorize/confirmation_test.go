@@ -1,7 +1,10 @@
 package authorize
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,7 +19,11 @@ func TestAuthorizeConfirmationValidInput(t *testing.T) {
 		t.Fatalf("unexpected error creating parentIdTag: %v", err)
 	}
 
-	expiry := time.Now().Add(24 * time.Hour).UTC()
+	expiry, err := types.DateTime(time.Now().Add(24 * time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error creating expiryDate: %v", err)
+	}
+
 	info := types.IdTagInfoType{
 		Status:      types.Accepted,
 		ExpiryDate:  &expiry,
@@ -73,7 +80,11 @@ func TestAuthorizeConfirmationString(t *testing.T) {
 		t.Fatalf("unexpected error creating parentIdTag: %v", err)
 	}
 
-	expiry := time.Now().UTC()
+	expiry, err := types.DateTime(time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error creating expiryDate: %v", err)
+	}
+
 	info := types.IdTagInfoType{
 		Status:      types.Accepted,
 		ExpiryDate:  &expiry,
@@ -91,3 +102,201 @@ func TestAuthorizeConfirmationString(t *testing.T) {
 		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, got)
 	}
 }
+
+func TestAuthorizeConfirmationMarshalJSONStatusOnly(t *testing.T) {
+	t.Parallel()
+
+	msg, err := Confirmation(types.IdTagInfoType{Status: types.Blocked, ExpiryDate: nil, ParentIdTag: nil})
+	if err != nil {
+		t.Fatalf("unexpected error constructing ConfirmationMessage: %v", err)
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling confirmation: %v", err)
+	}
+
+	want := `{"idTagInfo":{"status":"Blocked"}}`
+	if string(data) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, data)
+	}
+}
+
+func TestAuthorizeConfirmationMarshalJSONFullyPopulated(t *testing.T) {
+	t.Parallel()
+
+	parent, err := types.IdToken("GROUP123")
+	if err != nil {
+		t.Fatalf("unexpected error creating parentIdTag: %v", err)
+	}
+
+	expiry, _ := types.DateTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	msg := ConfirmationMessage{
+		IdTagInfo: types.IdTagInfoType{Status: types.Accepted, ExpiryDate: &expiry, ParentIdTag: &parent},
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling confirmation: %v", err)
+	}
+
+	want := `{"idTagInfo":{"status":"Accepted","expiryDate":"2025-01-02T03:04:05.000Z","parentIdTag":"GROUP123"}}`
+	if string(data) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, data)
+	}
+}
+
+func TestAuthorizeConfirmationMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	msg := ConfirmationMessage{
+		IdTagInfo: types.IdTagInfoType{Status: "Unknown", ExpiryDate: nil, ParentIdTag: nil},
+	}
+
+	if _, err := json.Marshal(msg); err == nil {
+		t.Error("expected error marshaling invalid ConfirmationMessage, got nil")
+	}
+}
+
+func TestAuthorizeConfirmationUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	data := `{"idTagInfo":{"status":"Accepted","expiryDate":"2025-01-02T03:04:05Z","parentIdTag":"GROUP123"}}`
+
+	var msg ConfirmationMessage
+	if err := json.Unmarshal([]byte(data), &msg); err != nil {
+		t.Fatalf("unexpected error unmarshaling confirmation: %v", err)
+	}
+
+	if msg.IdTagInfo.Status != types.Accepted {
+		t.Errorf("expected status %q, got %q", types.Accepted, msg.IdTagInfo.Status)
+	}
+
+	if msg.IdTagInfo.ExpiryDate == nil || !msg.IdTagInfo.ExpiryDate.Time().Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected expiryDate: %v", msg.IdTagInfo.ExpiryDate)
+	}
+
+	if msg.IdTagInfo.ParentIdTag == nil || msg.IdTagInfo.ParentIdTag.String() != "GROUP123" {
+		t.Errorf("unexpected parentIdTag: %v", msg.IdTagInfo.ParentIdTag)
+	}
+}
+
+func TestAuthorizeConfirmationUnmarshalJSONInvalidStatus(t *testing.T) {
+	t.Parallel()
+
+	var msg ConfirmationMessage
+
+	err := json.Unmarshal([]byte(`{"idTagInfo":{"status":"Maybe"}}`), &msg)
+	if !errors.Is(err, types.ErrInvalidAuthorizationStatus) {
+		t.Errorf("expected ErrInvalidAuthorizationStatus, got %v", err)
+	}
+}
+
+func TestAuthorizeConfirmationUnmarshalJSONMissingIdTagInfo(t *testing.T) {
+	t.Parallel()
+
+	var msg ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{}`), &msg); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"idTagInfo":{}}`), &msg); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField for missing status, got %v", err)
+	}
+}
+
+func TestAuthorizeConfirmationUnmarshalJSONInvalidParentIdTag(t *testing.T) {
+	t.Parallel()
+
+	var msg ConfirmationMessage
+
+	err := json.Unmarshal([]byte(`{"idTagInfo":{"status":"Accepted","parentIdTag":""}}`), &msg)
+	if !errors.Is(err, types.ErrInvalidParentIdTag) {
+		t.Errorf("expected ErrInvalidParentIdTag, got %v", err)
+	}
+}
+
+func TestAuthorizeConfirmationUnmarshalJSONMalformed(t *testing.T) {
+	t.Parallel()
+
+	var msg ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{"idTagInfo":[]}`), &msg); err == nil {
+		t.Error("expected error for malformed idTagInfo, got nil")
+	}
+
+	var verr *types.ValidationError
+
+	err := json.Unmarshal([]byte(`[]`), &msg)
+	if err == nil || errors.As(err, &verr) || !strings.HasPrefix(err.Error(), "ConfirmationMessage validation failed: ") {
+		t.Errorf("expected a decode error without field path, got %v", err)
+	}
+}
+
+func TestAuthorizeConfirmationValidationErrorPath(t *testing.T) {
+	t.Parallel()
+
+	var msg ConfirmationMessage
+
+	err := json.Unmarshal([]byte(`{"idTagInfo":{"status":"Accepted","parentIdTag":"ABC1234567890123456789"}}`), &msg)
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *types.ValidationError, got %v", err)
+	}
+
+	if verr.Path != "idTagInfo.parentIdTag" || verr.Constraint != "maxLength=20" {
+		t.Errorf("unexpected ValidationError: path=%s constraint=%s", verr.Path, verr.Constraint)
+	}
+
+	if verr.Value != "ABC1234567890123456789" {
+		t.Errorf("unexpected offending value: %v", verr.Value)
+	}
+
+	invalid := ConfirmationMessage{IdTagInfo: types.IdTagInfoType{Status: "Later", ExpiryDate: nil, ParentIdTag: nil}}
+	if !errors.As(invalid.Validate(), &verr) || verr.Path != "idTagInfo.status" || verr.Constraint != types.ConstraintEnum {
+		t.Errorf("unexpected ValidationError from Validate: %+v", verr)
+	}
+
+	if err := json.Unmarshal([]byte(`{"idTagInfo":{}}`), &msg); !errors.As(err, &verr) || verr.Path != "idTagInfo.status" {
+		t.Errorf("expected missing idTagInfo.status, got %v", err)
+	}
+}
+
+func TestAuthorizeConfirmationValidateAll(t *testing.T) {
+	t.Parallel()
+
+	zero := types.DateTimeType{}
+	parent, _ := types.IdToken("GROUP1")
+	msg := ConfirmationMessage{
+		IdTagInfo: types.IdTagInfoType{Status: "Unknown", ExpiryDate: &zero, ParentIdTag: &parent},
+	}
+
+	err := msg.ValidateAll()
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("expected joined error, got %T", err)
+	}
+
+	var paths []string
+
+	for _, e := range joined.Unwrap() {
+		var verr *types.ValidationError
+		if errors.As(e, &verr) {
+			paths = append(paths, verr.Path)
+		}
+	}
+
+	if fmt.Sprint(paths) != "[idTagInfo.status idTagInfo.expiryDate]" {
+		t.Errorf("unexpected failure paths: %v", paths)
+	}
+
+	if !errors.Is(err, types.ErrInvalidAuthorizationStatus) || !errors.Is(err, types.ErrInvalidExpiryDate) {
+		t.Errorf("expected both sentinels to match, got %v", err)
+	}
+
+	valid := ConfirmationMessage{IdTagInfo: types.IdTagInfoType{Status: types.Accepted, ExpiryDate: nil, ParentIdTag: nil}}
+	if err := valid.ValidateAll(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
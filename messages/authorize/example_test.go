@@ -6,21 +6,8 @@ import (
 	"log"
 
 	"github.com/aasanchez/ocpp16messages/messages/authorize"
-	"github.com/aasanchez/ocpp16messages/types"
 )
 
-type RequestPayload struct {
-	IdTag string `json:"idTag"`
-}
-
-type ConfirmationPayload struct {
-	IdTagInfo struct {
-		Status      types.AuthorizationStatus `json:"status"`
-		ExpiryDate  *string                   `json:"expiryDate,omitempty"`
-		ParentIdTag *string                   `json:"parentIdTag,omitempty"`
-	} `json:"idTagInfo"`
-}
-
 func ExampleRequest() {
 	idTagRaw := "ABC123456789"
 	messageID := "msg-001"
@@ -30,11 +17,7 @@ func ExampleRequest() {
 		log.Fatalf("failed to construct request: %v", err)
 	}
 
-	reqPayload := RequestPayload{
-		IdTag: reqMsg.IdTag.String(),
-	}
-
-	call := []any{2, messageID, "Authorize", reqPayload}
+	call := []any{2, messageID, "Authorize", reqMsg}
 	callJSON, err := json.MarshalIndent(call, "", "  ")
 
 	if err != nil {
@@ -54,3 +37,16 @@ func ExampleRequest() {
 	//   }
 	// ]
 }
+
+func ExampleConfirmationMessage_UnmarshalJSON() {
+	payload := `{"idTagInfo":{"status":"Accepted","parentIdTag":"GROUP01"}}`
+
+	var conf authorize.ConfirmationMessage
+	if err := json.Unmarshal([]byte(payload), &conf); err != nil {
+		log.Fatalf("failed to decode Authorize.conf: %v", err)
+	}
+
+	fmt.Println(conf.String())
+	// Output:
+	// Authorize.conf{{status=Accepted, parentIdTag=GROUP01}}
+}
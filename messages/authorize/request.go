@@ -1,6 +1,7 @@
 package authorize
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/aasanchez/ocpp16messages/types"
@@ -40,7 +41,7 @@ type RequestMessage struct {
 func Request(idTag string) (RequestMessage, error) {
 	tok, err := types.IdToken(idTag)
 	if err != nil {
-		return RequestMessage{}, fmt.Errorf("failed to create RequestMessage: %w", err)
+		return RequestMessage{}, fmt.Errorf("failed to create RequestMessage: %w", types.WithPath("idTag", err))
 	}
 
 	return RequestMessage{IdTag: tok}, nil
@@ -59,8 +60,55 @@ func (r RequestMessage) String() string {
 // message still complies with OCPP constraints before processing.
 func (r RequestMessage) Validate() error {
 	if err := r.IdTag.Validate(); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", types.WithPath("idTag", err))
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+//
+// Unlike Validate, it does not stop at the first failure, which makes it suitable
+// for compliance reports listing every problem of a message at once.
+func (r RequestMessage) ValidateAll() error {
+	return types.WithPath("idTag", r.IdTag.ValidateAll())
+}
+
+// requestPayload is the OCPP 1.6J wire representation of Authorize.req.
+type requestPayload struct {
+	IdTag *string `json:"idTag"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J Authorize.req payload.
+//
+// The message is validated first, so an invalid RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	idTag := r.IdTag.String()
+
+	return json.Marshal(requestPayload{IdTag: &idTag})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J Authorize.req payload into the RequestMessage.
+//
+// The decoded values go through the same checks as Request, so a successfully
+// decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
 		return fmt.Errorf("RequestMessage validation failed: %w", err)
 	}
 
+	idTag, err := types.Required("idTag", payload.IdTag, types.IdToken)
+	if err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{IdTag: idTag}
+
 	return nil
 }
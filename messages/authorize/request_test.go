@@ -1,6 +1,8 @@
 package authorize
 
 import (
+	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 
@@ -83,3 +85,118 @@ func TestAuthorizeRequestString(t *testing.T) {
 		t.Errorf("expected String() to include idTag, got: %s", output)
 	}
 }
+
+func TestAuthorizeRequestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request("TAG1234")
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	want := `{"idTag":"TAG1234"}`
+	if string(data) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, data)
+	}
+}
+
+func TestAuthorizeRequestMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := json.Marshal(RequestMessage{}); err == nil {
+		t.Error("expected error marshaling zero-value RequestMessage, got nil")
+	}
+}
+
+func TestAuthorizeRequestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"idTag":"TAG1234"}`), &req); err != nil {
+		t.Fatalf("unexpected error unmarshaling request: %v", err)
+	}
+
+	if req.IdTag.String() != "TAG1234" {
+		t.Errorf("expected idTag %q, got %q", "TAG1234", req.IdTag.String())
+	}
+}
+
+func TestAuthorizeRequestUnmarshalJSONMissingIdTag(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{}`), &req)
+	if !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"idTag":""}`), &req)
+	if !errors.Is(err, types.ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
+
+func TestAuthorizeRequestUnmarshalJSONTooLongIdTag(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	data := `{"idTag":"` + strings.Repeat("A", 21) + `"}`
+	if err := json.Unmarshal([]byte(data), &req); !errors.Is(err, types.ErrExceedsMaxLength) {
+		t.Errorf("expected ErrExceedsMaxLength, got %v", err)
+	}
+}
+
+func TestAuthorizeRequestUnmarshalJSONMalformed(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"idTag":42}`), &req); err == nil {
+		t.Error("expected error for non-string idTag, got nil")
+	}
+
+	var verr *types.ValidationError
+
+	err := json.Unmarshal([]byte(`[]`), &req)
+	if err == nil || errors.As(err, &verr) || !strings.HasPrefix(err.Error(), "RequestMessage validation failed: ") {
+		t.Errorf("expected a decode error without field path, got %v", err)
+	}
+}
+
+func TestAuthorizeRequestValidationErrorPath(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	var verr *types.ValidationError
+	if err := json.Unmarshal([]byte(`{"idTag":""}`), &req); !errors.As(err, &verr) {
+		t.Fatalf("expected *types.ValidationError, got %v", err)
+	}
+
+	if verr.Path != "idTag" || verr.Constraint != types.ConstraintNotEmpty {
+		t.Errorf("unexpected ValidationError: path=%s constraint=%s", verr.Path, verr.Constraint)
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &req); !errors.As(err, &verr) || verr.Constraint != types.ConstraintRequired {
+		t.Errorf("expected required constraint, got %v", err)
+	}
+}
+
+func TestAuthorizeRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request("ABC123")
+	if err := req.ValidateAll(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	if err := (RequestMessage{}).ValidateAll(); !errors.Is(err, types.ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
@@ -1 +1,150 @@
 package bootnotification
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidInterval indicates that the interval of a BootNotification.conf is negative.
+var ErrInvalidInterval = errors.New("invalid interval")
+
+// ConfirmationMessage represents the OCPP 1.6J BootNotification.conf message.
+//
+// This message is returned by the Central System in response to a
+// BootNotification.req, telling the Charge Point whether it is accepted and when
+// to send its next Heartbeat or BootNotification.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.4: BootNotification.conf
+type ConfirmationMessage struct {
+	// CurrentTime is the current time of the Central System.
+	CurrentTime types.DateTimeType
+
+	// Interval is the heartbeat interval in seconds when Status is Accepted, and the
+	// minimum wait time before the next BootNotification.req otherwise. It must not
+	// be negative.
+	Interval int
+
+	// Status tells whether the Charge Point has been registered.
+	Status RegistrationStatus
+}
+
+// Confirmation constructs a new ConfirmationMessage.
+//
+// It returns an error if the status is unknown, the interval is negative or the
+// current time is the zero time.
+func Confirmation(status RegistrationStatus, currentTime time.Time, interval int) (ConfirmationMessage, error) {
+	now, err := types.DateTime(currentTime)
+	if err != nil {
+		return ConfirmationMessage{}, fmt.Errorf("invalid currentTime: %w", types.WithPath("currentTime", err))
+	}
+
+	conf := ConfirmationMessage{CurrentTime: now, Interval: interval, Status: status}
+	if err := conf.Validate(); err != nil {
+		return ConfirmationMessage{}, err
+	}
+
+	return conf, nil
+}
+
+// Validate performs internal validation on the ConfirmationMessage and returns the
+// first failure.
+func (m ConfirmationMessage) Validate() error {
+	if err := types.FirstError(m.ValidateAll()); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the ConfirmationMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (m ConfirmationMessage) ValidateAll() error {
+	var errs []error
+
+	errs = append(errs, types.Field("currentTime", m.CurrentTime))
+
+	if m.Interval < 0 {
+		errs = append(errs, types.MinimumError("interval", m.Interval, 0, ErrInvalidInterval))
+	}
+
+	if !m.Status.IsValid() {
+		errs = append(errs, types.EnumError("status", m.Status, ErrInvalidRegistrationStatus))
+	}
+
+	return types.Join(errs...)
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+func (m ConfirmationMessage) String() string {
+	return "BootNotification.conf{currentTime=" + m.CurrentTime.String() +
+		", interval=" + strconv.Itoa(m.Interval) +
+		", status=" + string(m.Status) + "}"
+}
+
+// confirmationPayload is the OCPP 1.6J wire representation of BootNotification.conf.
+type confirmationPayload struct {
+	CurrentTime *string `json:"currentTime"`
+	Interval    *int    `json:"interval"`
+	Status      *string `json:"status"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J BootNotification.conf
+// payload. The message is validated first, so an invalid ConfirmationMessage is
+// never encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	currentTime := m.CurrentTime.String()
+	status := string(m.Status)
+
+	return json.Marshal(confirmationPayload{CurrentTime: &currentTime, Interval: &m.Interval, Status: &status})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J BootNotification.conf payload into the
+// ConfirmationMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded ConfirmationMessage is always valid.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	currentTime, err := types.Required("currentTime", payload.CurrentTime, types.ParseDateTime)
+	errs := []error{err}
+
+	if payload.Interval == nil {
+		errs = append(errs, types.MissingFieldError("interval"))
+	}
+
+	if payload.Status == nil {
+		errs = append(errs, types.MissingFieldError("status"))
+	}
+
+	if err := types.Join(errs...); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	conf := ConfirmationMessage{
+		CurrentTime: currentTime,
+		Interval:    *payload.Interval,
+		Status:      RegistrationStatus(*payload.Status),
+	}
+
+	if err := conf.Validate(); err != nil {
+		return err
+	}
+
+	*m = conf
+
+	return nil
+}
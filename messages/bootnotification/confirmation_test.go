@@ -1 +1,109 @@
 package bootnotification
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestBootNotificationConfirmationValid(t *testing.T) {
+	t.Parallel()
+
+	conf, err := Confirmation(RegistrationStatusAccepted, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), 300)
+	if err != nil {
+		t.Fatalf("unexpected error constructing ConfirmationMessage: %v", err)
+	}
+
+	if err := conf.Validate(); err != nil {
+		t.Errorf("expected message to be valid, got error: %v", err)
+	}
+
+	want := "BootNotification.conf{currentTime=2025-01-02T03:04:05.000Z, interval=300, status=Accepted}"
+	if conf.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, conf.String())
+	}
+}
+
+func TestBootNotificationConfirmationInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Confirmation("Maybe", time.Now(), 300); !errors.Is(err, ErrInvalidRegistrationStatus) {
+		t.Errorf("expected ErrInvalidRegistrationStatus, got %v", err)
+	}
+
+	if _, err := Confirmation(RegistrationStatusPending, time.Now(), -1); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("expected ErrInvalidInterval, got %v", err)
+	}
+
+	if _, err := Confirmation(RegistrationStatusRejected, time.Time{}, 60); !errors.Is(err, types.ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+}
+
+func TestBootNotificationConfirmationValidateAll(t *testing.T) {
+	t.Parallel()
+
+	conf := ConfirmationMessage{CurrentTime: types.DateTimeType{}, Interval: -5, Status: ""}
+
+	err := conf.ValidateAll()
+	for _, sentinel := range []error{types.ErrInvalidDateTime, ErrInvalidInterval, ErrInvalidRegistrationStatus} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	var verr *types.ValidationError
+	if !errors.As(conf.Validate(), &verr) || verr.Path != "currentTime" {
+		t.Errorf("expected first failure at currentTime, got %v", conf.Validate())
+	}
+}
+
+func TestBootNotificationConfirmationJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var conf ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{"currentTime":"2025-01-02T03:04:05Z","interval":300,"status":"Accepted"}`), &conf); err != nil {
+		t.Fatalf("unexpected error unmarshaling confirmation: %v", err)
+	}
+
+	out, err := json.Marshal(conf)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling confirmation: %v", err)
+	}
+
+	want := `{"currentTime":"2025-01-02T03:04:05.000Z","interval":300,"status":"Accepted"}`
+	if string(out) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, out)
+	}
+
+	if _, err := json.Marshal(ConfirmationMessage{}); err == nil {
+		t.Error("expected error marshaling zero-value ConfirmationMessage, got nil")
+	}
+}
+
+func TestBootNotificationConfirmationUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var conf ConfirmationMessage
+
+	if err := json.Unmarshal([]byte(`{}`), &conf); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	err := json.Unmarshal([]byte(`{"currentTime":"2025-01-02T03:04:05Z","interval":300,"status":"Registered"}`), &conf)
+	if !errors.Is(err, ErrInvalidRegistrationStatus) {
+		t.Errorf("expected ErrInvalidRegistrationStatus, got %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"currentTime":"later","interval":300,"status":"Accepted"}`), &conf)
+	if !errors.Is(err, types.ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"currentTime":"2025-01-02T03:04:05Z","interval":"300","status":"Accepted"}`), &conf); err == nil {
+		t.Error("expected error for non-integer interval, got nil")
+	}
+}
@@ -1,25 +1,30 @@
-// If the transaction is being stopped using a different identifier than the one
-// used to start it, the Charge Point must send a new Authorize.req request.
+// Package bootnotification models the OCPP 1.6J BootNotification message pair.
 //
-// The Authorize.req message is designed exclusively for verifying whether a
-// specific identifier is allowed to initiate charging.
+// After start-up, a Charge Point must send a BootNotification.req to the Central
+// System with information about its configuration (e.g. vendor, model, firmware
+// version). This is the first message every Charge Point sends, and it must be
+// repeated until the Central System accepts it.
 //
-//  Charge Points may also perform local authorization, bypassing the Central
-// System, by referencing a locally stored list of valid identifiers
-// (Local Authorization List). If the presented identifier (idTag) is not found
-// in this list or in the Authorization Cache, the Charge Point must forward an
-// Authorize.req message to the Central System for validation.
+// The Central System answers with a BootNotification.conf that contains its current
+// time, a registration status and an interval:
 //
-// Even if the identifier exists in the local list or cache, the Charge Point
-// may still choose to send the request to the Central System.
+//   - Accepted: the Charge Point is registered. The interval is the heartbeat
+//     interval in seconds, and the Charge Point should synchronize its clock with
+//     the returned currentTime.
+//   - Pending: the Central System wants to retrieve or set information before
+//     accepting the Charge Point. The interval is the minimum wait time before the
+//     next BootNotification.req.
+//   - Rejected: the Charge Point is not (yet) allowed to communicate. The interval
+//     is the minimum wait time before the next BootNotification.req.
 //
-// Upon receiving an Authorize.req, the Central System must respond with an
-// Authorize.conf. This response indicates whether the idTag is valid. If the
-// identifier is accepted, the response must include an authorization status
-// and may optionally contain a parentIdTag.
+// While not accepted, a Charge Point must not send any other request to the
+// Central System.
 //
-// If the Charge Point maintains an Authorization Cache and the idTag is not
-// part of the local list, then upon receiving an Authorize.conf, it must update
-// the cache entry using the details provided in the response.
-
+// Specification Reference:
+//   - OCPP 1.6J, Section 4.2: Boot Notification
+//   - OCPP 1.6J, Section 6.3 / 6.4: BootNotification.req / BootNotification.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/bootnotification"
 package bootnotification
@@ -1 +1,47 @@
 package bootnotification_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/messages/bootnotification"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleRequest() {
+	req, err := bootnotification.Request("VendorX", "ModelY")
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	firmware, err := types.CiString50("1.4.2")
+	if err != nil {
+		log.Fatalf("invalid firmware version: %v", err)
+	}
+
+	req.FirmwareVersion = &firmware
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"chargePointVendor":"VendorX","chargePointModel":"ModelY","firmwareVersion":"1.4.2"}
+}
+
+func ExampleConfirmation() {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	conf, err := bootnotification.Confirmation(bootnotification.RegistrationStatusAccepted, now, 300)
+	if err != nil {
+		log.Fatalf("failed to construct confirmation: %v", err)
+	}
+
+	fmt.Println(conf)
+	// Output:
+	// BootNotification.conf{currentTime=2025-01-02T03:04:05.000Z, interval=300, status=Accepted}
+}
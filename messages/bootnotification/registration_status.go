@@ -0,0 +1,40 @@
+package bootnotification
+
+import "errors"
+
+// ErrInvalidRegistrationStatus indicates that a RegistrationStatus is not one of the
+// values defined by OCPP 1.6J.
+var ErrInvalidRegistrationStatus = errors.New("invalid registration status")
+
+// RegistrationStatus is the result of a BootNotification.req, returned by the Central
+// System in the `status` field of BootNotification.conf.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.38: RegistrationStatus
+type RegistrationStatus string
+
+const (
+	// RegistrationStatusAccepted indicates that the Charge Point is accepted by the
+	// Central System.
+	RegistrationStatusAccepted RegistrationStatus = "Accepted"
+
+	// RegistrationStatusPending indicates that the Central System is not yet ready to
+	// accept the Charge Point. It may send messages to retrieve information or
+	// prepare the Charge Point.
+	RegistrationStatusPending RegistrationStatus = "Pending"
+
+	// RegistrationStatusRejected indicates that the Charge Point is not accepted by
+	// the Central System, for example because its identity is unknown.
+	RegistrationStatusRejected RegistrationStatus = "Rejected"
+)
+
+// IsValid returns true if the RegistrationStatus is one of the values defined by
+// OCPP 1.6J.
+func (s RegistrationStatus) IsValid() bool {
+	switch s {
+	case RegistrationStatusAccepted, RegistrationStatusPending, RegistrationStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,21 @@
+package bootnotification
+
+import "testing"
+
+func TestRegistrationStatusIsValid(t *testing.T) {
+	t.Parallel()
+
+	for _, status := range []RegistrationStatus{
+		RegistrationStatusAccepted, RegistrationStatusPending, RegistrationStatusRejected,
+	} {
+		if !status.IsValid() {
+			t.Errorf("expected IsValid() to return true for %s", status)
+		}
+	}
+
+	for _, status := range []RegistrationStatus{"", "accepted", "Registered"} {
+		if status.IsValid() {
+			t.Errorf("expected IsValid() to return false for %q", status)
+		}
+	}
+}
@@ -1 +1,220 @@
 package bootnotification
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// RequestMessage represents the OCPP 1.6J BootNotification.req message.
+//
+// This message is sent by a Charge Point to the Central System after start-up,
+// describing its hardware and firmware. Only the vendor and model are required;
+// all other fields are optional and are nil when not reported.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.3: BootNotification.req
+type RequestMessage struct {
+	// ChargePointVendor identifies the vendor of the Charge Point (CiString[20], required).
+	ChargePointVendor types.CiString20Type
+
+	// ChargePointModel identifies the model of the Charge Point (CiString[20], required).
+	ChargePointModel types.CiString20Type
+
+	// ChargePointSerialNumber is the serial number of the Charge Point (CiString[25]).
+	ChargePointSerialNumber *types.CiString25Type
+
+	// ChargeBoxSerialNumber is the serial number of the Charge Box inside the Charge
+	// Point (CiString[25]). Deprecated by the specification in favor of
+	// ChargePointSerialNumber, but still sent by many chargers.
+	ChargeBoxSerialNumber *types.CiString25Type
+
+	// FirmwareVersion is the firmware version of the Charge Point (CiString[50]).
+	FirmwareVersion *types.CiString50Type
+
+	// Iccid is the ICCID of the modem's SIM card (CiString[20]).
+	Iccid *types.CiString20Type
+
+	// Imsi is the IMSI of the modem's SIM card (CiString[20]).
+	Imsi *types.CiString20Type
+
+	// MeterType is the type of the main electrical meter of the Charge Point (CiString[25]).
+	MeterType *types.CiString25Type
+
+	// MeterSerialNumber is the serial number of the main electrical meter (CiString[25]).
+	MeterSerialNumber *types.CiString25Type
+}
+
+// Request constructs a new RequestMessage with the required vendor and model.
+//
+// Optional fields can be set on the returned message afterwards. An error is
+// returned if either value is not a valid CiString[20].
+//
+// Example usage:
+//
+//	req, err := bootnotification.Request("VendorX", "ModelY")
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+//	fw, _ := types.CiString50("1.4.2")
+//	req.FirmwareVersion = &fw
+func Request(chargePointVendor, chargePointModel string) (RequestMessage, error) {
+	vendor, vendorErr := types.Required("chargePointVendor", &chargePointVendor, types.CiString20)
+	model, modelErr := types.Required("chargePointModel", &chargePointModel, types.CiString20)
+
+	if err := types.Join(vendorErr, modelErr); err != nil {
+		return RequestMessage{}, fmt.Errorf("failed to create RequestMessage: %w", err)
+	}
+
+	return RequestMessage{
+		ChargePointVendor:       vendor,
+		ChargePointModel:        model,
+		ChargePointSerialNumber: nil,
+		ChargeBoxSerialNumber:   nil,
+		FirmwareVersion:         nil,
+		Iccid:                   nil,
+		Imsi:                    nil,
+		MeterType:               nil,
+		MeterSerialNumber:       nil,
+	}, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	return types.Join(
+		types.Field("chargePointVendor", r.ChargePointVendor),
+		types.Field("chargePointModel", r.ChargePointModel),
+		types.OptionalField("chargePointSerialNumber", r.ChargePointSerialNumber),
+		types.OptionalField("chargeBoxSerialNumber", r.ChargeBoxSerialNumber),
+		types.OptionalField("firmwareVersion", r.FirmwareVersion),
+		types.OptionalField("iccid", r.Iccid),
+		types.OptionalField("imsi", r.Imsi),
+		types.OptionalField("meterType", r.MeterType),
+		types.OptionalField("meterSerialNumber", r.MeterSerialNumber),
+	)
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// Optional fields are only included when set.
+func (r RequestMessage) String() string {
+	fields := []string{
+		"chargePointVendor=" + r.ChargePointVendor.String(),
+		"chargePointModel=" + r.ChargePointModel.String(),
+	}
+
+	optional := []struct {
+		name  string
+		value fmt.Stringer
+		isSet bool
+	}{
+		{"chargePointSerialNumber", r.ChargePointSerialNumber, r.ChargePointSerialNumber != nil},
+		{"chargeBoxSerialNumber", r.ChargeBoxSerialNumber, r.ChargeBoxSerialNumber != nil},
+		{"firmwareVersion", r.FirmwareVersion, r.FirmwareVersion != nil},
+		{"iccid", r.Iccid, r.Iccid != nil},
+		{"imsi", r.Imsi, r.Imsi != nil},
+		{"meterType", r.MeterType, r.MeterType != nil},
+		{"meterSerialNumber", r.MeterSerialNumber, r.MeterSerialNumber != nil},
+	}
+
+	for _, field := range optional {
+		if field.isSet {
+			fields = append(fields, field.name+"="+field.value.String())
+		}
+	}
+
+	return "BootNotification.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of BootNotification.req.
+type requestPayload struct {
+	ChargePointVendor       *string `json:"chargePointVendor"`
+	ChargePointModel        *string `json:"chargePointModel"`
+	ChargePointSerialNumber *string `json:"chargePointSerialNumber,omitempty"`
+	ChargeBoxSerialNumber   *string `json:"chargeBoxSerialNumber,omitempty"`
+	FirmwareVersion         *string `json:"firmwareVersion,omitempty"`
+	Iccid                   *string `json:"iccid,omitempty"`
+	Imsi                    *string `json:"imsi,omitempty"`
+	MeterType               *string `json:"meterType,omitempty"`
+	MeterSerialNumber       *string `json:"meterSerialNumber,omitempty"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J BootNotification.req payload.
+//
+// Unset optional fields are omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	vendor := r.ChargePointVendor.String()
+	model := r.ChargePointModel.String()
+
+	return json.Marshal(requestPayload{
+		ChargePointVendor:       &vendor,
+		ChargePointModel:        &model,
+		ChargePointSerialNumber: types.OptionalString(r.ChargePointSerialNumber),
+		ChargeBoxSerialNumber:   types.OptionalString(r.ChargeBoxSerialNumber),
+		FirmwareVersion:         types.OptionalString(r.FirmwareVersion),
+		Iccid:                   types.OptionalString(r.Iccid),
+		Imsi:                    types.OptionalString(r.Imsi),
+		MeterType:               types.OptionalString(r.MeterType),
+		MeterSerialNumber:       types.OptionalString(r.MeterSerialNumber),
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J BootNotification.req payload into the RequestMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	vendor, vendorErr := types.Required("chargePointVendor", payload.ChargePointVendor, types.CiString20)
+	model, modelErr := types.Required("chargePointModel", payload.ChargePointModel, types.CiString20)
+	cpSerial, cpSerialErr := types.Optional("chargePointSerialNumber", payload.ChargePointSerialNumber, types.CiString25)
+	cbSerial, cbSerialErr := types.Optional("chargeBoxSerialNumber", payload.ChargeBoxSerialNumber, types.CiString25)
+	firmware, firmwareErr := types.Optional("firmwareVersion", payload.FirmwareVersion, types.CiString50)
+	iccid, iccidErr := types.Optional("iccid", payload.Iccid, types.CiString20)
+	imsi, imsiErr := types.Optional("imsi", payload.Imsi, types.CiString20)
+	meterType, meterTypeErr := types.Optional("meterType", payload.MeterType, types.CiString25)
+	meterSerial, meterSerialErr := types.Optional("meterSerialNumber", payload.MeterSerialNumber, types.CiString25)
+
+	err := types.Join(
+		vendorErr, modelErr, cpSerialErr, cbSerialErr, firmwareErr, iccidErr, imsiErr, meterTypeErr, meterSerialErr,
+	)
+	if err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{
+		ChargePointVendor:       vendor,
+		ChargePointModel:        model,
+		ChargePointSerialNumber: cpSerial,
+		ChargeBoxSerialNumber:   cbSerial,
+		FirmwareVersion:         firmware,
+		Iccid:                   iccid,
+		Imsi:                    imsi,
+		MeterType:               meterType,
+		MeterSerialNumber:       meterSerial,
+	}
+
+	return nil
+}
@@ -1 +1,159 @@
 package bootnotification
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestBootNotificationRequestValid(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request("VendorX", "ModelY")
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	if req.ChargePointVendor.String() != "VendorX" || req.ChargePointModel.String() != "ModelY" {
+		t.Errorf("unexpected request contents: %s", req)
+	}
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+}
+
+func TestBootNotificationRequestInvalidVendorAndModel(t *testing.T) {
+	t.Parallel()
+
+	_, err := Request("", strings.Repeat("M", 21))
+	if !errors.Is(err, types.ErrEmptyValueNotAllowed) || !errors.Is(err, types.ErrExceedsMaxLength) {
+		t.Errorf("expected both vendor and model failures, got %v", err)
+	}
+}
+
+func TestBootNotificationRequestValidateOptionalFields(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request("VendorX", "ModelY")
+	req.FirmwareVersion = &types.CiString50Type{}
+
+	err := req.Validate()
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "firmwareVersion" {
+		t.Errorf("expected firmwareVersion failure, got %v", err)
+	}
+}
+
+func TestBootNotificationRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	req := RequestMessage{
+		ChargePointVendor:       types.CiString20Type{},
+		ChargePointModel:        types.CiString20Type{},
+		ChargePointSerialNumber: &types.CiString25Type{},
+		ChargeBoxSerialNumber:   nil,
+		FirmwareVersion:         nil,
+		Iccid:                   nil,
+		Imsi:                    &types.CiString20Type{},
+		MeterType:               nil,
+		MeterSerialNumber:       nil,
+	}
+
+	joined, ok := req.ValidateAll().(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("expected joined error, got %v", req.ValidateAll())
+	}
+
+	var paths []string
+
+	for _, err := range joined.Unwrap() {
+		var verr *types.ValidationError
+		if errors.As(err, &verr) {
+			paths = append(paths, verr.Path)
+		}
+	}
+
+	want := "chargePointVendor,chargePointModel,chargePointSerialNumber,imsi"
+	if strings.Join(paths, ",") != want {
+		t.Errorf("unexpected failure paths:\nwant: %s\ngot : %s", want, strings.Join(paths, ","))
+	}
+}
+
+func TestBootNotificationRequestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"chargePointVendor":"VendorX","chargePointModel":"ModelY","chargePointSerialNumber":"CP-001",` +
+		`"chargeBoxSerialNumber":"CB-001","firmwareVersion":"1.4.2","iccid":"8931440400000000001",` +
+		`"imsi":"204043000000001","meterType":"DIN-RAIL","meterSerialNumber":"MTR-001"}`
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatalf("unexpected error unmarshaling request: %v", err)
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestBootNotificationRequestMarshalJSONOmitsUnsetFields(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request("VendorX", "ModelY")
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	if string(out) != `{"chargePointVendor":"VendorX","chargePointModel":"ModelY"}` {
+		t.Errorf("unexpected JSON output: %s", out)
+	}
+
+	if _, err := json.Marshal(RequestMessage{}); err == nil {
+		t.Error("expected error marshaling zero-value RequestMessage, got nil")
+	}
+}
+
+func TestBootNotificationRequestUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"chargePointVendor":"VendorX","meterType":"`+strings.Repeat("X", 26)+`"}`), &req)
+	if !errors.Is(err, types.ErrMissingRequiredField) || !errors.Is(err, types.ErrExceedsMaxLength) {
+		t.Errorf("expected missing chargePointModel and too long meterType, got %v", err)
+	}
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "chargePointModel" {
+		t.Errorf("expected first failure at chargePointModel, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"chargePointVendor":1}`), &req); err == nil {
+		t.Error("expected error for non-string vendor, got nil")
+	}
+}
+
+func TestBootNotificationRequestString(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request("VendorX", "ModelY")
+	fw, _ := types.CiString50("1.4.2")
+	req.FirmwareVersion = &fw
+
+	want := "BootNotification.req{chargePointVendor=VendorX, chargePointModel=ModelY, firmwareVersion=1.4.2}"
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+}
@@ -0,0 +1,80 @@
+// Code generated by ocppgen from CancelReservation.json and CancelReservationResponse.json. DO NOT EDIT.
+
+package cancelreservation
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidCancelReservationStatus indicates that a CancelReservationStatus is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidCancelReservationStatus = errors.New("invalid cancel reservation status")
+
+// CancelReservationStatus enumerates the values allowed for the status field of CancelReservation.conf.
+type CancelReservationStatus string
+
+const (
+	// CancelReservationStatusAccepted is the "Accepted" value of CancelReservationStatus.
+	CancelReservationStatusAccepted CancelReservationStatus = "Accepted"
+
+	// CancelReservationStatusRejected is the "Rejected" value of CancelReservationStatus.
+	CancelReservationStatusRejected CancelReservationStatus = "Rejected"
+)
+
+// IsValid returns true if the CancelReservationStatus is one of the values defined by OCPP 1.6J.
+func (c CancelReservationStatus) IsValid() bool {
+	switch c {
+	case CancelReservationStatusAccepted, CancelReservationStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the CancelReservationStatus.
+func (c CancelReservationStatus) String() string {
+	return string(c)
+}
+
+// ParseCancelReservationStatus converts a wire value into a CancelReservationStatus. Unknown values are rejected with a
+// *types.ValidationError wrapping ErrInvalidCancelReservationStatus.
+func ParseCancelReservationStatus(value string) (CancelReservationStatus, error) {
+	return types.ParseEnum[CancelReservationStatus](value, ErrInvalidCancelReservationStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with ErrInvalidCancelReservationStatus if the
+// value is not recognized.
+func (c CancelReservationStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(c, ErrInvalidCancelReservationStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with ErrInvalidCancelReservationStatus if
+// the input is not recognized.
+func (c *CancelReservationStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseCancelReservationStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (c CancelReservationStatus) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (c *CancelReservationStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, c, ParseCancelReservationStatus)
+}
@@ -0,0 +1,87 @@
+package changeavailability
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidAvailabilityStatus indicates that a AvailabilityStatus is not one of
+// the values defined by OCPP 1.6J.
+var ErrInvalidAvailabilityStatus = errors.New("invalid availability status")
+
+// AvailabilityStatus is the result of a ChangeAvailability.req, reported in the
+// status field of ChangeAvailability.conf.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.3: AvailabilityStatus
+type AvailabilityStatus string
+
+const (
+	// AvailabilityStatusAccepted means that the availability has been changed.
+	AvailabilityStatusAccepted AvailabilityStatus = "Accepted"
+
+	// AvailabilityStatusRejected means that the availability cannot be changed.
+	AvailabilityStatusRejected AvailabilityStatus = "Rejected"
+
+	// AvailabilityStatusScheduled means that the availability will be changed once
+	// the transactions in progress have finished.
+	AvailabilityStatusScheduled AvailabilityStatus = "Scheduled"
+)
+
+// IsValid returns true if the AvailabilityStatus is one of the values defined by
+// OCPP 1.6J.
+func (a AvailabilityStatus) IsValid() bool {
+	switch a {
+	case AvailabilityStatusAccepted, AvailabilityStatusRejected, AvailabilityStatusScheduled:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the AvailabilityStatus.
+func (a AvailabilityStatus) String() string {
+	return string(a)
+}
+
+// ParseAvailabilityStatus converts a wire value into a AvailabilityStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidAvailabilityStatus.
+func ParseAvailabilityStatus(value string) (AvailabilityStatus, error) {
+	return types.ParseEnum[AvailabilityStatus](value, ErrInvalidAvailabilityStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidAvailabilityStatus if the value is not recognized.
+func (a AvailabilityStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(a, ErrInvalidAvailabilityStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidAvailabilityStatus if the input is not recognized.
+func (a *AvailabilityStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseAvailabilityStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (a AvailabilityStatus) MarshalJSON() ([]byte, error) {
+	text, err := a.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (a *AvailabilityStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, a, ParseAvailabilityStatus)
+}
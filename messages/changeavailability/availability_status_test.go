@@ -0,0 +1,20 @@
+package changeavailability
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestAvailabilityStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[AvailabilityStatus]{
+		Values: []AvailabilityStatus{
+			AvailabilityStatusAccepted, AvailabilityStatusRejected, AvailabilityStatusScheduled,
+		},
+		Invalid:  []string{"", "scheduled", "Pending"},
+		Sentinel: ErrInvalidAvailabilityStatus,
+		Parse:    ParseAvailabilityStatus,
+	})
+}
@@ -0,0 +1,84 @@
+package changeavailability
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidAvailabilityType indicates that a AvailabilityType is not one of the
+// values defined by OCPP 1.6J.
+var ErrInvalidAvailabilityType = errors.New("invalid availability type")
+
+// AvailabilityType is the availability requested by a ChangeAvailability.req.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.4: AvailabilityType
+type AvailabilityType string
+
+const (
+	// AvailabilityTypeInoperative makes the connector or Charge Point unavailable
+	// for charging.
+	AvailabilityTypeInoperative AvailabilityType = "Inoperative"
+
+	// AvailabilityTypeOperative makes the connector or Charge Point available for
+	// charging.
+	AvailabilityTypeOperative AvailabilityType = "Operative"
+)
+
+// IsValid returns true if the AvailabilityType is one of the values defined by
+// OCPP 1.6J.
+func (a AvailabilityType) IsValid() bool {
+	switch a {
+	case AvailabilityTypeInoperative, AvailabilityTypeOperative:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the AvailabilityType.
+func (a AvailabilityType) String() string {
+	return string(a)
+}
+
+// ParseAvailabilityType converts a wire value into a AvailabilityType. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidAvailabilityType.
+func ParseAvailabilityType(value string) (AvailabilityType, error) {
+	return types.ParseEnum[AvailabilityType](value, ErrInvalidAvailabilityType)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidAvailabilityType if the value is not recognized.
+func (a AvailabilityType) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(a, ErrInvalidAvailabilityType)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidAvailabilityType if the input is not recognized.
+func (a *AvailabilityType) UnmarshalText(text []byte) error {
+	parsed, err := ParseAvailabilityType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (a AvailabilityType) MarshalJSON() ([]byte, error) {
+	text, err := a.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (a *AvailabilityType) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, a, ParseAvailabilityType)
+}
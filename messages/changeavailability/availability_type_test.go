@@ -0,0 +1,20 @@
+package changeavailability
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestAvailabilityType(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[AvailabilityType]{
+		Values: []AvailabilityType{
+			AvailabilityTypeInoperative, AvailabilityTypeOperative,
+		},
+		Invalid:  []string{"", "operative", "Available"},
+		Sentinel: ErrInvalidAvailabilityType,
+		Parse:    ParseAvailabilityType,
+	})
+}
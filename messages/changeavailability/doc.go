@@ -0,0 +1,22 @@
+// Package changeavailability models the OCPP 1.6J ChangeAvailability message pair.
+//
+// A Central System sends a ChangeAvailability.req to make a connector, or the whole
+// Charge Point when connectorId is 0, Operative or Inoperative, for example to take
+// a charger out of service for maintenance.
+//
+// The Charge Point answers with a ChangeAvailability.conf. If a transaction is in
+// progress on the affected connector, the change cannot be applied immediately: the
+// Charge Point answers Scheduled and changes the availability once the transaction
+// has finished.
+//
+// Connector ids are never negative, so the request is validated beyond the JSON
+// schema: connectorId must be 0 or greater.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.2: Change Availability
+//   - OCPP 1.6J, Section 6.7 / 6.8: ChangeAvailability.req / ChangeAvailability.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/changeavailability"
+package changeavailability
@@ -0,0 +1,38 @@
+package changeavailability_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/changeavailability"
+)
+
+func ExampleRequest() {
+	req, err := changeavailability.Request(0, changeavailability.AvailabilityTypeInoperative)
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"connectorId":0,"type":"Inoperative"}
+}
+
+func ExampleConfirmationMessage_UnmarshalJSON() {
+	var conf changeavailability.ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{"status":"Scheduled"}`), &conf); err != nil {
+		log.Fatalf("failed to decode confirmation: %v", err)
+	}
+
+	if conf.Status == changeavailability.AvailabilityStatusScheduled {
+		fmt.Println("availability changes after the running transaction")
+	}
+	// Output:
+	// availability changes after the running transaction
+}
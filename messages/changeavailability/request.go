@@ -0,0 +1,129 @@
+package changeavailability
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidConnectorId indicates that the connectorId of a ChangeAvailability.req
+// is negative.
+var ErrInvalidConnectorId = errors.New("invalid connectorId")
+
+// RequestMessage represents the OCPP 1.6J ChangeAvailability.req message.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.7: ChangeAvailability.req
+type RequestMessage struct {
+	// ConnectorId is the connector whose availability should change, or 0 for the
+	// Charge Point as a whole.
+	ConnectorId int
+
+	// Type is the requested availability.
+	Type AvailabilityType
+}
+
+// Request constructs a new RequestMessage for the given connector and availability.
+//
+// It returns an error if connectorId is negative or availability is not a valid
+// AvailabilityType.
+//
+// Example usage:
+//
+//	req, err := changeavailability.Request(0, changeavailability.AvailabilityTypeInoperative)
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(connectorId int, availability AvailabilityType) (RequestMessage, error) {
+	req := RequestMessage{ConnectorId: connectorId, Type: availability}
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	errs := []error{connectorIdError(r.ConnectorId)}
+
+	if !r.Type.IsValid() {
+		errs = append(errs, types.EnumError("type", r.Type, ErrInvalidAvailabilityType))
+	}
+
+	return types.Join(errs...)
+}
+
+// connectorIdError reports a negative connectorId.
+func connectorIdError(connectorId int) error {
+	if connectorId < 0 {
+		return types.MinimumError("connectorId", connectorId, 0, ErrInvalidConnectorId)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+func (r RequestMessage) String() string {
+	return "ChangeAvailability.req{connectorId=" + strconv.Itoa(r.ConnectorId) + ", type=" + r.Type.String() + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of ChangeAvailability.req.
+type requestPayload struct {
+	ConnectorId *int    `json:"connectorId"`
+	Type        *string `json:"type"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J ChangeAvailability.req
+// payload. The message is validated first, so an invalid RequestMessage is never
+// encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	availability := r.Type.String()
+
+	return json.Marshal(requestPayload{ConnectorId: &r.ConnectorId, Type: &availability})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J ChangeAvailability.req payload into the
+// RequestMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	connectorId, connectorIdErr := types.RequiredValue("connectorId", payload.ConnectorId)
+	availability, typeErr := types.Required("type", payload.Type, ParseAvailabilityType)
+
+	if connectorIdErr == nil {
+		connectorIdErr = connectorIdError(connectorId)
+	}
+
+	if err := types.Join(connectorIdErr, typeErr); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{ConnectorId: connectorId, Type: availability}
+
+	return nil
+}
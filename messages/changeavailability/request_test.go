@@ -0,0 +1,68 @@
+package changeavailability
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestChangeAvailabilityRequest(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request(0, AvailabilityTypeInoperative)
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	if req.String() != "ChangeAvailability.req{connectorId=0, type=Inoperative}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil || string(out) != `{"connectorId":0,"type":"Inoperative"}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	if _, err := Request(-1, AvailabilityTypeOperative); !errors.Is(err, ErrInvalidConnectorId) {
+		t.Errorf("expected ErrInvalidConnectorId, got %v", err)
+	}
+
+	if _, err := json.Marshal(RequestMessage{ConnectorId: 1, Type: ""}); !errors.Is(err, ErrInvalidAvailabilityType) {
+		t.Errorf("expected ErrInvalidAvailabilityType, got %v", err)
+	}
+}
+
+func TestChangeAvailabilityRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	err := RequestMessage{ConnectorId: -1, Type: "Offline"}.ValidateAll()
+	if !errors.Is(err, ErrInvalidConnectorId) || !errors.Is(err, ErrInvalidAvailabilityType) {
+		t.Errorf("expected connectorId and type failures, got %v", err)
+	}
+}
+
+func TestChangeAvailabilityRequestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"connectorId":2,"type":"Operative"}`), &req); err != nil ||
+		req.ConnectorId != 2 || req.Type != AvailabilityTypeOperative {
+		t.Errorf("unexpected request: %v, %v", req, err)
+	}
+
+	err := json.Unmarshal([]byte(`{"connectorId":-1}`), &req)
+	if !errors.Is(err, ErrInvalidConnectorId) || !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected connectorId and missing type failures, got %v", err)
+	}
+
+	var verr *types.ValidationError
+	if err := json.Unmarshal([]byte(`{"connectorId":1,"type":"operative"}`), &req); !errors.As(err, &verr) || verr.Path != "type" {
+		t.Errorf("expected invalid type, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`[]`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
@@ -0,0 +1,93 @@
+package changeconfiguration
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidConfigurationStatus indicates that a ConfigurationStatus is not one
+// of the values defined by OCPP 1.6J.
+var ErrInvalidConfigurationStatus = errors.New("invalid configuration status")
+
+// ConfigurationStatus is the outcome of a ChangeConfiguration.req reported by
+// the Charge Point.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.22: ConfigurationStatus
+type ConfigurationStatus string
+
+const (
+	// ConfigurationStatusAccepted means the configuration change was accepted and
+	// applied.
+	ConfigurationStatusAccepted ConfigurationStatus = "Accepted"
+
+	// ConfigurationStatusRejected means the configuration change was rejected, for
+	// example because the value is out of range.
+	ConfigurationStatusRejected ConfigurationStatus = "Rejected"
+
+	// ConfigurationStatusRebootRequired means the configuration change was
+	// accepted, but only takes effect after a reboot.
+	ConfigurationStatusRebootRequired ConfigurationStatus = "RebootRequired"
+
+	// ConfigurationStatusNotSupported means the configuration key is not supported
+	// by the Charge Point.
+	ConfigurationStatusNotSupported ConfigurationStatus = "NotSupported"
+)
+
+// IsValid returns true if the ConfigurationStatus is one of the values defined
+// by OCPP 1.6J.
+func (c ConfigurationStatus) IsValid() bool {
+	switch c {
+	case ConfigurationStatusAccepted, ConfigurationStatusRejected, ConfigurationStatusRebootRequired, ConfigurationStatusNotSupported:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ConfigurationStatus.
+func (c ConfigurationStatus) String() string {
+	return string(c)
+}
+
+// ParseConfigurationStatus converts a wire value into a ConfigurationStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidConfigurationStatus.
+func ParseConfigurationStatus(value string) (ConfigurationStatus, error) {
+	return types.ParseEnum[ConfigurationStatus](value, ErrInvalidConfigurationStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidConfigurationStatus if the value is not recognized.
+func (c ConfigurationStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(c, ErrInvalidConfigurationStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidConfigurationStatus if the input is not recognized.
+func (c *ConfigurationStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseConfigurationStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (c ConfigurationStatus) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (c *ConfigurationStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, c, ParseConfigurationStatus)
+}
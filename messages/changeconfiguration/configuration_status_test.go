@@ -0,0 +1,21 @@
+package changeconfiguration
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestConfigurationStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[ConfigurationStatus]{
+		Values: []ConfigurationStatus{
+			ConfigurationStatusAccepted, ConfigurationStatusRejected, ConfigurationStatusRebootRequired,
+			ConfigurationStatusNotSupported,
+		},
+		Invalid:  []string{"", "accepted", "Pending"},
+		Sentinel: ErrInvalidConfigurationStatus,
+		Parse:    ParseConfigurationStatus,
+	})
+}
@@ -0,0 +1,20 @@
+// Package changeconfiguration models the OCPP 1.6J ChangeConfiguration message
+// pair.
+//
+// A Central System sends a ChangeConfiguration.req to set the value of one
+// configuration key of a Charge Point, for example HeartbeatInterval. Keys and
+// values are exchanged as strings: the key is a CiString50 and the value a
+// CiString500.
+//
+// The Charge Point answers with a ChangeConfiguration.conf telling whether the new
+// value was applied, is rejected, only takes effect after a reboot, or whether the
+// key is not supported at all.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.3: Change Configuration
+//   - OCPP 1.6J, Section 6.9 / 6.10: ChangeConfiguration.req / ChangeConfiguration.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/changeconfiguration"
+package changeconfiguration
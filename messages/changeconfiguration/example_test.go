@@ -0,0 +1,25 @@
+package changeconfiguration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/changeconfiguration"
+)
+
+func ExampleRequest() {
+	req, err := changeconfiguration.Request("HeartbeatInterval", "300")
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"key":"HeartbeatInterval","value":"300"}
+}
@@ -0,0 +1,106 @@
+package changeconfiguration
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// RequestMessage represents the OCPP 1.6J ChangeConfiguration.req message.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.9: ChangeConfiguration.req
+type RequestMessage struct {
+	// Key is the name of the configuration key to change.
+	Key types.CiString50Type
+
+	// Value is the new value of the key.
+	Value types.CiString500Type
+}
+
+// Request constructs a new RequestMessage setting key to value.
+//
+// It returns an error if key is not a valid CiString50 or value is not a valid
+// CiString500.
+//
+// Example usage:
+//
+//	req, err := changeconfiguration.Request("HeartbeatInterval", "300")
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(key, value string) (RequestMessage, error) {
+	ciKey, keyErr := types.Required("key", &key, types.CiString50)
+	ciValue, valueErr := types.Required("value", &value, types.CiString500)
+
+	if err := types.Join(keyErr, valueErr); err != nil {
+		return RequestMessage{}, fmt.Errorf("failed to create RequestMessage: %w", err)
+	}
+
+	return RequestMessage{Key: ciKey, Value: ciValue}, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	return types.Join(types.Field("key", r.Key), types.Field("value", r.Value))
+}
+
+// String returns a human-readable representation of the RequestMessage.
+func (r RequestMessage) String() string {
+	return "ChangeConfiguration.req{key=" + r.Key.String() + ", value=" + r.Value.String() + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of ChangeConfiguration.req.
+type requestPayload struct {
+	Key   *string `json:"key"`
+	Value *string `json:"value"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J ChangeConfiguration.req
+// payload. The message is validated first, so an invalid RequestMessage is never
+// encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := r.Key.String()
+	value := r.Value.String()
+
+	return json.Marshal(requestPayload{Key: &key, Value: &value})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J ChangeConfiguration.req payload into the
+// RequestMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	key, keyErr := types.Required("key", payload.Key, types.CiString50)
+	value, valueErr := types.Required("value", payload.Value, types.CiString500)
+
+	if err := types.Join(keyErr, valueErr); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{Key: key, Value: value}
+
+	return nil
+}
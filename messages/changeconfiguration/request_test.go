@@ -0,0 +1,62 @@
+package changeconfiguration
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestChangeConfigurationRequest(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request("HeartbeatInterval", "300")
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	if req.String() != "ChangeConfiguration.req{key=HeartbeatInterval, value=300}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil || string(out) != `{"key":"HeartbeatInterval","value":"300"}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	_, err = Request(strings.Repeat("K", 51), "")
+	if !errors.Is(err, types.ErrExceedsMaxLength) || !errors.Is(err, types.ErrEmptyValueNotAllowed) {
+		t.Errorf("expected key and value failures, got %v", err)
+	}
+
+	if _, err := json.Marshal(RequestMessage{}); err == nil {
+		t.Error("expected error marshaling invalid request, got nil")
+	}
+}
+
+func TestChangeConfigurationRequestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"key":"MeterValueSampleInterval","value":"60"}`), &req); err != nil ||
+		req.Key.String() != "MeterValueSampleInterval" || req.Value.String() != "60" {
+		t.Errorf("unexpected request: %v, %v", req, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"key":"HeartbeatInterval"}`), &req); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	var verr *types.ValidationError
+
+	data := `{"key":"HeartbeatInterval","value":"` + strings.Repeat("1", 501) + `"}`
+	if err := json.Unmarshal([]byte(data), &req); !errors.As(err, &verr) || verr.Path != "value" {
+		t.Errorf("expected too long value, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"key":"HeartbeatInterval","value":300}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
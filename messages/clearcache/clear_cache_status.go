@@ -0,0 +1,85 @@
+package clearcache
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidClearCacheStatus indicates that a ClearCacheStatus is not one of the
+// values defined by OCPP 1.6J.
+var ErrInvalidClearCacheStatus = errors.New("invalid clear cache status")
+
+// ClearCacheStatus is the result of a ClearCache.req, reported in the status
+// field of ClearCache.conf.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.20: ClearCacheStatus
+type ClearCacheStatus string
+
+const (
+	// ClearCacheStatusAccepted means that the Authorization Cache has been
+	// cleared.
+	ClearCacheStatusAccepted ClearCacheStatus = "Accepted"
+
+	// ClearCacheStatusRejected means that the Authorization Cache could not be
+	// cleared.
+	ClearCacheStatusRejected ClearCacheStatus = "Rejected"
+)
+
+// IsValid returns true if the ClearCacheStatus is one of the values defined by
+// OCPP 1.6J.
+func (c ClearCacheStatus) IsValid() bool {
+	switch c {
+	case ClearCacheStatusAccepted, ClearCacheStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ClearCacheStatus.
+func (c ClearCacheStatus) String() string {
+	return string(c)
+}
+
+// ParseClearCacheStatus converts a wire value into a ClearCacheStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidClearCacheStatus.
+func ParseClearCacheStatus(value string) (ClearCacheStatus, error) {
+	return types.ParseEnum[ClearCacheStatus](value, ErrInvalidClearCacheStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidClearCacheStatus if the value is not recognized.
+func (c ClearCacheStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(c, ErrInvalidClearCacheStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidClearCacheStatus if the input is not recognized.
+func (c *ClearCacheStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseClearCacheStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (c ClearCacheStatus) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (c *ClearCacheStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, c, ParseClearCacheStatus)
+}
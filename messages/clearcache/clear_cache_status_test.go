@@ -0,0 +1,20 @@
+package clearcache
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestClearCacheStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[ClearCacheStatus]{
+		Values: []ClearCacheStatus{
+			ClearCacheStatusAccepted, ClearCacheStatusRejected,
+		},
+		Invalid:  []string{"", "accepted", "Cleared"},
+		Sentinel: ErrInvalidClearCacheStatus,
+		Parse:    ParseClearCacheStatus,
+	})
+}
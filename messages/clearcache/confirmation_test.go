@@ -0,0 +1,39 @@
+package clearcache
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestClearCacheConfirmationStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		data       string
+		constraint string
+		sentinel   error
+	}{
+		{`{}`, types.ConstraintRequired, types.ErrMissingRequiredField},
+		{`{"status":"Cleared"}`, types.ConstraintEnum, ErrInvalidClearCacheStatus},
+	}
+
+	for _, tc := range tests {
+		var conf ConfirmationMessage
+
+		err := json.Unmarshal([]byte(tc.data), &conf)
+
+		var verr *types.ValidationError
+		if !errors.As(err, &verr) || verr.Path != "status" || verr.Constraint != tc.constraint ||
+			!errors.Is(err, tc.sentinel) {
+			t.Errorf("%s: expected %s failure at status, got %v", tc.data, tc.constraint, err)
+		}
+	}
+
+	_, err := json.Marshal(ConfirmationMessage{Status: "Cleared"})
+	if !errors.Is(err, ErrInvalidClearCacheStatus) {
+		t.Errorf("expected ErrInvalidClearCacheStatus encoding an invalid status, got %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+// Package clearcache models the OCPP 1.6J ClearCache message pair.
+//
+// A Central System sends a ClearCache.req to ask a Charge Point to clear its
+// Authorization Cache, the list of recently used idTags and their authorization
+// status. The request has no fields.
+//
+// The Charge Point answers with a ClearCache.conf telling whether the cache was
+// cleared. The Local Authorization List is not affected.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.4: Clear Cache
+//   - OCPP 1.6J, Section 6.11 / 6.12: ClearCache.req / ClearCache.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/clearcache"
+package clearcache
@@ -0,0 +1,25 @@
+package clearcache_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/clearcache"
+)
+
+func ExampleConfirmation() {
+	conf, err := clearcache.Confirmation(clearcache.ClearCacheStatusAccepted)
+	if err != nil {
+		log.Fatalf("failed to construct confirmation: %v", err)
+	}
+
+	payload, err := json.Marshal(conf)
+	if err != nil {
+		log.Fatalf("failed to encode confirmation: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"status":"Accepted"}
+}
@@ -0,0 +1,85 @@
+package clearchargingprofile
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidClearChargingProfileStatus indicates that a
+// ClearChargingProfileStatus is not one of the values defined by OCPP 1.6J.
+var ErrInvalidClearChargingProfileStatus = errors.New("invalid clear charging profile status")
+
+// ClearChargingProfileStatus is the outcome of a ClearChargingProfile.req
+// reported by the Charge Point.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.21: ClearChargingProfileStatus
+type ClearChargingProfileStatus string
+
+const (
+	// ClearChargingProfileStatusAccepted means one or more charging profiles
+	// matched the request and were cleared.
+	ClearChargingProfileStatusAccepted ClearChargingProfileStatus = "Accepted"
+
+	// ClearChargingProfileStatusUnknown means no charging profile matched the
+	// request.
+	ClearChargingProfileStatusUnknown ClearChargingProfileStatus = "Unknown"
+)
+
+// IsValid returns true if the ClearChargingProfileStatus is one of the values
+// defined by OCPP 1.6J.
+func (c ClearChargingProfileStatus) IsValid() bool {
+	switch c {
+	case ClearChargingProfileStatusAccepted, ClearChargingProfileStatusUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ClearChargingProfileStatus.
+func (c ClearChargingProfileStatus) String() string {
+	return string(c)
+}
+
+// ParseClearChargingProfileStatus converts a wire value into a ClearChargingProfileStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidClearChargingProfileStatus.
+func ParseClearChargingProfileStatus(value string) (ClearChargingProfileStatus, error) {
+	return types.ParseEnum[ClearChargingProfileStatus](value, ErrInvalidClearChargingProfileStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidClearChargingProfileStatus if the value is not recognized.
+func (c ClearChargingProfileStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(c, ErrInvalidClearChargingProfileStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidClearChargingProfileStatus if the input is not recognized.
+func (c *ClearChargingProfileStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseClearChargingProfileStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (c ClearChargingProfileStatus) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (c *ClearChargingProfileStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, c, ParseClearChargingProfileStatus)
+}
@@ -0,0 +1,20 @@
+package clearchargingprofile
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestClearChargingProfileStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[ClearChargingProfileStatus]{
+		Values: []ClearChargingProfileStatus{
+			ClearChargingProfileStatusAccepted, ClearChargingProfileStatusUnknown,
+		},
+		Invalid:  []string{"", "accepted", "Rejected"},
+		Sentinel: ErrInvalidClearChargingProfileStatus,
+		Parse:    ParseClearChargingProfileStatus,
+	})
+}
@@ -0,0 +1,92 @@
+package clearchargingprofile
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConfirmationMessage represents the OCPP 1.6J ClearChargingProfile.conf message.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.14: ClearChargingProfile.conf
+type ConfirmationMessage struct {
+	// Status tells whether the Charge Point found and cleared matching charging
+	// profiles.
+	Status ClearChargingProfileStatus
+}
+
+// Confirmation constructs a new ConfirmationMessage with the given status.
+//
+// It returns an error if the status is not a valid ClearChargingProfileStatus.
+func Confirmation(status ClearChargingProfileStatus) (ConfirmationMessage, error) {
+	conf := ConfirmationMessage{Status: status}
+	if err := conf.Validate(); err != nil {
+		return ConfirmationMessage{}, err
+	}
+
+	return conf, nil
+}
+
+// Validate performs internal validation on the ConfirmationMessage and returns the
+// first failure.
+func (m ConfirmationMessage) Validate() error {
+	if err := m.ValidateAll(); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the ConfirmationMessage and returns its
+// failures as *types.ValidationError values with their JSON path.
+func (m ConfirmationMessage) ValidateAll() error {
+	if !m.Status.IsValid() {
+		return types.EnumError("status", m.Status, ErrInvalidClearChargingProfileStatus)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+func (m ConfirmationMessage) String() string {
+	return "ClearChargingProfile.conf{status=" + m.Status.String() + "}"
+}
+
+// confirmationPayload is the OCPP 1.6J wire representation of
+// ClearChargingProfile.conf.
+type confirmationPayload struct {
+	Status *string `json:"status"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J
+// ClearChargingProfile.conf payload. The message is validated first, so an
+// invalid ConfirmationMessage is never encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	status := m.Status.String()
+
+	return json.Marshal(confirmationPayload{Status: &status})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J ClearChargingProfile.conf payload into the
+// ConfirmationMessage, validating it while decoding.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	status, err := types.Required("status", payload.Status, ParseClearChargingProfileStatus)
+	if err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	*m = ConfirmationMessage{Status: status}
+
+	return nil
+}
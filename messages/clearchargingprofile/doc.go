@@ -0,0 +1,23 @@
+// Package clearchargingprofile models the OCPP 1.6J ClearChargingProfile message
+// pair.
+//
+// A Central System sends a ClearChargingProfile.req to remove charging profiles
+// from a Charge Point: either a single profile identified by its id, or every
+// profile matching the optional connectorId, chargingProfilePurpose and stackLevel
+// criteria.
+//
+// The Charge Point answers with a ClearChargingProfile.conf telling whether any
+// profile matched the request.
+//
+// Connector ids and stack levels are never negative, so the request is validated
+// beyond the JSON schema: connectorId and stackLevel must be 0 or greater when
+// set.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.5: Clear Charging Profile
+//   - OCPP 1.6J, Section 6.13 / 6.14: ClearChargingProfile.req / ClearChargingProfile.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/clearchargingprofile"
+package clearchargingprofile
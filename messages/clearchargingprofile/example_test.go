@@ -0,0 +1,41 @@
+package clearchargingprofile_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/clearchargingprofile"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleRequest() {
+	connectorId := 1
+	purpose := types.ChargingProfilePurposeTypeTxDefaultProfile
+
+	req := clearchargingprofile.Request()
+	req.ConnectorId = &connectorId
+	req.ChargingProfilePurpose = &purpose
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"connectorId":1,"chargingProfilePurpose":"TxDefaultProfile"}
+}
+
+func ExampleConfirmationMessage_UnmarshalJSON() {
+	var conf clearchargingprofile.ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{"status":"Unknown"}`), &conf); err != nil {
+		log.Fatalf("failed to decode confirmation: %v", err)
+	}
+
+	if conf.Status == clearchargingprofile.ClearChargingProfileStatusUnknown {
+		fmt.Println("no charging profile matched")
+	}
+	// Output:
+	// no charging profile matched
+}
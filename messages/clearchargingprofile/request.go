@@ -0,0 +1,193 @@
+package clearchargingprofile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidConnectorId indicates that the connectorId of a
+// ClearChargingProfile.req is negative.
+var ErrInvalidConnectorId = errors.New("invalid connectorId")
+
+// RequestMessage represents the OCPP 1.6J ClearChargingProfile.req message.
+//
+// Every field is optional and nil when it is not set. The fields that are set
+// select the charging profiles to clear.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.13: ClearChargingProfile.req
+type RequestMessage struct {
+	// Id is the chargingProfileId of the profile to clear.
+	Id *int
+
+	// ConnectorId is the connector whose profiles are cleared, or 0 for the profiles
+	// of the Charge Point as a whole.
+	ConnectorId *int
+
+	// ChargingProfilePurpose restricts the profiles to clear to one purpose.
+	ChargingProfilePurpose *types.ChargingProfilePurposeType
+
+	// StackLevel restricts the profiles to clear to one stack level.
+	StackLevel *int
+}
+
+// Request constructs a new RequestMessage without selection criteria. It cannot
+// fail, since every field of ClearChargingProfile.req is optional.
+//
+// The criteria can be set on the returned message afterwards.
+//
+// Example usage:
+//
+//	req := clearchargingprofile.Request()
+//	req.Id = &chargingProfileId
+func Request() RequestMessage {
+	return RequestMessage{
+		Id:                     nil,
+		ConnectorId:            nil,
+		ChargingProfilePurpose: nil,
+		StackLevel:             nil,
+	}
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	var errs []error
+
+	if r.ConnectorId != nil {
+		errs = append(errs, connectorIdError(*r.ConnectorId))
+	}
+
+	if r.ChargingProfilePurpose != nil && !r.ChargingProfilePurpose.IsValid() {
+		errs = append(errs, types.EnumError("chargingProfilePurpose", *r.ChargingProfilePurpose,
+			types.ErrInvalidChargingProfilePurposeType))
+	}
+
+	if r.StackLevel != nil {
+		errs = append(errs, stackLevelError(*r.StackLevel))
+	}
+
+	return types.Join(errs...)
+}
+
+// connectorIdError reports a negative connectorId.
+func connectorIdError(connectorId int) error {
+	if connectorId < 0 {
+		return types.MinimumError("connectorId", connectorId, 0, ErrInvalidConnectorId)
+	}
+
+	return nil
+}
+
+// stackLevelError reports a negative stackLevel.
+func stackLevelError(stackLevel int) error {
+	if stackLevel < 0 {
+		return types.MinimumError("stackLevel", stackLevel, 0, types.ErrInvalidStackLevel)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// Optional fields are only included when set.
+func (r RequestMessage) String() string {
+	var fields []string
+
+	if r.Id != nil {
+		fields = append(fields, "id="+strconv.Itoa(*r.Id))
+	}
+
+	if r.ConnectorId != nil {
+		fields = append(fields, "connectorId="+strconv.Itoa(*r.ConnectorId))
+	}
+
+	if r.ChargingProfilePurpose != nil {
+		fields = append(fields, "chargingProfilePurpose="+r.ChargingProfilePurpose.String())
+	}
+
+	if r.StackLevel != nil {
+		fields = append(fields, "stackLevel="+strconv.Itoa(*r.StackLevel))
+	}
+
+	return "ClearChargingProfile.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of ClearChargingProfile.req.
+type requestPayload struct {
+	Id                     *int    `json:"id,omitempty"`
+	ConnectorId            *int    `json:"connectorId,omitempty"`
+	ChargingProfilePurpose *string `json:"chargingProfilePurpose,omitempty"`
+	StackLevel             *int    `json:"stackLevel,omitempty"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J ClearChargingProfile.req
+// payload.
+//
+// Unset optional fields are omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(requestPayload{
+		Id:                     r.Id,
+		ConnectorId:            r.ConnectorId,
+		ChargingProfilePurpose: types.OptionalString(r.ChargingProfilePurpose),
+		StackLevel:             r.StackLevel,
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J ClearChargingProfile.req payload into the
+// RequestMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	var connectorIdErr, stackLevelErr error
+
+	if payload.ConnectorId != nil {
+		connectorIdErr = connectorIdError(*payload.ConnectorId)
+	}
+
+	purpose, purposeErr := types.Optional("chargingProfilePurpose", payload.ChargingProfilePurpose,
+		types.ParseChargingProfilePurposeType)
+
+	if payload.StackLevel != nil {
+		stackLevelErr = stackLevelError(*payload.StackLevel)
+	}
+
+	if err := types.Join(connectorIdErr, purposeErr, stackLevelErr); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{
+		Id:                     payload.Id,
+		ConnectorId:            payload.ConnectorId,
+		ChargingProfilePurpose: purpose,
+		StackLevel:             payload.StackLevel,
+	}
+
+	return nil
+}
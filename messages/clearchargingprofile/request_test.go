@@ -0,0 +1,96 @@
+package clearchargingprofile
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestClearChargingProfileRequest(t *testing.T) {
+	t.Parallel()
+
+	req := Request()
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected empty request to be valid, got %v", err)
+	}
+
+	if req.String() != "ClearChargingProfile.req{}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+
+	connectorId, stackLevel := 1, 2
+	purpose := types.ChargingProfilePurposeTypeTxDefaultProfile
+	req.ConnectorId = &connectorId
+	req.ChargingProfilePurpose = &purpose
+	req.StackLevel = &stackLevel
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	want := "ClearChargingProfile.req{connectorId=1, chargingProfilePurpose=TxDefaultProfile, stackLevel=2}"
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+}
+
+func TestClearChargingProfileRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	connectorId, stackLevel := -1, -1
+	purpose := types.ChargingProfilePurposeType("Tx")
+	req := RequestMessage{Id: nil, ConnectorId: &connectorId, ChargingProfilePurpose: &purpose, StackLevel: &stackLevel}
+
+	err := req.ValidateAll()
+	if !errors.Is(err, ErrInvalidConnectorId) || !errors.Is(err, types.ErrInvalidChargingProfilePurposeType) ||
+		!errors.Is(err, types.ErrInvalidStackLevel) {
+		t.Errorf("expected connectorId, purpose and stackLevel failures, got %v", err)
+	}
+
+	if _, err := json.Marshal(req); !errors.Is(err, ErrInvalidConnectorId) {
+		t.Errorf("expected ErrInvalidConnectorId, got %v", err)
+	}
+}
+
+func TestClearChargingProfileRequestJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{}`, `{"id":7}`, `{"connectorId":0,"chargingProfilePurpose":"TxProfile","stackLevel":0}`} {
+		var req RequestMessage
+		if err := json.Unmarshal([]byte(data), &req); err != nil {
+			t.Fatalf("%s: unexpected error unmarshaling request: %v", data, err)
+		}
+
+		out, err := json.Marshal(req)
+		if err != nil || string(out) != data {
+			t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s, %v", data, out, err)
+		}
+	}
+
+	tests := []struct {
+		data string
+		path string
+	}{
+		{`{"connectorId":-1}`, "connectorId"},
+		{`{"chargingProfilePurpose":"Tx"}`, "chargingProfilePurpose"},
+		{`{"stackLevel":-1}`, "stackLevel"},
+	}
+
+	for _, tc := range tests {
+		var req RequestMessage
+
+		err := json.Unmarshal([]byte(tc.data), &req)
+
+		var verr *types.ValidationError
+		if !errors.As(err, &verr) || verr.Path != tc.path {
+			t.Errorf("%s: expected failure at %s, got %v", tc.data, tc.path, err)
+		}
+	}
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`[]`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
@@ -0,0 +1,119 @@
+package datatransfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConfirmationMessage represents the OCPP 1.6J DataTransfer.conf message.
+//
+// Status is required; Data is nil when the recipient returns no response data.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.16: DataTransfer.conf
+type ConfirmationMessage struct {
+	// Status indicates the success or failure of the data transfer.
+	Status DataTransferStatus
+
+	// Data is the response text, in a format agreed upon by the vendor.
+	Data *string
+}
+
+// Confirmation constructs a new ConfirmationMessage with the given status.
+//
+// Data can be set on the returned message afterwards. It returns an error if the
+// status is not a valid DataTransferStatus.
+func Confirmation(status DataTransferStatus) (ConfirmationMessage, error) {
+	conf := ConfirmationMessage{
+		Status: status,
+		Data:   nil,
+	}
+
+	if err := conf.Validate(); err != nil {
+		return ConfirmationMessage{}, err
+	}
+
+	return conf, nil
+}
+
+// Validate performs internal validation on the ConfirmationMessage and returns the
+// first failure.
+func (m ConfirmationMessage) Validate() error {
+	if err := types.FirstError(m.ValidateAll()); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the ConfirmationMessage and returns all
+// failures joined with errors.Join, each as a *types.ValidationError with its JSON
+// path.
+func (m ConfirmationMessage) ValidateAll() error {
+	if !m.Status.IsValid() {
+		return types.EnumError("status", m.Status, ErrInvalidDataTransferStatus)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+//
+// Data is only included when set.
+func (m ConfirmationMessage) String() string {
+	fields := []string{"status=" + m.Status.String()}
+
+	if m.Data != nil {
+		fields = append(fields, "data="+*m.Data)
+	}
+
+	return "DataTransfer.conf{" + strings.Join(fields, ", ") + "}"
+}
+
+// confirmationPayload is the OCPP 1.6J wire representation of DataTransfer.conf.
+type confirmationPayload struct {
+	Status *string `json:"status"`
+	Data   *string `json:"data,omitempty"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J DataTransfer.conf
+// payload.
+//
+// The message is validated first, so an invalid ConfirmationMessage is never
+// encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	status := m.Status.String()
+
+	return json.Marshal(confirmationPayload{
+		Status: &status,
+		Data:   m.Data,
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J DataTransfer.conf payload into the
+// ConfirmationMessage, validating it while decoding.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	status, err := types.Required("status", payload.Status, ParseDataTransferStatus)
+	if err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	*m = ConfirmationMessage{
+		Status: status,
+		Data:   payload.Data,
+	}
+
+	return nil
+}
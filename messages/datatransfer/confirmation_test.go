@@ -0,0 +1,78 @@
+package datatransfer
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestDataTransferConfirmationValid(t *testing.T) {
+	t.Parallel()
+
+	conf, err := Confirmation(DataTransferStatusAccepted)
+	if err != nil {
+		t.Fatalf("unexpected error creating confirmation: %v", err)
+	}
+
+	data := "42"
+	conf.Data = &data
+
+	if conf.String() != "DataTransfer.conf{status=Accepted, data=42}" {
+		t.Errorf("unexpected String() output: %s", conf.String())
+	}
+}
+
+func TestDataTransferConfirmationInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := Confirmation("Unknown")
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "status" || !errors.Is(err, ErrInvalidDataTransferStatus) {
+		t.Errorf("expected invalid status, got %v", err)
+	}
+
+	if _, err := json.Marshal(ConfirmationMessage{}); !errors.Is(err, ErrInvalidDataTransferStatus) {
+		t.Errorf("expected ErrInvalidDataTransferStatus, got %v", err)
+	}
+}
+
+func TestDataTransferConfirmationJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"status":"UnknownMessageId","data":"try Price"}`
+
+	var conf ConfirmationMessage
+	if err := json.Unmarshal([]byte(data), &conf); err != nil {
+		t.Fatalf("unexpected error unmarshaling confirmation: %v", err)
+	}
+
+	out, err := json.Marshal(conf)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling confirmation: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestDataTransferConfirmationUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var conf ConfirmationMessage
+
+	if err := json.Unmarshal([]byte(`{}`), &conf); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"status":"Pending"}`), &conf); !errors.Is(err, ErrInvalidDataTransferStatus) {
+		t.Errorf("expected ErrInvalidDataTransferStatus, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"status":1}`), &conf); err == nil {
+		t.Error("expected error for malformed confirmation, got nil")
+	}
+}
@@ -0,0 +1,93 @@
+package datatransfer
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidDataTransferStatus indicates that a DataTransferStatus is not one of the
+// values defined by OCPP 1.6J.
+var ErrInvalidDataTransferStatus = errors.New("invalid data transfer status")
+
+// DataTransferStatus is the status returned in a DataTransfer.conf.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.23: DataTransferStatus
+type DataTransferStatus string
+
+const (
+	// DataTransferStatusAccepted means that the message has been accepted and the
+	// contained request is accepted.
+	DataTransferStatusAccepted DataTransferStatus = "Accepted"
+
+	// DataTransferStatusRejected means that the message has been accepted but the
+	// contained request is rejected.
+	DataTransferStatusRejected DataTransferStatus = "Rejected"
+
+	// DataTransferStatusUnknownMessageId means that the message could not be
+	// interpreted due to an unknown messageId.
+	DataTransferStatusUnknownMessageId DataTransferStatus = "UnknownMessageId"
+
+	// DataTransferStatusUnknownVendorId means that the message could not be
+	// interpreted due to an unknown vendorId.
+	DataTransferStatusUnknownVendorId DataTransferStatus = "UnknownVendorId"
+)
+
+// IsValid returns true if the DataTransferStatus is one of the values defined by
+// OCPP 1.6J.
+func (d DataTransferStatus) IsValid() bool {
+	switch d {
+	case DataTransferStatusAccepted, DataTransferStatusRejected, DataTransferStatusUnknownMessageId,
+		DataTransferStatusUnknownVendorId:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the DataTransferStatus.
+func (d DataTransferStatus) String() string {
+	return string(d)
+}
+
+// ParseDataTransferStatus converts a wire value into a DataTransferStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidDataTransferStatus.
+func ParseDataTransferStatus(value string) (DataTransferStatus, error) {
+	return types.ParseEnum[DataTransferStatus](value, ErrInvalidDataTransferStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidDataTransferStatus if the value is not recognized.
+func (d DataTransferStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(d, ErrInvalidDataTransferStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidDataTransferStatus if the input is not recognized.
+func (d *DataTransferStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseDataTransferStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (d DataTransferStatus) MarshalJSON() ([]byte, error) {
+	text, err := d.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (d *DataTransferStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, d, ParseDataTransferStatus)
+}
@@ -0,0 +1,21 @@
+package datatransfer
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestDataTransferStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[DataTransferStatus]{
+		Values: []DataTransferStatus{
+			DataTransferStatusAccepted, DataTransferStatusRejected, DataTransferStatusUnknownMessageId,
+			DataTransferStatusUnknownVendorId,
+		},
+		Invalid:  []string{"", "accepted", "UnknownMessageID"},
+		Sentinel: ErrInvalidDataTransferStatus,
+		Parse:    ParseDataTransferStatus,
+	})
+}
@@ -0,0 +1,26 @@
+// Package datatransfer models the OCPP 1.6J DataTransfer message pair.
+//
+// DataTransfer lets a Charge Point and a Central System exchange information for
+// functions that are not supported by OCPP. It can be initiated by either side.
+// The request names the vendor (vendorId), optionally a specific message
+// (messageId), and carries the data as text whose format is agreed upon by the
+// vendor.
+//
+// The recipient answers with a DataTransfer.conf that reports whether the vendor and
+// message are known and whether the data was accepted, optionally with response
+// data of its own.
+//
+// Since the data is opaque to OCPP, this package also provides an extension
+// Registry: a Go type can be registered per (vendorId, messageId) pair, so that the
+// data of matching requests and confirmations is decoded and validated as a typed
+// value instead of being passed around as a raw string.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 4.3: Data Transfer (Charge Point initiated)
+//   - OCPP 1.6J, Section 5.6: Data Transfer (Central System initiated)
+//   - OCPP 1.6J, Section 6.15 / 6.16: DataTransfer.req / DataTransfer.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/datatransfer"
+package datatransfer
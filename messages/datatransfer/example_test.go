@@ -0,0 +1,88 @@
+package datatransfer_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/datatransfer"
+)
+
+// Price is the data of the vendor's "Price" request.
+type Price struct {
+	Tariff string `json:"tariff"`
+}
+
+// Validate requires a tariff.
+func (p Price) Validate() error {
+	if p.Tariff == "" {
+		return errors.New("missing tariff")
+	}
+
+	return nil
+}
+
+// Quote is the data of the vendor's "Price" confirmation.
+type Quote struct {
+	PerKWh float64 `json:"perKWh"`
+}
+
+// Validate rejects negative prices.
+func (q Quote) Validate() error {
+	if q.PerKWh < 0 {
+		return errors.New("negative price")
+	}
+
+	return nil
+}
+
+func ExampleRequest() {
+	req, err := datatransfer.Request("com.example")
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	req.Data, err = datatransfer.EncodeData(Price{Tariff: "Night"})
+	if err != nil {
+		log.Fatalf("failed to encode data: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"vendorId":"com.example","data":"{\"tariff\":\"Night\"}"}
+}
+
+func ExampleRegistry() {
+	reg := datatransfer.NewRegistry()
+	if err := reg.Register(datatransfer.Define[Price, Quote]("com.example", "Price")); err != nil {
+		log.Fatalf("failed to register extension: %v", err)
+	}
+
+	var req datatransfer.RequestMessage
+	if err := json.Unmarshal([]byte(`{"vendorId":"com.example","messageId":"Price","data":"{\"tariff\":\"Night\"}"}`), &req); err != nil {
+		log.Fatalf("failed to decode request: %v", err)
+	}
+
+	data, err := reg.DecodeRequestData(req)
+	if err != nil {
+		log.Fatalf("failed to decode data: %v", err)
+	}
+
+	if price, ok := data.(Price); ok {
+		fmt.Println(price.Tariff)
+	}
+
+	req.MessageId = nil
+	if _, err := reg.DecodeRequestData(req); errors.Is(err, datatransfer.ErrUnknownMessageId) {
+		fmt.Println(datatransfer.DataTransferStatusUnknownMessageId)
+	}
+	// Output:
+	// Night
+	// UnknownMessageId
+}
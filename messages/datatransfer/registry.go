@@ -0,0 +1,258 @@
+package datatransfer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// Predefined errors returned by the extension Registry.
+var (
+	// ErrUnknownVendorId indicates that no extension is registered for the vendorId.
+	// A recipient should answer with DataTransferStatusUnknownVendorId.
+	ErrUnknownVendorId = errors.New("unknown vendorId")
+
+	// ErrUnknownMessageId indicates that the vendorId is known but no extension is
+	// registered for the messageId. A recipient should answer with
+	// DataTransferStatusUnknownMessageId.
+	ErrUnknownMessageId = errors.New("unknown messageId")
+
+	// ErrDuplicateExtension indicates that an extension is already registered for a
+	// (vendorId, messageId) pair.
+	ErrDuplicateExtension = errors.New("extension already registered")
+
+	// ErrInvalidExtension indicates that an extension definition is incomplete or
+	// that its vendorId or messageId is not valid.
+	ErrInvalidExtension = errors.New("invalid extension definition")
+)
+
+// Data is implemented by the typed values carried in the data field of DataTransfer
+// messages.
+//
+// Values are encoded as JSON text in the data field, so a Data type should support
+// encoding/json.
+type Data interface {
+	// Validate checks the value against the rules of the vendor.
+	Validate() error
+}
+
+// decodeFunc decodes and validates the data field into a typed Data value.
+type decodeFunc func(data string) (Data, error)
+
+// Extension describes the typed request and confirmation data of a vendor-specific
+// DataTransfer message.
+//
+// Use Define to build an Extension from the Go types of its data.
+type Extension struct {
+	// VendorId is the vendorId of the DataTransfer messages.
+	VendorId string
+
+	// MessageId is the messageId of the DataTransfer messages, or empty for messages
+	// without a messageId.
+	MessageId string
+
+	decodeRequest      decodeFunc
+	decodeConfirmation decodeFunc
+}
+
+// Define returns the Extension for vendorId and messageId, decoding the data of
+// requests into Req and the data of confirmations into Conf.
+//
+// Decoding uses encoding/json and every decoded value is validated before it is
+// returned. An empty messageId matches requests without a messageId.
+//
+// Example usage:
+//
+//	ext := datatransfer.Define[PriceRequest, PriceResponse]("com.example", "Price")
+func Define[Req, Conf Data](vendorId, messageId string) Extension {
+	return Extension{
+		VendorId:           vendorId,
+		MessageId:          messageId,
+		decodeRequest:      decoder[Req](),
+		decodeConfirmation: decoder[Conf](),
+	}
+}
+
+// decoder returns a decodeFunc producing values of type T.
+func decoder[T Data]() decodeFunc {
+	return func(data string) (Data, error) {
+		var value T
+		if err := json.Unmarshal([]byte(data), &value); err != nil {
+			return nil, err
+		}
+
+		if err := value.Validate(); err != nil {
+			return nil, err
+		}
+
+		return value, nil
+	}
+}
+
+// EncodeData validates value and encodes it as the text of a data field.
+//
+// Example usage:
+//
+//	req.Data, err = datatransfer.EncodeData(PriceRequest{Tariff: "Night"})
+func EncodeData(value Data) (*string, error) {
+	if err := value.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+
+	text := string(encoded)
+
+	return &text, nil
+}
+
+// Registry holds the known extensions, keyed by vendorId and messageId. Both are
+// CiStrings, so they are matched case-insensitively. It is safe for concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	vendors map[string]map[string]Extension
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{vendors: make(map[string]map[string]Extension)}
+}
+
+// Register adds an extension to the registry.
+//
+// It fails with ErrInvalidExtension if the extension was not built with Define or
+// its vendorId or messageId is not a valid CiString, and with ErrDuplicateExtension
+// if an extension is already registered for the same pair.
+func (r *Registry) Register(ext Extension) error {
+	if err := ext.validate(); err != nil {
+		return err
+	}
+
+	vendorId, messageId := strings.ToLower(ext.VendorId), strings.ToLower(ext.MessageId)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	messages, ok := r.vendors[vendorId]
+	if !ok {
+		messages = make(map[string]Extension)
+		r.vendors[vendorId] = messages
+	}
+
+	if _, exists := messages[messageId]; exists {
+		return fmt.Errorf("%w: %s", ErrDuplicateExtension, ext)
+	}
+
+	messages[messageId] = ext
+
+	return nil
+}
+
+// validate checks that the extension is complete and that its identifiers are
+// valid CiStrings.
+func (e Extension) validate() error {
+	if e.decodeRequest == nil || e.decodeConfirmation == nil {
+		return fmt.Errorf("%w: %s", ErrInvalidExtension, e)
+	}
+
+	_, vendorIdErr := types.CiString255(e.VendorId)
+
+	var messageIdErr error
+	if e.MessageId != "" {
+		_, messageIdErr = types.CiString50(e.MessageId)
+	}
+
+	if err := types.Join(types.WithPath("vendorId", vendorIdErr), types.WithPath("messageId", messageIdErr)); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidExtension, e, err)
+	}
+
+	return nil
+}
+
+// String returns the vendorId and messageId of the extension.
+func (e Extension) String() string {
+	if e.MessageId == "" {
+		return e.VendorId
+	}
+
+	return e.VendorId + "/" + e.MessageId
+}
+
+// Lookup returns the extension registered for vendorId and messageId.
+//
+// It fails with ErrUnknownVendorId if no extension is registered for the vendor,
+// and with ErrUnknownMessageId if the vendor is known but the message is not.
+func (r *Registry) Lookup(vendorId, messageId string) (Extension, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	messages, ok := r.vendors[strings.ToLower(vendorId)]
+	if !ok {
+		return Extension{}, fmt.Errorf("%w: %s", ErrUnknownVendorId, vendorId)
+	}
+
+	ext, ok := messages[strings.ToLower(messageId)]
+	if !ok {
+		return Extension{}, fmt.Errorf("%w: %s", ErrUnknownMessageId, messageId)
+	}
+
+	return ext, nil
+}
+
+// DecodeRequestData decodes the data of req into the typed value of its extension.
+//
+// It returns a nil Data if the request carries no data. The lookup errors of Lookup
+// are returned as is, so that they can be mapped to the status of the
+// DataTransfer.conf.
+func (r *Registry) DecodeRequestData(req RequestMessage) (Data, error) {
+	ext, err := r.lookupMessage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return ext.decode("request", req.Data, ext.decodeRequest)
+}
+
+// DecodeConfirmationData decodes the data of conf, sent in response to req, into
+// the typed value of the extension of req.
+//
+// It returns a nil Data if the confirmation carries no data.
+func (r *Registry) DecodeConfirmationData(req RequestMessage, conf ConfirmationMessage) (Data, error) {
+	ext, err := r.lookupMessage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return ext.decode("confirmation", conf.Data, ext.decodeConfirmation)
+}
+
+// lookupMessage returns the extension of the vendorId and messageId of req.
+func (r *Registry) lookupMessage(req RequestMessage) (Extension, error) {
+	messageId := ""
+	if req.MessageId != nil {
+		messageId = req.MessageId.String()
+	}
+
+	return r.Lookup(req.VendorId.String(), messageId)
+}
+
+// decode runs fn on data, if set, and reports failures with the extension name.
+func (e Extension) decode(kind string, data *string, fn decodeFunc) (Data, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	value, err := fn(*data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %s data: %w", e, kind, err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,180 @@
+package datatransfer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// errMissingTariff is returned by priceRequest.Validate.
+var errMissingTariff = errors.New("missing tariff")
+
+// priceRequest and priceResponse are the typed data of a test extension.
+type priceRequest struct {
+	Tariff string `json:"tariff"`
+}
+
+func (p priceRequest) Validate() error {
+	if p.Tariff == "" {
+		return errMissingTariff
+	}
+
+	return nil
+}
+
+type priceResponse struct {
+	Price float64 `json:"price"`
+}
+
+func (priceResponse) Validate() error {
+	return nil
+}
+
+// priceMessage returns a DataTransfer.req for the test extension.
+func priceMessage(t *testing.T, vendorId, messageId string, data *string) RequestMessage {
+	t.Helper()
+
+	req, err := Request(vendorId)
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	if messageId != "" {
+		id, _ := types.CiString50(messageId)
+		req.MessageId = &id
+	}
+
+	req.Data = data
+
+	return req
+}
+
+func newPriceRegistry(t *testing.T) *Registry {
+	t.Helper()
+
+	reg := NewRegistry()
+	if err := reg.Register(Define[priceRequest, priceResponse]("com.example", "Price")); err != nil {
+		t.Fatalf("unexpected error registering extension: %v", err)
+	}
+
+	return reg
+}
+
+func TestRegistryDecodeRequestData(t *testing.T) {
+	t.Parallel()
+
+	reg := newPriceRegistry(t)
+	data := `{"tariff":"Night"}`
+
+	value, err := reg.DecodeRequestData(priceMessage(t, "COM.EXAMPLE", "price", &data))
+	if err != nil {
+		t.Fatalf("unexpected error decoding data: %v", err)
+	}
+
+	if req, ok := value.(priceRequest); !ok || req.Tariff != "Night" {
+		t.Errorf("expected priceRequest{Night}, got %#v", value)
+	}
+
+	value, err = reg.DecodeRequestData(priceMessage(t, "com.example", "Price", nil))
+	if err != nil || value != nil {
+		t.Errorf("expected no data, got %v, %v", value, err)
+	}
+}
+
+func TestRegistryDecodeConfirmationData(t *testing.T) {
+	t.Parallel()
+
+	reg := newPriceRegistry(t)
+	req := priceMessage(t, "com.example", "Price", nil)
+
+	conf, _ := Confirmation(DataTransferStatusAccepted)
+	data := `{"price":0.25}`
+	conf.Data = &data
+
+	value, err := reg.DecodeConfirmationData(req, conf)
+	if err != nil {
+		t.Fatalf("unexpected error decoding data: %v", err)
+	}
+
+	if resp, ok := value.(priceResponse); !ok || resp.Price != 0.25 {
+		t.Errorf("expected priceResponse{0.25}, got %#v", value)
+	}
+}
+
+func TestRegistryDecodeInvalidData(t *testing.T) {
+	t.Parallel()
+
+	reg := newPriceRegistry(t)
+
+	empty := `{"tariff":""}`
+	if _, err := reg.DecodeRequestData(priceMessage(t, "com.example", "Price", &empty)); !errors.Is(err, errMissingTariff) {
+		t.Errorf("expected errMissingTariff, got %v", err)
+	}
+
+	malformed := `Night`
+
+	_, err := reg.DecodeRequestData(priceMessage(t, "com.example", "Price", &malformed))
+	if err == nil || !strings.Contains(err.Error(), "com.example/Price request data") {
+		t.Errorf("expected an invalid request data error, got %v", err)
+	}
+}
+
+func TestRegistryUnknownExtension(t *testing.T) {
+	t.Parallel()
+
+	reg := newPriceRegistry(t)
+
+	if _, err := reg.DecodeRequestData(priceMessage(t, "org.other", "Price", nil)); !errors.Is(err, ErrUnknownVendorId) {
+		t.Errorf("expected ErrUnknownVendorId, got %v", err)
+	}
+
+	if _, err := reg.DecodeRequestData(priceMessage(t, "com.example", "", nil)); !errors.Is(err, ErrUnknownMessageId) {
+		t.Errorf("expected ErrUnknownMessageId, got %v", err)
+	}
+
+	conf, _ := Confirmation(DataTransferStatusAccepted)
+	if _, err := reg.DecodeConfirmationData(priceMessage(t, "com.example", "Tariff", nil), conf); !errors.Is(err, ErrUnknownMessageId) {
+		t.Errorf("expected ErrUnknownMessageId, got %v", err)
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	t.Parallel()
+
+	reg := newPriceRegistry(t)
+
+	if err := reg.Register(Define[priceRequest, priceResponse]("Com.Example", "PRICE")); !errors.Is(err, ErrDuplicateExtension) {
+		t.Errorf("expected ErrDuplicateExtension, got %v", err)
+	}
+
+	if err := reg.Register(Define[priceRequest, priceResponse]("com.example", "")); err != nil {
+		t.Errorf("expected an extension without messageId to be accepted, got %v", err)
+	}
+
+	invalid := []Extension{
+		{VendorId: "com.example", MessageId: "Manual", decodeRequest: nil, decodeConfirmation: nil},
+		Define[priceRequest, priceResponse]("", "Price"),
+		Define[priceRequest, priceResponse]("com.example", strings.Repeat("m", 51)),
+	}
+
+	for _, ext := range invalid {
+		if err := reg.Register(ext); !errors.Is(err, ErrInvalidExtension) {
+			t.Errorf("%s: expected ErrInvalidExtension, got %v", ext, err)
+		}
+	}
+}
+
+func TestEncodeData(t *testing.T) {
+	t.Parallel()
+
+	data, err := EncodeData(priceRequest{Tariff: "Night"})
+	if err != nil || *data != `{"tariff":"Night"}` {
+		t.Errorf("unexpected EncodeData result: %v, %v", data, err)
+	}
+
+	if _, err := EncodeData(priceRequest{Tariff: ""}); !errors.Is(err, errMissingTariff) {
+		t.Errorf("expected errMissingTariff, got %v", err)
+	}
+}
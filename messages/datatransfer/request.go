@@ -0,0 +1,142 @@
+package datatransfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// RequestMessage represents the OCPP 1.6J DataTransfer.req message.
+//
+// VendorId is required; MessageId and Data are nil when they are not set. Use a
+// Registry to decode Data into a typed value.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.15: DataTransfer.req
+type RequestMessage struct {
+	// VendorId identifies the vendor-specific implementation (CiString[255]). It is
+	// recommended to use a reversed DNS name of the vendor.
+	VendorId types.CiString255Type
+
+	// MessageId identifies the message within the vendor namespace (CiString[50]).
+	MessageId *types.CiString50Type
+
+	// Data is the text to be transferred, in a format agreed upon by the vendor.
+	Data *string
+}
+
+// Request constructs a new RequestMessage for the given vendor.
+//
+// MessageId and Data can be set on the returned message afterwards. It returns an
+// error if the vendorId is empty, too long or not printable ASCII.
+//
+// Example usage:
+//
+//	req, err := datatransfer.Request("com.example")
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(vendorId string) (RequestMessage, error) {
+	vendor, err := types.Required("vendorId", &vendorId, types.CiString255)
+	if err != nil {
+		return RequestMessage{}, fmt.Errorf("failed to create RequestMessage: %w", err)
+	}
+
+	return RequestMessage{
+		VendorId:  vendor,
+		MessageId: nil,
+		Data:      nil,
+	}, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+//
+// Data is not validated, since its format is vendor-specific; see Registry.
+func (r RequestMessage) ValidateAll() error {
+	return types.Join(
+		types.Field("vendorId", r.VendorId),
+		types.OptionalField("messageId", r.MessageId),
+	)
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// Optional fields are only included when set.
+func (r RequestMessage) String() string {
+	fields := []string{"vendorId=" + r.VendorId.String()}
+
+	if r.MessageId != nil {
+		fields = append(fields, "messageId="+r.MessageId.String())
+	}
+
+	if r.Data != nil {
+		fields = append(fields, "data="+*r.Data)
+	}
+
+	return "DataTransfer.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of DataTransfer.req.
+type requestPayload struct {
+	VendorId  *string `json:"vendorId"`
+	MessageId *string `json:"messageId,omitempty"`
+	Data      *string `json:"data,omitempty"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J DataTransfer.req payload.
+//
+// Unset optional fields are omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	vendorId := r.VendorId.String()
+
+	return json.Marshal(requestPayload{
+		VendorId:  &vendorId,
+		MessageId: types.OptionalString(r.MessageId),
+		Data:      r.Data,
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J DataTransfer.req payload into the
+// RequestMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	vendorId, vendorIdErr := types.Required("vendorId", payload.VendorId, types.CiString255)
+	messageId, messageIdErr := types.Optional("messageId", payload.MessageId, types.CiString50)
+
+	if err := types.Join(vendorIdErr, messageIdErr); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{
+		VendorId:  vendorId,
+		MessageId: messageId,
+		Data:      payload.Data,
+	}
+
+	return nil
+}
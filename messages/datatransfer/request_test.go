@@ -0,0 +1,113 @@
+package datatransfer
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestDataTransferRequestValid(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request("com.example")
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	messageId, _ := types.CiString50("Price")
+	data := `{"tariff":"Night"}`
+	req.MessageId = &messageId
+	req.Data = &data
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	want := `DataTransfer.req{vendorId=com.example, messageId=Price, data={"tariff":"Night"}}`
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+}
+
+func TestDataTransferRequestInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Request(""); !errors.Is(err, types.ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+
+	if _, err := Request(strings.Repeat("v", 256)); !errors.Is(err, types.ErrExceedsMaxLength) {
+		t.Errorf("expected ErrExceedsMaxLength, got %v", err)
+	}
+}
+
+func TestDataTransferRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	messageId := types.CiString50Type{}
+	req := RequestMessage{
+		VendorId:  types.CiString255Type{},
+		MessageId: &messageId,
+		Data:      nil,
+	}
+
+	err := req.ValidateAll()
+	for _, path := range []string{"vendorId: ", "messageId: "} {
+		if !strings.Contains(err.Error(), path) {
+			t.Errorf("expected a failure at %s in %v", path, err)
+		}
+	}
+}
+
+func TestDataTransferRequestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"vendorId":"com.example","messageId":"Price","data":"{\"tariff\":\"Night\"}"}`
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatalf("unexpected error unmarshaling request: %v", err)
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestDataTransferRequestMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := json.Marshal(RequestMessage{}); !errors.Is(err, types.ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
+
+func TestDataTransferRequestUnmarshalJSONReportsAllFailures(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"messageId":"`+strings.Repeat("m", 51)+`"}`), &req)
+	for _, sentinel := range []error{types.ErrMissingRequiredField, types.ErrExceedsMaxLength} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+}
+
+func TestDataTransferRequestUnmarshalJSONMalformed(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"vendorId":"com.example","data":{"tariff":"Night"}}`), &req); err == nil {
+		t.Error("expected error for non-string data, got nil")
+	}
+}
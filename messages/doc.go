@@ -0,0 +1,11 @@
+// Package messages groups the OCPP 1.6J message payloads, one subpackage per action.
+//
+// Each subpackage, such as messages/authorize, provides a RequestMessage and a
+// ConfirmationMessage with constructors, validation and JSON encoding. Packages
+// without hand-written code are generated from the official JSON schemas by
+// cmd/ocppgen; regenerate them with:
+//
+//	go generate ./messages
+package messages
+
+//go:generate go run ../cmd/ocppgen -schemas ../schemas/json -out .
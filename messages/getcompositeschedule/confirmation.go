@@ -0,0 +1,175 @@
+package getcompositeschedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConfirmationMessage represents the OCPP 1.6J GetCompositeSchedule.conf message.
+//
+// ConnectorId, ScheduleStart and ChargingSchedule are nil when they are not set.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.22: GetCompositeSchedule.conf
+type ConfirmationMessage struct {
+	// Status tells whether the Charge Point calculated the composite schedule.
+	Status GetCompositeScheduleStatus
+
+	// ConnectorId is the connector the schedule applies to.
+	ConnectorId *int
+
+	// ScheduleStart is the point in time the periods of ChargingSchedule are
+	// relative to.
+	ScheduleStart *types.DateTimeType
+
+	// ChargingSchedule is the composite schedule: the planned consumption over
+	// time, relative to ScheduleStart.
+	ChargingSchedule *types.ChargingScheduleType
+}
+
+// Confirmation constructs a new ConfirmationMessage with the given status and no
+// schedule.
+//
+// ConnectorId, ScheduleStart and ChargingSchedule can be set on the returned
+// message afterwards. It returns an error if the status is not a valid
+// GetCompositeScheduleStatus.
+func Confirmation(status GetCompositeScheduleStatus) (ConfirmationMessage, error) {
+	conf := ConfirmationMessage{
+		Status:           status,
+		ConnectorId:      nil,
+		ScheduleStart:    nil,
+		ChargingSchedule: nil,
+	}
+
+	if err := conf.Validate(); err != nil {
+		return ConfirmationMessage{}, err
+	}
+
+	return conf, nil
+}
+
+// Validate performs a revalidation of the ConfirmationMessage fields and returns
+// the first failure.
+func (m ConfirmationMessage) Validate() error {
+	if err := types.FirstError(m.ValidateAll()); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the ConfirmationMessage and returns all
+// failures joined with errors.Join, each as a *types.ValidationError with its JSON
+// path.
+func (m ConfirmationMessage) ValidateAll() error {
+	var errs []error
+
+	if !m.Status.IsValid() {
+		errs = append(errs, types.EnumError("status", m.Status, ErrInvalidGetCompositeScheduleStatus))
+	}
+
+	if m.ConnectorId != nil {
+		errs = append(errs, connectorIdError(*m.ConnectorId))
+	}
+
+	errs = append(errs,
+		types.OptionalField("scheduleStart", m.ScheduleStart),
+		types.OptionalField("chargingSchedule", m.ChargingSchedule),
+	)
+
+	return types.Join(errs...)
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+//
+// Optional fields are only included when set.
+func (m ConfirmationMessage) String() string {
+	fields := []string{"status=" + m.Status.String()}
+
+	if m.ConnectorId != nil {
+		fields = append(fields, "connectorId="+strconv.Itoa(*m.ConnectorId))
+	}
+
+	if m.ScheduleStart != nil {
+		fields = append(fields, "scheduleStart="+m.ScheduleStart.String())
+	}
+
+	if m.ChargingSchedule != nil {
+		fields = append(fields, "chargingSchedule="+m.ChargingSchedule.String())
+	}
+
+	return "GetCompositeSchedule.conf{" + strings.Join(fields, ", ") + "}"
+}
+
+// confirmationPayload is the OCPP 1.6J wire representation of
+// GetCompositeSchedule.conf.
+type confirmationPayload struct {
+	Status           *string         `json:"status"`
+	ConnectorId      *int            `json:"connectorId,omitempty"`
+	ScheduleStart    *string         `json:"scheduleStart,omitempty"`
+	ChargingSchedule json.RawMessage `json:"chargingSchedule,omitempty"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J
+// GetCompositeSchedule.conf payload.
+//
+// Unset optional fields are omitted. The message is validated first, so an invalid
+// ConfirmationMessage is never encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	status := m.Status.String()
+
+	schedule, err := types.EncodeOptional(m.ChargingSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(confirmationPayload{
+		Status:           &status,
+		ConnectorId:      m.ConnectorId,
+		ScheduleStart:    types.OptionalString(m.ScheduleStart),
+		ChargingSchedule: schedule,
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J GetCompositeSchedule.conf payload into the
+// ConfirmationMessage.
+//
+// Every field, including the order of the schedule periods, is validated while
+// decoding and all failures are reported together, so a successfully decoded
+// ConfirmationMessage is always valid.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	var connectorIdErr error
+	if payload.ConnectorId != nil {
+		connectorIdErr = connectorIdError(*payload.ConnectorId)
+	}
+
+	status, statusErr := types.Required("status", payload.Status, ParseGetCompositeScheduleStatus)
+	scheduleStart, scheduleStartErr := types.Optional("scheduleStart", payload.ScheduleStart, types.ParseDateTime)
+	schedule, scheduleErr := types.DecodeOptional[types.ChargingScheduleType]("chargingSchedule", payload.ChargingSchedule)
+
+	if err := types.Join(statusErr, connectorIdErr, scheduleStartErr, scheduleErr); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	*m = ConfirmationMessage{
+		Status:           status,
+		ConnectorId:      payload.ConnectorId,
+		ScheduleStart:    scheduleStart,
+		ChargingSchedule: schedule,
+	}
+
+	return nil
+}
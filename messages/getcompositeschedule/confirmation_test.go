@@ -0,0 +1,90 @@
+package getcompositeschedule
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestGetCompositeScheduleConfirmation(t *testing.T) {
+	t.Parallel()
+
+	conf, err := Confirmation(GetCompositeScheduleStatusAccepted)
+	if err != nil {
+		t.Fatalf("unexpected error creating confirmation: %v", err)
+	}
+
+	connectorId := 1
+	start, _ := types.DateTime(time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC))
+	first, _ := types.ChargingSchedulePeriod(0, 16)
+	second, _ := types.ChargingSchedulePeriod(1800, 10)
+	schedule, _ := types.ChargingSchedule(types.ChargingRateUnitTypeA, first, second)
+
+	conf.ConnectorId = &connectorId
+	conf.ScheduleStart = &start
+	conf.ChargingSchedule = &schedule
+
+	want := "GetCompositeSchedule.conf{status=Accepted, connectorId=1, scheduleStart=2025-01-02T03:00:00.000Z, " +
+		"chargingSchedule={chargingRateUnit=A, chargingSchedulePeriod=[{startPeriod=0, limit=16}, " +
+		"{startPeriod=1800, limit=10}]}}"
+	if conf.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, conf.String())
+	}
+
+	data := `{"status":"Accepted","connectorId":1,"scheduleStart":"2025-01-02T03:00:00.000Z",` +
+		`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16},` +
+		`{"startPeriod":1800,"limit":10}]}}`
+
+	out, err := json.Marshal(conf)
+	if err != nil || string(out) != data {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s, %v", data, out, err)
+	}
+
+	var decoded ConfirmationMessage
+	if err := json.Unmarshal([]byte(data), &decoded); err != nil || decoded.String() != want {
+		t.Errorf("unexpected decoded confirmation: %v, %v", decoded, err)
+	}
+}
+
+func TestGetCompositeScheduleConfirmationInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Confirmation("Unknown"); !errors.Is(err, ErrInvalidGetCompositeScheduleStatus) {
+		t.Errorf("expected ErrInvalidGetCompositeScheduleStatus, got %v", err)
+	}
+
+	connectorId := -1
+	conf := ConfirmationMessage{Status: "", ConnectorId: &connectorId, ScheduleStart: nil, ChargingSchedule: nil}
+
+	err := conf.ValidateAll()
+	if !errors.Is(err, ErrInvalidGetCompositeScheduleStatus) || !errors.Is(err, ErrInvalidConnectorId) {
+		t.Errorf("expected status and connectorId failures, got %v", err)
+	}
+
+	tests := []struct {
+		data string
+		path string
+	}{
+		{`{}`, "status"},
+		{`{"status":"Rejected","connectorId":-1}`, "connectorId"},
+		{`{"status":"Accepted","scheduleStart":"yesterday"}`, "scheduleStart"},
+		{`{"status":"Accepted","chargingSchedule":{"chargingRateUnit":"A",` +
+			`"chargingSchedulePeriod":[{"startPeriod":60,"limit":16}]}}`, "chargingSchedule.chargingSchedulePeriod[0].startPeriod"},
+	}
+
+	for _, tc := range tests {
+		err := json.Unmarshal([]byte(tc.data), &conf)
+
+		var verr *types.ValidationError
+		if !errors.As(err, &verr) || verr.Path != tc.path {
+			t.Errorf("%s: expected failure at %s, got %v", tc.data, tc.path, err)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`[]`), &conf); err == nil {
+		t.Error("expected error for malformed confirmation, got nil")
+	}
+}
@@ -0,0 +1,23 @@
+// Package getcompositeschedule models the OCPP 1.6J GetCompositeSchedule message
+// pair.
+//
+// A Central System sends a GetCompositeSchedule.req to learn the schedule a
+// connector, or the Charge Point as a whole when connectorId is 0, is going to
+// follow during the requested duration. The Charge Point calculates it from every
+// installed charging profile and local limit.
+//
+// The Charge Point answers with a GetCompositeSchedule.conf. When the status is
+// Accepted, it holds the composite charging schedule, relative to scheduleStart;
+// when it is Rejected, the schedule may be absent.
+//
+// Connector ids and durations are never negative, so the messages are validated
+// beyond the JSON schema: connectorId and duration must be 0 or greater.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.7: Get Composite Schedule
+//   - OCPP 1.6J, Section 6.21 / 6.22: GetCompositeSchedule.req / GetCompositeSchedule.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/getcompositeschedule"
+package getcompositeschedule
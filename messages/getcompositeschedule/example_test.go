@@ -0,0 +1,43 @@
+package getcompositeschedule_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/getcompositeschedule"
+)
+
+func ExampleRequest() {
+	req, err := getcompositeschedule.Request(1, 3600)
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"connectorId":1,"duration":3600}
+}
+
+func ExampleConfirmationMessage_UnmarshalJSON() {
+	data := `{"status":"Accepted","connectorId":1,"scheduleStart":"2025-01-02T03:00:00Z",` +
+		`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16},` +
+		`{"startPeriod":1800,"limit":10}]}}`
+
+	var conf getcompositeschedule.ConfirmationMessage
+	if err := json.Unmarshal([]byte(data), &conf); err != nil {
+		log.Fatalf("failed to decode confirmation: %v", err)
+	}
+
+	for _, period := range conf.ChargingSchedule.ChargingSchedulePeriod {
+		fmt.Printf("+%ds: %g %s\n", period.StartPeriod, period.Limit, conf.ChargingSchedule.ChargingRateUnit)
+	}
+	// Output:
+	// +0s: 16 A
+	// +1800s: 10 A
+}
@@ -0,0 +1,86 @@
+package getcompositeschedule
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidGetCompositeScheduleStatus indicates that a
+// GetCompositeScheduleStatus is not one of the values defined by OCPP 1.6J.
+var ErrInvalidGetCompositeScheduleStatus = errors.New("invalid get composite schedule status")
+
+// GetCompositeScheduleStatus is the outcome of a GetCompositeSchedule.req
+// reported by the Charge Point.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.26: GetCompositeScheduleStatus
+type GetCompositeScheduleStatus string
+
+const (
+	// GetCompositeScheduleStatusAccepted means the Charge Point calculated the
+	// composite schedule.
+	GetCompositeScheduleStatusAccepted GetCompositeScheduleStatus = "Accepted"
+
+	// GetCompositeScheduleStatusRejected means the Charge Point could not
+	// calculate the composite schedule, for example because the connector is
+	// unknown.
+	GetCompositeScheduleStatusRejected GetCompositeScheduleStatus = "Rejected"
+)
+
+// IsValid returns true if the GetCompositeScheduleStatus is one of the values
+// defined by OCPP 1.6J.
+func (s GetCompositeScheduleStatus) IsValid() bool {
+	switch s {
+	case GetCompositeScheduleStatusAccepted, GetCompositeScheduleStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the GetCompositeScheduleStatus.
+func (s GetCompositeScheduleStatus) String() string {
+	return string(s)
+}
+
+// ParseGetCompositeScheduleStatus converts a wire value into a GetCompositeScheduleStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidGetCompositeScheduleStatus.
+func ParseGetCompositeScheduleStatus(value string) (GetCompositeScheduleStatus, error) {
+	return types.ParseEnum[GetCompositeScheduleStatus](value, ErrInvalidGetCompositeScheduleStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidGetCompositeScheduleStatus if the value is not recognized.
+func (s GetCompositeScheduleStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(s, ErrInvalidGetCompositeScheduleStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidGetCompositeScheduleStatus if the input is not recognized.
+func (s *GetCompositeScheduleStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseGetCompositeScheduleStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (s GetCompositeScheduleStatus) MarshalJSON() ([]byte, error) {
+	text, err := s.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (s *GetCompositeScheduleStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, s, ParseGetCompositeScheduleStatus)
+}
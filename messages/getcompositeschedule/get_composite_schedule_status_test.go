@@ -0,0 +1,20 @@
+package getcompositeschedule
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestGetCompositeScheduleStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[GetCompositeScheduleStatus]{
+		Values: []GetCompositeScheduleStatus{
+			GetCompositeScheduleStatusAccepted, GetCompositeScheduleStatusRejected,
+		},
+		Invalid:  []string{"", "accepted", "Unknown"},
+		Sentinel: ErrInvalidGetCompositeScheduleStatus,
+		Parse:    ParseGetCompositeScheduleStatus,
+	})
+}
@@ -0,0 +1,164 @@
+package getcompositeschedule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidConnectorId indicates that the connectorId of a GetCompositeSchedule
+// message is negative.
+var ErrInvalidConnectorId = errors.New("invalid connectorId")
+
+// RequestMessage represents the OCPP 1.6J GetCompositeSchedule.req message.
+//
+// ChargingRateUnit is nil when it is not set.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.21: GetCompositeSchedule.req
+type RequestMessage struct {
+	// ConnectorId is the connector whose schedule is requested, or 0 for the grid
+	// connection of the Charge Point as a whole.
+	ConnectorId int
+
+	// Duration is the length of the requested schedule in seconds.
+	Duration int
+
+	// ChargingRateUnit is the unit in which the schedule is requested. When nil, the
+	// Charge Point chooses the unit.
+	ChargingRateUnit *types.ChargingRateUnitType
+}
+
+// Request constructs a new RequestMessage for the given connector and duration,
+// without a charging rate unit.
+//
+// ChargingRateUnit can be set on the returned message afterwards. It returns an
+// error if connectorId or duration is negative.
+//
+// Example usage:
+//
+//	req, err := getcompositeschedule.Request(1, 3600)
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(connectorId, duration int) (RequestMessage, error) {
+	req := RequestMessage{ConnectorId: connectorId, Duration: duration, ChargingRateUnit: nil}
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	errs := []error{connectorIdError(r.ConnectorId), durationError(r.Duration)}
+
+	if r.ChargingRateUnit != nil && !r.ChargingRateUnit.IsValid() {
+		errs = append(errs, types.EnumError("chargingRateUnit", *r.ChargingRateUnit, types.ErrInvalidChargingRateUnitType))
+	}
+
+	return types.Join(errs...)
+}
+
+// connectorIdError reports a negative connectorId.
+func connectorIdError(connectorId int) error {
+	if connectorId < 0 {
+		return types.MinimumError("connectorId", connectorId, 0, ErrInvalidConnectorId)
+	}
+
+	return nil
+}
+
+// durationError reports a negative duration.
+func durationError(duration int) error {
+	if duration < 0 {
+		return types.MinimumError("duration", duration, 0, types.ErrInvalidDuration)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// Optional fields are only included when set.
+func (r RequestMessage) String() string {
+	fields := []string{"connectorId=" + strconv.Itoa(r.ConnectorId), "duration=" + strconv.Itoa(r.Duration)}
+
+	if r.ChargingRateUnit != nil {
+		fields = append(fields, "chargingRateUnit="+r.ChargingRateUnit.String())
+	}
+
+	return "GetCompositeSchedule.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of GetCompositeSchedule.req.
+type requestPayload struct {
+	ConnectorId      *int    `json:"connectorId"`
+	Duration         *int    `json:"duration"`
+	ChargingRateUnit *string `json:"chargingRateUnit,omitempty"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J GetCompositeSchedule.req
+// payload.
+//
+// An unset ChargingRateUnit is omitted. The message is validated first, so an
+// invalid RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(requestPayload{
+		ConnectorId:      &r.ConnectorId,
+		Duration:         &r.Duration,
+		ChargingRateUnit: types.OptionalString(r.ChargingRateUnit),
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J GetCompositeSchedule.req payload into the
+// RequestMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	connectorId, connectorIdErr := types.RequiredValue("connectorId", payload.ConnectorId)
+	duration, durationErr := types.RequiredValue("duration", payload.Duration)
+	unit, unitErr := types.Optional("chargingRateUnit", payload.ChargingRateUnit, types.ParseChargingRateUnitType)
+
+	if connectorIdErr == nil {
+		connectorIdErr = connectorIdError(connectorId)
+	}
+
+	if durationErr == nil {
+		durationErr = durationError(duration)
+	}
+
+	if err := types.Join(connectorIdErr, durationErr, unitErr); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{ConnectorId: connectorId, Duration: duration, ChargingRateUnit: unit}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package getcompositeschedule
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestGetCompositeScheduleRequestValid(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request(1, 3600)
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	unit := types.ChargingRateUnitTypeW
+	req.ChargingRateUnit = &unit
+
+	if req.String() != "GetCompositeSchedule.req{connectorId=1, duration=3600, chargingRateUnit=W}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil || string(out) != `{"connectorId":1,"duration":3600,"chargingRateUnit":"W"}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+}
+
+func TestGetCompositeScheduleRequestInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Request(-1, 3600); !errors.Is(err, ErrInvalidConnectorId) {
+		t.Errorf("expected ErrInvalidConnectorId, got %v", err)
+	}
+
+	if _, err := Request(0, -1); !errors.Is(err, types.ErrInvalidDuration) {
+		t.Errorf("expected ErrInvalidDuration, got %v", err)
+	}
+
+	unit := types.ChargingRateUnitType("kW")
+	req := RequestMessage{ConnectorId: -1, Duration: -1, ChargingRateUnit: &unit}
+
+	err := req.ValidateAll()
+	if !errors.Is(err, ErrInvalidConnectorId) || !errors.Is(err, types.ErrInvalidDuration) ||
+		!errors.Is(err, types.ErrInvalidChargingRateUnitType) {
+		t.Errorf("expected connectorId, duration and unit failures, got %v", err)
+	}
+}
+
+func TestGetCompositeScheduleRequestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"connectorId":0,"duration":86400}`), &req); err != nil ||
+		req.ConnectorId != 0 || req.Duration != 86400 || req.ChargingRateUnit != nil {
+		t.Errorf("unexpected request: %v, %v", req, err)
+	}
+
+	tests := []struct {
+		data string
+		path string
+	}{
+		{`{"duration":60}`, "connectorId"},
+		{`{"connectorId":1}`, "duration"},
+		{`{"connectorId":-1,"duration":60}`, "connectorId"},
+		{`{"connectorId":1,"duration":-60}`, "duration"},
+		{`{"connectorId":1,"duration":60,"chargingRateUnit":"kW"}`, "chargingRateUnit"},
+	}
+
+	for _, tc := range tests {
+		err := json.Unmarshal([]byte(tc.data), &req)
+
+		var verr *types.ValidationError
+		if !errors.As(err, &verr) || verr.Path != tc.path {
+			t.Errorf("%s: expected failure at %s, got %v", tc.data, tc.path, err)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`[]`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
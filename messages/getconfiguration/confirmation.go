@@ -0,0 +1,136 @@
+package getconfiguration
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConfirmationMessage represents the OCPP 1.6J GetConfiguration.conf message.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.24: GetConfiguration.conf
+type ConfirmationMessage struct {
+	// ConfigurationKey holds the requested keys known by the Charge Point, with
+	// their values.
+	ConfigurationKey []types.KeyValueType
+
+	// UnknownKey lists the requested keys that the Charge Point does not know.
+	UnknownKey []types.CiString50Type
+}
+
+// Confirmation constructs a new ConfirmationMessage reporting configurationKey and
+// the names of the unknown keys.
+//
+// It returns an error if a KeyValue is invalid or an unknown key is not a valid
+// CiString50.
+//
+// Example usage:
+//
+//	interval, _ := types.KeyValue("HeartbeatInterval", false)
+//	conf, err := getconfiguration.Confirmation([]types.KeyValueType{interval}, []string{"Foo"})
+//	if err != nil {
+//	    log.Fatalf("invalid confirmation: %v", err)
+//	}
+func Confirmation(configurationKey []types.KeyValueType, unknownKey []string) (ConfirmationMessage, error) {
+	ciUnknownKey, err := ciString50List("unknownKey", unknownKey)
+	if err != nil {
+		return ConfirmationMessage{}, fmt.Errorf("ConfirmationMessage validation failed: %w", types.FirstError(err))
+	}
+
+	conf := ConfirmationMessage{ConfigurationKey: configurationKey, UnknownKey: ciUnknownKey}
+	if err := conf.Validate(); err != nil {
+		return ConfirmationMessage{}, err
+	}
+
+	return conf, nil
+}
+
+// Validate performs internal validation on the ConfirmationMessage and returns the
+// first failure.
+func (m ConfirmationMessage) Validate() error {
+	if err := types.FirstError(m.ValidateAll()); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every entry of the ConfirmationMessage and returns all
+// failures joined with errors.Join, each as a *types.ValidationError with its JSON
+// path, for example "configurationKey[0].value".
+func (m ConfirmationMessage) ValidateAll() error {
+	return types.Join(types.ListField("configurationKey", m.ConfigurationKey), types.ListField("unknownKey", m.UnknownKey))
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+//
+// Lists are only included when set.
+func (m ConfirmationMessage) String() string {
+	fields := make([]string, 0, 2)
+
+	if m.ConfigurationKey != nil {
+		fields = append(fields, "configurationKey="+types.FormatList(m.ConfigurationKey))
+	}
+
+	if m.UnknownKey != nil {
+		fields = append(fields, "unknownKey="+types.FormatList(m.UnknownKey))
+	}
+
+	return "GetConfiguration.conf{" + strings.Join(fields, ", ") + "}"
+}
+
+// confirmationPayload is the OCPP 1.6J wire representation of
+// GetConfiguration.conf.
+type confirmationPayload struct {
+	ConfigurationKey []json.RawMessage `json:"configurationKey,omitempty"`
+	UnknownKey       []json.RawMessage `json:"unknownKey,omitempty"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J GetConfiguration.conf
+// payload.
+//
+// Empty lists are omitted. The message is validated first, so an invalid
+// ConfirmationMessage is never encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	configurationKey, err := types.EncodeList(m.ConfigurationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	unknownKey, err := types.EncodeList(m.UnknownKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(confirmationPayload{ConfigurationKey: configurationKey, UnknownKey: unknownKey})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J GetConfiguration.conf payload into the
+// ConfirmationMessage.
+//
+// Every entry is validated while decoding and all failures are reported together,
+// so a successfully decoded ConfirmationMessage is always valid.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	configurationKey, configurationKeyErr := types.DecodeList[types.KeyValueType]("configurationKey", payload.ConfigurationKey)
+	unknownKey, unknownKeyErr := types.DecodeList[types.CiString50Type]("unknownKey", payload.UnknownKey)
+
+	if err := types.Join(configurationKeyErr, unknownKeyErr); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	*m = ConfirmationMessage{ConfigurationKey: configurationKey, UnknownKey: unknownKey}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package getconfiguration
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestGetConfigurationConfirmation(t *testing.T) {
+	t.Parallel()
+
+	interval, _ := types.KeyValue("HeartbeatInterval", false)
+	value, _ := types.CiString500("300")
+	interval.Value = &value
+
+	conf, err := Confirmation([]types.KeyValueType{interval}, []string{"Foo"})
+	if err != nil {
+		t.Fatalf("unexpected error creating confirmation: %v", err)
+	}
+
+	want := "GetConfiguration.conf{configurationKey=[{key=HeartbeatInterval, readonly=false, value=300}], unknownKey=[Foo]}"
+	if conf.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, conf.String())
+	}
+
+	out, err := json.Marshal(conf)
+	if err != nil || string(out) != `{"configurationKey":[{"key":"HeartbeatInterval","readonly":false,"value":"300"}],"unknownKey":["Foo"]}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	empty, err := Confirmation(nil, nil)
+	if err != nil || empty.String() != "GetConfiguration.conf{}" {
+		t.Errorf("unexpected empty confirmation: %v, %v", empty, err)
+	}
+
+	var verr *types.ValidationError
+	if _, err := Confirmation(nil, []string{"Foo", ""}); !errors.As(err, &verr) || verr.Path != "unknownKey[1]" {
+		t.Errorf("expected empty key at unknownKey[1], got %v", err)
+	}
+
+	invalid := types.KeyValueType{Key: types.CiString50Type{}, Readonly: true, Value: nil}
+	if _, err := Confirmation([]types.KeyValueType{interval, invalid}, nil); !errors.As(err, &verr) ||
+		verr.Path != "configurationKey[1].key" {
+		t.Errorf("expected empty key at configurationKey[1].key, got %v", err)
+	}
+}
+
+func TestGetConfigurationConfirmationUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var conf ConfirmationMessage
+
+	data := `{"configurationKey":[{"key":"NumberOfConnectors","readonly":true,"value":"2"},` +
+		`{"key":"AuthorizationKey","readonly":false}],"unknownKey":["Foo"]}`
+	if err := json.Unmarshal([]byte(data), &conf); err != nil || len(conf.ConfigurationKey) != 2 || len(conf.UnknownKey) != 1 {
+		t.Errorf("unexpected confirmation: %v, %v", conf, err)
+	}
+
+	data = `{"configurationKey":[{"key":"A","readonly":true},{"key":"B","value":"` + strings.Repeat("1", 501) + `"}]}`
+
+	err := json.Unmarshal([]byte(data), &conf)
+	if !errors.Is(err, types.ErrMissingRequiredField) || !errors.Is(err, types.ErrExceedsMaxLength) {
+		t.Errorf("expected missing readonly and too long value, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"unknownKey":[1]}`), &conf); err == nil {
+		t.Error("expected error for malformed unknownKey, got nil")
+	}
+
+	if err := json.Unmarshal([]byte(`[]`), &conf); err == nil {
+		t.Error("expected error for malformed confirmation, got nil")
+	}
+}
@@ -0,0 +1,18 @@
+// Package getconfiguration models the OCPP 1.6J GetConfiguration message pair.
+//
+// A Central System sends a GetConfiguration.req to read configuration keys of a
+// Charge Point. The request lists the keys of interest; without keys the Charge
+// Point reports its whole configuration.
+//
+// The Charge Point answers with a GetConfiguration.conf holding a KeyValue entry,
+// with key, readonly flag and value, for every requested key it knows, and the
+// names of the requested keys it does not know in unknownKey.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.8: Get Configuration
+//   - OCPP 1.6J, Section 6.23 / 6.24: GetConfiguration.req / GetConfiguration.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/getconfiguration"
+package getconfiguration
@@ -0,0 +1,27 @@
+package getconfiguration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/getconfiguration"
+)
+
+func ExampleConfirmationMessage_UnmarshalJSON() {
+	data := `{"configurationKey":[{"key":"HeartbeatInterval","readonly":false,"value":"300"}],"unknownKey":["Foo"]}`
+
+	var conf getconfiguration.ConfirmationMessage
+	if err := json.Unmarshal([]byte(data), &conf); err != nil {
+		log.Fatalf("failed to decode confirmation: %v", err)
+	}
+
+	for _, kv := range conf.ConfigurationKey {
+		fmt.Printf("%s = %s\n", kv.Key, kv.Value)
+	}
+
+	fmt.Println("unknown:", conf.UnknownKey)
+	// Output:
+	// HeartbeatInterval = 300
+	// unknown: [Foo]
+}
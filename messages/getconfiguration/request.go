@@ -0,0 +1,133 @@
+package getconfiguration
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// RequestMessage represents the OCPP 1.6J GetConfiguration.req message.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.23: GetConfiguration.req
+type RequestMessage struct {
+	// Key lists the configuration keys to report. It is nil to request the whole
+	// configuration.
+	Key []types.CiString50Type
+}
+
+// Request constructs a new RequestMessage for the given configuration keys. Without
+// keys the Charge Point is asked for its whole configuration.
+//
+// It returns an error if a key is not a valid CiString50.
+//
+// Example usage:
+//
+//	req, err := getconfiguration.Request("HeartbeatInterval", "NumberOfConnectors")
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(keys ...string) (RequestMessage, error) {
+	ciKeys, err := ciString50List("key", keys)
+	if err != nil {
+		return RequestMessage{}, fmt.Errorf("failed to create RequestMessage: %w", err)
+	}
+
+	return RequestMessage{Key: ciKeys}, nil
+}
+
+// ciString50List converts values into CiString50 values, reporting every invalid
+// value at its element path under path. It returns nil for no values.
+func ciString50List(path string, values []string) ([]types.CiString50Type, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	list := make([]types.CiString50Type, 0, len(values))
+	errs := make([]error, 0, len(values))
+
+	for i, value := range values {
+		ciValue, err := types.Required(types.ElementPath(path, i), &value, types.CiString50)
+		list = append(list, ciValue)
+		errs = append(errs, err)
+	}
+
+	if err := types.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every key of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path, for
+// example "key[1]".
+func (r RequestMessage) ValidateAll() error {
+	return types.ListField("key", r.Key)
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// Key is only included when set.
+func (r RequestMessage) String() string {
+	if r.Key == nil {
+		return "GetConfiguration.req{}"
+	}
+
+	return "GetConfiguration.req{key=" + types.FormatList(r.Key) + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of GetConfiguration.req.
+type requestPayload struct {
+	Key []json.RawMessage `json:"key,omitempty"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J GetConfiguration.req
+// payload.
+//
+// An empty key list is omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	key, err := types.EncodeList(r.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(requestPayload{Key: key})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J GetConfiguration.req payload into the
+// RequestMessage.
+//
+// Every key is validated while decoding and all failures are reported together, so
+// a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	key, err := types.DecodeList[types.CiString50Type]("key", payload.Key)
+	if err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{Key: key}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package getconfiguration
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestGetConfigurationRequest(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request("HeartbeatInterval", "NumberOfConnectors")
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	if req.String() != "GetConfiguration.req{key=[HeartbeatInterval, NumberOfConnectors]}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil || string(out) != `{"key":["HeartbeatInterval","NumberOfConnectors"]}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	all, err := Request()
+	if err != nil || all.Key != nil || all.String() != "GetConfiguration.req{}" {
+		t.Errorf("unexpected request for the whole configuration: %v, %v", all, err)
+	}
+
+	if out, err := json.Marshal(all); err != nil || string(out) != `{}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	_, err = Request("HeartbeatInterval", strings.Repeat("K", 51))
+
+	var verr *types.ValidationError
+	if !errors.Is(err, types.ErrExceedsMaxLength) || !errors.As(err, &verr) || verr.Path != "key[1]" {
+		t.Errorf("expected too long key at key[1], got %v", err)
+	}
+
+	if _, err := json.Marshal(RequestMessage{Key: []types.CiString50Type{{}}}); err == nil {
+		t.Error("expected error marshaling invalid request, got nil")
+	}
+}
+
+func TestGetConfigurationRequestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"key":["HeartbeatInterval"]}`), &req); err != nil || len(req.Key) != 1 {
+		t.Errorf("unexpected request: %v, %v", req, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &req); err != nil || req.Key != nil {
+		t.Errorf("unexpected request: %v, %v", req, err)
+	}
+
+	var verr *types.ValidationError
+	if err := json.Unmarshal([]byte(`{"key":["A",""]}`), &req); !errors.As(err, &verr) || verr.Path != "key[1]" {
+		t.Errorf("expected empty key at key[1], got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"key":"HeartbeatInterval"}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
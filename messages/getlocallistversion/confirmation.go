@@ -0,0 +1,117 @@
+package getlocallistversion
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+const (
+	// ListVersionEmpty is the listVersion reported when the Local Authorization
+	// List is empty.
+	ListVersionEmpty = 0
+
+	// ListVersionNotSupported is the listVersion reported when the Charge Point
+	// does not support a Local Authorization List.
+	ListVersionNotSupported = -1
+)
+
+// ErrInvalidListVersion indicates that the listVersion of a GetLocalListVersion.conf
+// is below ListVersionNotSupported.
+var ErrInvalidListVersion = errors.New("invalid listVersion")
+
+// ConfirmationMessage represents the OCPP 1.6J GetLocalListVersion.conf message.
+//
+// This message is returned by the Charge Point in response to a
+// GetLocalListVersion.req.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.28: GetLocalListVersion.conf
+type ConfirmationMessage struct {
+	// ListVersion is the version of the Local Authorization List, ListVersionEmpty
+	// or ListVersionNotSupported.
+	ListVersion int
+}
+
+// Confirmation constructs a new ConfirmationMessage.
+//
+// It returns an error if listVersion is below ListVersionNotSupported.
+func Confirmation(listVersion int) (ConfirmationMessage, error) {
+	conf := ConfirmationMessage{ListVersion: listVersion}
+	if err := conf.Validate(); err != nil {
+		return ConfirmationMessage{}, err
+	}
+
+	return conf, nil
+}
+
+// Validate performs internal validation on the ConfirmationMessage and returns the
+// first failure.
+func (m ConfirmationMessage) Validate() error {
+	if err := types.FirstError(m.ValidateAll()); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the ConfirmationMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (m ConfirmationMessage) ValidateAll() error {
+	if m.ListVersion < ListVersionNotSupported {
+		return types.MinimumError("listVersion", m.ListVersion, ListVersionNotSupported, ErrInvalidListVersion)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+func (m ConfirmationMessage) String() string {
+	return "GetLocalListVersion.conf{listVersion=" + strconv.Itoa(m.ListVersion) + "}"
+}
+
+// confirmationPayload is the OCPP 1.6J wire representation of
+// GetLocalListVersion.conf.
+type confirmationPayload struct {
+	ListVersion *int `json:"listVersion"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J
+// GetLocalListVersion.conf payload. The message is validated first, so an invalid
+// ConfirmationMessage is never encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(confirmationPayload{ListVersion: &m.ListVersion})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J GetLocalListVersion.conf payload into the
+// ConfirmationMessage.
+//
+// The listVersion is validated while decoding, so a successfully decoded
+// ConfirmationMessage is always valid.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	listVersion, err := types.RequiredValue("listVersion", payload.ListVersion)
+	if err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	conf := ConfirmationMessage{ListVersion: listVersion}
+	if err := conf.Validate(); err != nil {
+		return err
+	}
+
+	*m = conf
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package getlocallistversion
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestGetLocalListVersionConfirmationValid(t *testing.T) {
+	t.Parallel()
+
+	for _, listVersion := range []int{ListVersionNotSupported, ListVersionEmpty, 7} {
+		conf, err := Confirmation(listVersion)
+		if err != nil {
+			t.Fatalf("listVersion %d: unexpected error constructing ConfirmationMessage: %v", listVersion, err)
+		}
+
+		if conf.ListVersion != listVersion {
+			t.Errorf("expected listVersion %d, got %d", listVersion, conf.ListVersion)
+		}
+	}
+
+	conf, _ := Confirmation(7)
+	if conf.String() != "GetLocalListVersion.conf{listVersion=7}" {
+		t.Errorf("unexpected String() output: %s", conf.String())
+	}
+}
+
+func TestGetLocalListVersionConfirmationInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Confirmation(-2); !errors.Is(err, ErrInvalidListVersion) {
+		t.Errorf("expected ErrInvalidListVersion, got %v", err)
+	}
+
+	var verr *types.ValidationError
+	if err := (ConfirmationMessage{ListVersion: -2}).Validate(); !errors.As(err, &verr) || verr.Path != "listVersion" {
+		t.Errorf("expected failure at listVersion, got %v", err)
+	}
+
+	if _, err := json.Marshal(ConfirmationMessage{ListVersion: -2}); !errors.Is(err, ErrInvalidListVersion) {
+		t.Errorf("expected ErrInvalidListVersion, got %v", err)
+	}
+}
+
+func TestGetLocalListVersionConfirmationJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{"listVersion":-1}`, `{"listVersion":0}`, `{"listVersion":12}`} {
+		var conf ConfirmationMessage
+		if err := json.Unmarshal([]byte(data), &conf); err != nil {
+			t.Fatalf("%s: unexpected error unmarshaling confirmation: %v", data, err)
+		}
+
+		out, err := json.Marshal(conf)
+		if err != nil || string(out) != data {
+			t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s, %v", data, out, err)
+		}
+	}
+
+	var conf ConfirmationMessage
+
+	if err := json.Unmarshal([]byte(`{}`), &conf); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"listVersion":-2}`), &conf); !errors.Is(err, ErrInvalidListVersion) {
+		t.Errorf("expected ErrInvalidListVersion, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"listVersion":"1"}`), &conf); err == nil {
+		t.Error("expected error for non-integer listVersion, got nil")
+	}
+}
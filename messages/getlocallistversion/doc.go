@@ -0,0 +1,19 @@
+// Package getlocallistversion models the OCPP 1.6J GetLocalListVersion message pair.
+//
+// A Central System sends a GetLocalListVersion.req to ask a Charge Point for the
+// version of its Local Authorization List, typically before sending a Differential
+// SendLocalList.req. The request has no fields.
+//
+// The Charge Point answers with a GetLocalListVersion.conf holding the version. The
+// spec reserves two values: ListVersionEmpty when the list is empty and
+// ListVersionNotSupported when the Charge Point has no Local Authorization List.
+// Any value below -1 is rejected, although the JSON schema allows it.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.10: Get Local List Version
+//   - OCPP 1.6J, Section 6.27 / 6.28: GetLocalListVersion.req / GetLocalListVersion.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/getlocallistversion"
+package getlocallistversion
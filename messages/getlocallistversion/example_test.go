@@ -0,0 +1,27 @@
+package getlocallistversion_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/getlocallistversion"
+)
+
+func ExampleConfirmationMessage_UnmarshalJSON() {
+	var conf getlocallistversion.ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{"listVersion":-1}`), &conf); err != nil {
+		log.Fatalf("failed to decode confirmation: %v", err)
+	}
+
+	switch conf.ListVersion {
+	case getlocallistversion.ListVersionNotSupported:
+		fmt.Println("no Local Authorization List")
+	case getlocallistversion.ListVersionEmpty:
+		fmt.Println("empty Local Authorization List")
+	default:
+		fmt.Println("list version", conf.ListVersion)
+	}
+	// Output:
+	// no Local Authorization List
+}
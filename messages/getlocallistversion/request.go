@@ -0,0 +1,58 @@
+package getlocallistversion
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RequestMessage represents the OCPP 1.6J GetLocalListVersion.req message.
+//
+// The message has no fields: it asks the Charge Point for the version of its Local
+// Authorization List.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.27: GetLocalListVersion.req
+type RequestMessage struct{}
+
+// Request constructs a new RequestMessage. It cannot fail, since
+// GetLocalListVersion.req has no fields.
+func Request() RequestMessage {
+	return RequestMessage{}
+}
+
+// Validate always succeeds, since GetLocalListVersion.req has no fields. It is
+// provided so that RequestMessage has the same API as every other message.
+func (r RequestMessage) Validate() error {
+	return nil
+}
+
+// ValidateAll always succeeds, since GetLocalListVersion.req has no fields.
+func (r RequestMessage) ValidateAll() error {
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+func (r RequestMessage) String() string {
+	return "GetLocalListVersion.req{}"
+}
+
+// MarshalJSON encodes the RequestMessage as an empty JSON object.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J GetLocalListVersion.req payload into the
+// RequestMessage.
+//
+// The payload must be a JSON object. Unknown fields are ignored, as for every other
+// message.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload struct{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package getlocallistversion
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGetLocalListVersionRequest(t *testing.T) {
+	t.Parallel()
+
+	req := Request()
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		t.Errorf("expected ValidateAll() to succeed, got error: %v", err)
+	}
+
+	if req.String() != "GetLocalListVersion.req{}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+}
+
+func TestGetLocalListVersionRequestJSON(t *testing.T) {
+	t.Parallel()
+
+	out, err := json.Marshal(Request())
+	if err != nil || string(out) != "{}" {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{}`), &req); err != nil {
+		t.Errorf("unexpected error unmarshaling request: %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`[]`), &req); err == nil {
+		t.Error("expected error for non-object payload, got nil")
+	}
+}
@@ -0,0 +1,98 @@
+package heartbeat
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConfirmationMessage represents the OCPP 1.6J Heartbeat.conf message.
+//
+// This message is returned by the Central System in response to a Heartbeat.req.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.30: Heartbeat.conf
+type ConfirmationMessage struct {
+	// CurrentTime is the current time of the Central System.
+	CurrentTime types.DateTimeType
+}
+
+// Confirmation constructs a new ConfirmationMessage for the given Central System time.
+//
+// It returns an error if currentTime is the zero time.
+//
+// Example usage:
+//
+//	conf, err := heartbeat.Confirmation(time.Now())
+//	if err != nil {
+//	    log.Fatalf("invalid confirmation: %v", err)
+//	}
+func Confirmation(currentTime time.Time) (ConfirmationMessage, error) {
+	now, err := types.DateTime(currentTime)
+	if err != nil {
+		return ConfirmationMessage{}, fmt.Errorf("invalid currentTime: %w", types.WithPath("currentTime", err))
+	}
+
+	return ConfirmationMessage{CurrentTime: now}, nil
+}
+
+// Validate performs internal validation on the ConfirmationMessage and returns the
+// first failure.
+func (m ConfirmationMessage) Validate() error {
+	if err := types.FirstError(m.ValidateAll()); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the ConfirmationMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (m ConfirmationMessage) ValidateAll() error {
+	return types.Field("currentTime", m.CurrentTime)
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+func (m ConfirmationMessage) String() string {
+	return "Heartbeat.conf{currentTime=" + m.CurrentTime.String() + "}"
+}
+
+// confirmationPayload is the OCPP 1.6J wire representation of Heartbeat.conf.
+type confirmationPayload struct {
+	CurrentTime *string `json:"currentTime"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J Heartbeat.conf payload.
+// The message is validated first, so an invalid ConfirmationMessage is never encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	currentTime := m.CurrentTime.String()
+
+	return json.Marshal(confirmationPayload{CurrentTime: &currentTime})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J Heartbeat.conf payload into the
+// ConfirmationMessage.
+//
+// The currentTime is validated while decoding, so a successfully decoded
+// ConfirmationMessage is always valid.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	currentTime, err := types.Required("currentTime", payload.CurrentTime, types.ParseDateTime)
+	if err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	*m = ConfirmationMessage{CurrentTime: currentTime}
+
+	return nil
+}
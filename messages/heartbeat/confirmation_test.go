@@ -0,0 +1,80 @@
+package heartbeat
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestHeartbeatConfirmationValid(t *testing.T) {
+	t.Parallel()
+
+	conf, err := Confirmation(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error constructing ConfirmationMessage: %v", err)
+	}
+
+	if err := conf.Validate(); err != nil {
+		t.Errorf("expected message to be valid, got error: %v", err)
+	}
+
+	if conf.String() != "Heartbeat.conf{currentTime=2025-01-02T03:04:05.000Z}" {
+		t.Errorf("unexpected String() output: %s", conf.String())
+	}
+}
+
+func TestHeartbeatConfirmationInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Confirmation(time.Time{}); !errors.Is(err, types.ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+
+	var verr *types.ValidationError
+	if err := (ConfirmationMessage{}).Validate(); !errors.As(err, &verr) || verr.Path != "currentTime" {
+		t.Errorf("expected failure at currentTime, got %v", err)
+	}
+
+	if _, err := json.Marshal(ConfirmationMessage{}); err == nil {
+		t.Error("expected error marshaling zero-value ConfirmationMessage, got nil")
+	}
+}
+
+func TestHeartbeatConfirmationJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var conf ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{"currentTime":"2025-01-02T04:04:05+01:00"}`), &conf); err != nil {
+		t.Fatalf("unexpected error unmarshaling confirmation: %v", err)
+	}
+
+	out, err := json.Marshal(conf)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling confirmation: %v", err)
+	}
+
+	if string(out) != `{"currentTime":"2025-01-02T03:04:05.000Z"}` {
+		t.Errorf("unexpected JSON output: %s", out)
+	}
+}
+
+func TestHeartbeatConfirmationUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var conf ConfirmationMessage
+
+	if err := json.Unmarshal([]byte(`{}`), &conf); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"currentTime":"noon"}`), &conf); !errors.Is(err, types.ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"currentTime":12}`), &conf); err == nil {
+		t.Error("expected error for non-string currentTime, got nil")
+	}
+}
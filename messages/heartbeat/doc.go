@@ -0,0 +1,17 @@
+// Package heartbeat models the OCPP 1.6J Heartbeat message pair.
+//
+// A Charge Point sends a Heartbeat.req at the interval returned in the
+// BootNotification.conf (or configured with the HeartbeatInterval key), so that the
+// Central System knows it is still connected. The request has no fields.
+//
+// The Central System answers with a Heartbeat.conf that contains its current time,
+// which the Charge Point should use to synchronize its internal clock.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 4.6: Heartbeat
+//   - OCPP 1.6J, Section 6.29 / 6.30: Heartbeat.req / Heartbeat.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/heartbeat"
+package heartbeat
@@ -0,0 +1,26 @@
+package heartbeat_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/messages/heartbeat"
+)
+
+func ExampleConfirmation() {
+	conf, err := heartbeat.Confirmation(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		log.Fatalf("failed to construct confirmation: %v", err)
+	}
+
+	payload, err := json.Marshal(conf)
+	if err != nil {
+		log.Fatalf("failed to encode confirmation: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"currentTime":"2025-01-02T03:04:05.000Z"}
+}
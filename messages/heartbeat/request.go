@@ -0,0 +1,57 @@
+package heartbeat
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RequestMessage represents the OCPP 1.6J Heartbeat.req message.
+//
+// The message has no fields: its only purpose is to tell the Central System that
+// the Charge Point is still connected.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.29: Heartbeat.req
+type RequestMessage struct{}
+
+// Request constructs a new RequestMessage. It cannot fail, since Heartbeat.req has
+// no fields.
+func Request() RequestMessage {
+	return RequestMessage{}
+}
+
+// Validate always succeeds, since Heartbeat.req has no fields. It is provided so
+// that RequestMessage has the same API as every other message.
+func (r RequestMessage) Validate() error {
+	return nil
+}
+
+// ValidateAll always succeeds, since Heartbeat.req has no fields.
+func (r RequestMessage) ValidateAll() error {
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+func (r RequestMessage) String() string {
+	return "Heartbeat.req{}"
+}
+
+// MarshalJSON encodes the RequestMessage as an empty JSON object.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J Heartbeat.req payload into the RequestMessage.
+//
+// The payload must be a JSON object. Unknown fields are ignored, as for every other
+// message.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload struct{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{}
+
+	return nil
+}
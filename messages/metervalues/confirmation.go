@@ -0,0 +1,57 @@
+package metervalues
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ConfirmationMessage represents the OCPP 1.6J MeterValues.conf message.
+//
+// The message has no fields: it only acknowledges the MeterValues.req.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.32: MeterValues.conf
+type ConfirmationMessage struct{}
+
+// Confirmation constructs a new ConfirmationMessage. It cannot fail, since
+// MeterValues.conf has no fields.
+func Confirmation() ConfirmationMessage {
+	return ConfirmationMessage{}
+}
+
+// Validate always succeeds, since MeterValues.conf has no fields. It is provided so
+// that ConfirmationMessage has the same API as every other message.
+func (m ConfirmationMessage) Validate() error {
+	return nil
+}
+
+// ValidateAll always succeeds, since MeterValues.conf has no fields.
+func (m ConfirmationMessage) ValidateAll() error {
+	return nil
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+func (m ConfirmationMessage) String() string {
+	return "MeterValues.conf{}"
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an empty JSON object.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J MeterValues.conf payload into the
+// ConfirmationMessage.
+//
+// The payload must be a JSON object. Unknown fields are ignored, as for every other
+// message.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload struct{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	*m = ConfirmationMessage{}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package metervalues
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMeterValuesConfirmation(t *testing.T) {
+	t.Parallel()
+
+	conf := Confirmation()
+
+	if err := conf.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	if err := conf.ValidateAll(); err != nil {
+		t.Errorf("expected ValidateAll() to succeed, got error: %v", err)
+	}
+
+	if conf.String() != "MeterValues.conf{}" {
+		t.Errorf("unexpected String() output: %s", conf.String())
+	}
+}
+
+func TestMeterValuesConfirmationJSON(t *testing.T) {
+	t.Parallel()
+
+	out, err := json.Marshal(Confirmation())
+	if err != nil || string(out) != "{}" {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	var conf ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{}`), &conf); err != nil {
+		t.Errorf("unexpected error unmarshaling confirmation: %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`"ok"`), &conf); err == nil {
+		t.Error("expected error for non-object payload, got nil")
+	}
+}
@@ -0,0 +1,23 @@
+// Package metervalues models the OCPP 1.6J MeterValues message pair.
+//
+// A Charge Point samples the electrical meter, or other sensors, and sends the
+// values to the Central System in a MeterValues.req. Samples are taken at a fixed
+// interval during transactions (MeterValueSampleInterval), at clock-aligned times
+// (ClockAlignedDataInterval) or on request of a TriggerMessage.req. Each MeterValue
+// groups the values sampled at one point in time; the SampledValue elements say
+// what was measured (measurand), where, on which phase and in which unit.
+//
+// Meter values and sampled values are modeled by types.MeterValueType and
+// types.SampledValueType, together with the enumerations of their optional fields,
+// so that misspelled measurands or units are rejected while decoding.
+//
+// The Central System answers with an empty MeterValues.conf.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 4.7: Meter Values
+//   - OCPP 1.6J, Section 6.31 / 6.32: MeterValues.req / MeterValues.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/metervalues"
+package metervalues
@@ -0,0 +1,64 @@
+package metervalues_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/messages/metervalues"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleRequest() {
+	energy, err := types.SampledValue("1500")
+	if err != nil {
+		log.Fatalf("invalid sampled value: %v", err)
+	}
+
+	current, err := types.SampledValue("16.1")
+	if err != nil {
+		log.Fatalf("invalid sampled value: %v", err)
+	}
+
+	measurand := types.MeasurandCurrentImport
+	unit := types.UnitOfMeasureA
+	current.Measurand = &measurand
+	current.Unit = &unit
+
+	mv, err := types.MeterValue(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), energy, current)
+	if err != nil {
+		log.Fatalf("invalid meter value: %v", err)
+	}
+
+	req, err := metervalues.Request(1, mv)
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"connectorId":1,"meterValue":[{"timestamp":"2025-01-02T03:04:05.000Z","sampledValue":[{"value":"1500"},{"value":"16.1","measurand":"Current.Import","unit":"A"}]}]}
+}
+
+func ExampleRequestMessage_UnmarshalJSON() {
+	payload := `{"connectorId":1,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z",` +
+		`"sampledValue":[{"value":"1500","measurand":"Energy.Active.Import.Registr"}]}]}`
+
+	var req metervalues.RequestMessage
+
+	err := json.Unmarshal([]byte(payload), &req)
+
+	var verr *types.ValidationError
+	if errors.As(err, &verr) {
+		fmt.Println(verr.Path, verr.Constraint, verr.Value)
+	}
+	// Output:
+	// meterValue[0].sampledValue[0].measurand enum Energy.Active.Import.Registr
+}
@@ -0,0 +1,177 @@
+package metervalues
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidConnectorId indicates that the connectorId of a MeterValues.req is
+// negative.
+var ErrInvalidConnectorId = errors.New("invalid connectorId")
+
+// RequestMessage represents the OCPP 1.6J MeterValues.req message.
+//
+// This message is sent by a Charge Point to report meter values for a connector,
+// or for the main meter of the Charge Point when ConnectorId is 0. TransactionId is
+// nil when the values are not related to a transaction.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.31: MeterValues.req
+type RequestMessage struct {
+	// ConnectorId identifies the connector the values belong to, or 0 for the main
+	// energy meter. It must not be negative.
+	ConnectorId int
+
+	// TransactionId is the transaction the values are related to.
+	TransactionId *int
+
+	// MeterValue holds the sampled meter values, each with its timestamp. At least
+	// one is required.
+	MeterValue []types.MeterValueType
+}
+
+// Request constructs a new RequestMessage for the given connector.
+//
+// TransactionId is nil and can be set on the returned message afterwards. An error
+// is returned if the connector id is negative, no meter value is given or a meter
+// value is invalid.
+//
+// Example usage:
+//
+//	energy, _ := types.SampledValue("1500")
+//	mv, _ := types.MeterValue(time.Now(), energy)
+//	req, err := metervalues.Request(1, mv)
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(connectorId int, meterValues ...types.MeterValueType) (RequestMessage, error) {
+	req := RequestMessage{ConnectorId: connectorId, TransactionId: nil, MeterValue: meterValues}
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path, for
+// example "meterValue[0].sampledValue[1].measurand".
+func (r RequestMessage) ValidateAll() error {
+	return types.Join(
+		connectorIdError(r.ConnectorId),
+		meterValueCountError(len(r.MeterValue)),
+		types.ListField("meterValue", r.MeterValue),
+	)
+}
+
+// connectorIdError reports a negative connectorId.
+func connectorIdError(connectorId int) error {
+	if connectorId < 0 {
+		return types.MinimumError("connectorId", connectorId, 0, ErrInvalidConnectorId)
+	}
+
+	return nil
+}
+
+// meterValueCountError reports a request without meter values.
+func meterValueCountError(count int) error {
+	if count < 1 {
+		return types.MinItemsError("meterValue", count, 1, types.ErrEmptyValueNotAllowed)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// TransactionId is only included when set.
+func (r RequestMessage) String() string {
+	fields := []string{"connectorId=" + strconv.Itoa(r.ConnectorId)}
+
+	if r.TransactionId != nil {
+		fields = append(fields, "transactionId="+strconv.Itoa(*r.TransactionId))
+	}
+
+	fields = append(fields, "meterValue="+types.FormatList(r.MeterValue))
+
+	return "MeterValues.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of MeterValues.req.
+type requestPayload struct {
+	ConnectorId   *int              `json:"connectorId"`
+	TransactionId *int              `json:"transactionId,omitempty"`
+	MeterValue    []json.RawMessage `json:"meterValue"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J MeterValues.req payload.
+//
+// An unset TransactionId is omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	meterValue, err := types.EncodeList(r.MeterValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(requestPayload{
+		ConnectorId:   &r.ConnectorId,
+		TransactionId: r.TransactionId,
+		MeterValue:    meterValue,
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J MeterValues.req payload into the
+// RequestMessage.
+//
+// Every field, including every sampled value, is validated while decoding and all
+// failures are reported together, so a successfully decoded RequestMessage is
+// always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	connectorId, connectorIdErr := types.RequiredValue("connectorId", payload.ConnectorId)
+	meterValue, meterValueErr := types.DecodeRequiredList[types.MeterValueType]("meterValue", payload.MeterValue)
+
+	if connectorIdErr == nil {
+		connectorIdErr = connectorIdError(connectorId)
+	}
+
+	if payload.MeterValue != nil && meterValueErr == nil {
+		meterValueErr = meterValueCountError(len(meterValue))
+	}
+
+	if err := types.Join(connectorIdErr, meterValueErr); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{
+		ConnectorId:   connectorId,
+		TransactionId: payload.TransactionId,
+		MeterValue:    meterValue,
+	}
+
+	return nil
+}
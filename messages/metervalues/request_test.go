@@ -0,0 +1,147 @@
+package metervalues
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+var sampleTime = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func energyReading(t *testing.T, value string) types.MeterValueType {
+	t.Helper()
+
+	energy, err := types.SampledValue(value)
+	if err != nil {
+		t.Fatalf("unexpected error creating SampledValue: %v", err)
+	}
+
+	mv, err := types.MeterValue(sampleTime, energy)
+	if err != nil {
+		t.Fatalf("unexpected error creating MeterValue: %v", err)
+	}
+
+	return mv
+}
+
+func TestMeterValuesRequestValid(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request(1, energyReading(t, "1500"))
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	transactionId := 42
+	req.TransactionId = &transactionId
+
+	want := "MeterValues.req{connectorId=1, transactionId=42, meterValue=[{timestamp=2025-01-02T03:04:05.000Z, " +
+		"sampledValue=[{value=1500}]}]}"
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+
+	if _, err := Request(0, energyReading(t, "1500")); err != nil {
+		t.Errorf("expected connector 0 (main meter) to be valid, got %v", err)
+	}
+}
+
+func TestMeterValuesRequestInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Request(-1, energyReading(t, "1")); !errors.Is(err, ErrInvalidConnectorId) {
+		t.Errorf("expected ErrInvalidConnectorId, got %v", err)
+	}
+
+	_, err := Request(1)
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "meterValue" || verr.Constraint != "minItems=1" {
+		t.Errorf("expected minItems failure at meterValue, got %v", err)
+	}
+}
+
+func TestMeterValuesRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	unit := types.UnitOfMeasure("kwh")
+	invalid := energyReading(t, "1")
+	invalid.SampledValue[0].Unit = &unit
+
+	req := RequestMessage{ConnectorId: -2, TransactionId: nil, MeterValue: []types.MeterValueType{energyReading(t, "1"), invalid}}
+
+	err := req.ValidateAll()
+	if !errors.Is(err, ErrInvalidConnectorId) || !errors.Is(err, types.ErrInvalidUnitOfMeasure) {
+		t.Errorf("expected connectorId and unit failures, got %v", err)
+	}
+}
+
+func TestMeterValuesRequestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"connectorId":2,"transactionId":7,"meterValue":[{"timestamp":"2025-01-02T03:04:05.000Z","sampledValue":` +
+		`[{"value":"1500","context":"Sample.Periodic","measurand":"Energy.Active.Import.Register","unit":"Wh"},` +
+		`{"value":"16.1","measurand":"Current.Import","phase":"L1","unit":"A"}]}]}`
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatalf("unexpected error unmarshaling request: %v", err)
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestMeterValuesRequestUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		data     string
+		path     string
+		sentinel error
+	}{
+		{`{"meterValue":[]}`, "connectorId", types.ErrMissingRequiredField},
+		{`{"connectorId":-1,"meterValue":[]}`, "connectorId", ErrInvalidConnectorId},
+		{`{"connectorId":1}`, "meterValue", types.ErrMissingRequiredField},
+		{`{"connectorId":1,"meterValue":[]}`, "meterValue", types.ErrEmptyValueNotAllowed},
+		{
+			`{"connectorId":1,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z","sampledValue":` +
+				`[{"value":"1"},{"value":"2","measurand":"Energy.Active.Import.Registers"}]}]}`,
+			"meterValue[0].sampledValue[1].measurand", types.ErrInvalidMeasurand,
+		},
+	}
+
+	for _, tc := range tests {
+		var req RequestMessage
+
+		err := json.Unmarshal([]byte(tc.data), &req)
+
+		var verr *types.ValidationError
+		if !errors.As(err, &verr) || verr.Path != tc.path || !errors.Is(err, tc.sentinel) {
+			t.Errorf("%s: expected %v at %s, got %v", tc.data, tc.sentinel, tc.path, err)
+		}
+	}
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"connectorId":"1"}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
+
+func TestMeterValuesRequestMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	req := RequestMessage{ConnectorId: 1, TransactionId: nil, MeterValue: nil}
+	if _, err := json.Marshal(req); !errors.Is(err, types.ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
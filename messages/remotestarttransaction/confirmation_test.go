@@ -0,0 +1,39 @@
+package remotestarttransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestRemoteStartTransactionConfirmationStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		data       string
+		constraint string
+		sentinel   error
+	}{
+		{`{}`, types.ConstraintRequired, types.ErrMissingRequiredField},
+		{`{"status":"Pending"}`, types.ConstraintEnum, types.ErrInvalidRemoteStartStopStatus},
+	}
+
+	for _, tc := range tests {
+		var conf ConfirmationMessage
+
+		err := json.Unmarshal([]byte(tc.data), &conf)
+
+		var verr *types.ValidationError
+		if !errors.As(err, &verr) || verr.Path != "status" || verr.Constraint != tc.constraint ||
+			!errors.Is(err, tc.sentinel) {
+			t.Errorf("%s: expected %s failure at status, got %v", tc.data, tc.constraint, err)
+		}
+	}
+
+	_, err := json.Marshal(ConfirmationMessage{Status: "Pending"})
+	if !errors.Is(err, types.ErrInvalidRemoteStartStopStatus) {
+		t.Errorf("expected ErrInvalidRemoteStartStopStatus encoding an invalid status, got %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+// Package remotestarttransaction models the OCPP 1.6J RemoteStartTransaction message
+// pair.
+//
+// A Central System sends a RemoteStartTransaction.req to ask a Charge Point to start
+// a transaction for an idTag, for example when a driver starts a session from an
+// app. The request may name the connector to use and may carry a charging profile
+// for the transaction.
+//
+// The Charge Point answers with a RemoteStartTransaction.conf telling whether it
+// accepted the request. If it did, it starts the transaction as if the idTag had been
+// presented locally (authorizing it first if AuthorizeRemoteTxRequests is true) and
+// sends a StartTransaction.req.
+//
+// A charging profile sent with the request applies to the transaction it starts, so
+// its purpose must be TxProfile. Since the transaction does not exist yet, the
+// profile must not carry a transactionId, and the request is also validated beyond
+// the JSON schema: connectorId, when set, must be greater than 0.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.11: Remote Start Transaction
+//   - OCPP 1.6J, Section 6.33 / 6.34: RemoteStartTransaction.req / RemoteStartTransaction.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/remotestarttransaction"
+package remotestarttransaction
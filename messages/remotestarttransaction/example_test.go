@@ -0,0 +1,46 @@
+package remotestarttransaction_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/remotestarttransaction"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleRequest() {
+	req, err := remotestarttransaction.Request("ABC123")
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	period, err := types.ChargingSchedulePeriod(0, 16)
+	if err != nil {
+		log.Fatalf("invalid period: %v", err)
+	}
+
+	schedule, err := types.ChargingSchedule(types.ChargingRateUnitTypeA, period)
+	if err != nil {
+		log.Fatalf("invalid schedule: %v", err)
+	}
+
+	profile, err := types.ChargingProfile(1, 0, types.ChargingProfilePurposeTypeTxProfile,
+		types.ChargingProfileKindTypeRelative, schedule)
+	if err != nil {
+		log.Fatalf("invalid profile: %v", err)
+	}
+
+	connectorId := 1
+	req.ConnectorId = &connectorId
+	req.ChargingProfile = &profile
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"connectorId":1,"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,"stackLevel":0,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative","chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}
+}
@@ -0,0 +1,226 @@
+package remotestarttransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConstraintTxProfile is the ValidationError constraint reported when the charging
+// profile of a RemoteStartTransaction.req is not a TxProfile, or is a TxProfile
+// bound to a transactionId.
+const ConstraintTxProfile = "txProfile"
+
+var (
+	// ErrInvalidConnectorId indicates that the connectorId of a
+	// RemoteStartTransaction.req is not greater than 0.
+	ErrInvalidConnectorId = errors.New("invalid connectorId")
+
+	// ErrNotTxProfile indicates that the charging profile of a
+	// RemoteStartTransaction.req does not have the TxProfile purpose.
+	ErrNotTxProfile = errors.New("charging profile purpose must be TxProfile")
+)
+
+// RequestMessage represents the OCPP 1.6J RemoteStartTransaction.req message.
+//
+// IdTag is required; ConnectorId and ChargingProfile are nil when they are not set.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.33: RemoteStartTransaction.req
+type RequestMessage struct {
+	// ConnectorId is the connector on which to start the transaction. When nil, the
+	// Charge Point chooses the connector.
+	ConnectorId *int
+
+	// IdTag is the identifier the transaction is started for.
+	IdTag types.IdTokenType
+
+	// ChargingProfile is the profile to apply to the transaction. Its purpose must be
+	// TxProfile, and it has no transactionId since the transaction does not exist
+	// yet.
+	ChargingProfile *types.ChargingProfileType
+}
+
+// Request constructs a new RequestMessage for the given idTag, without connector or
+// charging profile.
+//
+// ConnectorId and ChargingProfile can be set on the returned message afterwards. It
+// returns an error if the idTag is not valid.
+//
+// Example usage:
+//
+//	req, err := remotestarttransaction.Request("ABC123")
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(idTag string) (RequestMessage, error) {
+	token, err := types.Required("idTag", &idTag, types.IdToken)
+	if err != nil {
+		return RequestMessage{}, fmt.Errorf("failed to create RequestMessage: %w", err)
+	}
+
+	return RequestMessage{
+		ConnectorId:     nil,
+		IdTag:           token,
+		ChargingProfile: nil,
+	}, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	var errs []error
+
+	if r.ConnectorId != nil {
+		errs = append(errs, connectorIdError(*r.ConnectorId))
+	}
+
+	errs = append(errs, types.Field("idTag", r.IdTag))
+
+	if r.ChargingProfile != nil {
+		errs = append(errs,
+			types.Field("chargingProfile", r.ChargingProfile),
+			chargingProfileError(*r.ChargingProfile),
+		)
+	}
+
+	return types.Join(errs...)
+}
+
+// connectorIdError reports a connectorId that is not greater than 0.
+func connectorIdError(connectorId int) error {
+	if connectorId < 1 {
+		return types.MinimumError("connectorId", connectorId, 1, ErrInvalidConnectorId)
+	}
+
+	return nil
+}
+
+// chargingProfileError reports a valid charging profile purpose other than
+// TxProfile, and a TxProfile carrying a transactionId. Invalid purposes are reported
+// by the profile itself.
+func chargingProfileError(profile types.ChargingProfileType) error {
+	purpose := profile.ChargingProfilePurpose
+
+	switch {
+	case !purpose.IsValid():
+		return nil
+	case purpose != types.ChargingProfilePurposeTypeTxProfile:
+		return &types.ValidationError{
+			Path:       "chargingProfile.chargingProfilePurpose",
+			Constraint: ConstraintTxProfile,
+			Value:      purpose,
+			Err:        fmt.Errorf("%w: %s", ErrNotTxProfile, purpose),
+		}
+	case profile.TransactionId != nil:
+		return &types.ValidationError{
+			Path:       "chargingProfile.transactionId",
+			Constraint: ConstraintTxProfile,
+			Value:      *profile.TransactionId,
+			Err:        fmt.Errorf("%w: the transaction has not started yet", types.ErrTransactionIdNotAllowed),
+		}
+	default:
+		return nil
+	}
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// Optional fields are only included when set.
+func (r RequestMessage) String() string {
+	var fields []string
+
+	if r.ConnectorId != nil {
+		fields = append(fields, "connectorId="+strconv.Itoa(*r.ConnectorId))
+	}
+
+	fields = append(fields, "idTag="+r.IdTag.String())
+
+	if r.ChargingProfile != nil {
+		fields = append(fields, "chargingProfile="+r.ChargingProfile.String())
+	}
+
+	return "RemoteStartTransaction.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of RemoteStartTransaction.req.
+type requestPayload struct {
+	ConnectorId     *int            `json:"connectorId,omitempty"`
+	IdTag           *string         `json:"idTag"`
+	ChargingProfile json.RawMessage `json:"chargingProfile,omitempty"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J RemoteStartTransaction.req
+// payload.
+//
+// Unset optional fields are omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	idTag := r.IdTag.String()
+
+	profile, err := types.EncodeOptional(r.ChargingProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(requestPayload{
+		ConnectorId:     r.ConnectorId,
+		IdTag:           &idTag,
+		ChargingProfile: profile,
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J RemoteStartTransaction.req payload into the
+// RequestMessage.
+//
+// Every field, including the purpose of the charging profile, is validated while
+// decoding and all failures are reported together, so a successfully decoded
+// RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	var connectorIdErr error
+	if payload.ConnectorId != nil {
+		connectorIdErr = connectorIdError(*payload.ConnectorId)
+	}
+
+	idTag, idTagErr := types.Required("idTag", payload.IdTag, types.IdToken)
+	profile, profileErr := types.DecodeOptional[types.ChargingProfileType]("chargingProfile", payload.ChargingProfile)
+
+	if profile != nil && profileErr == nil {
+		profileErr = chargingProfileError(*profile)
+	}
+
+	if err := types.Join(connectorIdErr, idTagErr, profileErr); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{
+		ConnectorId:     payload.ConnectorId,
+		IdTag:           idTag,
+		ChargingProfile: profile,
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package remotestarttransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// txProfile returns a valid profile of 16 A with the given purpose.
+func txProfile(t *testing.T, purpose types.ChargingProfilePurposeType) types.ChargingProfileType {
+	t.Helper()
+
+	period, _ := types.ChargingSchedulePeriod(0, 16)
+	schedule, _ := types.ChargingSchedule(types.ChargingRateUnitTypeA, period)
+
+	profile, err := types.ChargingProfile(1, 0, purpose, types.ChargingProfileKindTypeRelative, schedule)
+	if err != nil {
+		t.Fatalf("unexpected error creating charging profile: %v", err)
+	}
+
+	return profile
+}
+
+func TestRemoteStartTransactionRequestValid(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request("ABC123")
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	connectorId := 2
+	profile := txProfile(t, types.ChargingProfilePurposeTypeTxProfile)
+	req.ConnectorId = &connectorId
+	req.ChargingProfile = &profile
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	want := "RemoteStartTransaction.req{connectorId=2, idTag=ABC123, chargingProfile={chargingProfileId=1, " +
+		"stackLevel=0, chargingProfilePurpose=TxProfile, chargingProfileKind=Relative, " +
+		"chargingSchedule={chargingRateUnit=A, chargingSchedulePeriod=[{startPeriod=0, limit=16}]}}}"
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+}
+
+func TestRemoteStartTransactionRequestInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Request(""); !errors.Is(err, types.ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+
+	req, _ := Request("ABC123")
+	connectorId := 0
+	req.ConnectorId = &connectorId
+
+	var verr *types.ValidationError
+	if err := req.Validate(); !errors.As(err, &verr) || verr.Path != "connectorId" || verr.Constraint != "minimum=1" {
+		t.Errorf("expected connectorId failure, got %v", err)
+	}
+}
+
+func TestRemoteStartTransactionRequestRequiresTxProfile(t *testing.T) {
+	t.Parallel()
+
+	for _, purpose := range []types.ChargingProfilePurposeType{
+		types.ChargingProfilePurposeTypeChargePointMaxProfile, types.ChargingProfilePurposeTypeTxDefaultProfile,
+	} {
+		req, _ := Request("ABC123")
+		profile := txProfile(t, purpose)
+		req.ChargingProfile = &profile
+
+		err := req.Validate()
+
+		var verr *types.ValidationError
+		if !errors.Is(err, ErrNotTxProfile) || !errors.As(err, &verr) ||
+			verr.Path != "chargingProfile.chargingProfilePurpose" || verr.Constraint != ConstraintTxProfile {
+			t.Errorf("%s: expected TxProfile failure, got %v", purpose, err)
+		}
+	}
+}
+
+func TestRemoteStartTransactionRequestRejectsTransactionId(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request("ABC123")
+	profile := txProfile(t, types.ChargingProfilePurposeTypeTxProfile)
+	transactionId := 42
+	profile.TransactionId = &transactionId
+	req.ChargingProfile = &profile
+
+	var verr *types.ValidationError
+	if err := req.Validate(); !errors.Is(err, types.ErrTransactionIdNotAllowed) || !errors.As(err, &verr) ||
+		verr.Path != "chargingProfile.transactionId" {
+		t.Errorf("expected transactionId failure, got %v", err)
+	}
+
+	data := `{"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,"transactionId":42,"stackLevel":0,` +
+		`"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+		`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`
+	if err := json.Unmarshal([]byte(data), &req); !errors.Is(err, types.ErrTransactionIdNotAllowed) {
+		t.Errorf("expected ErrTransactionIdNotAllowed, got %v", err)
+	}
+}
+
+func TestRemoteStartTransactionRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	connectorId := -1
+	profile := txProfile(t, types.ChargingProfilePurposeTypeTxProfile)
+	profile.ChargingProfilePurpose = "Tx"
+	profile.StackLevel = -1
+
+	req := RequestMessage{ConnectorId: &connectorId, IdTag: types.IdTokenType{}, ChargingProfile: &profile}
+
+	err := req.ValidateAll()
+	for _, sentinel := range []error{
+		ErrInvalidConnectorId, types.ErrEmptyValueNotAllowed, types.ErrInvalidStackLevel,
+		types.ErrInvalidChargingProfilePurposeType,
+	} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	if errors.Is(err, ErrNotTxProfile) {
+		t.Errorf("did not expect a TxProfile failure for an invalid purpose, got %v", err)
+	}
+}
+
+func TestRemoteStartTransactionRequestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"connectorId":1,"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,"stackLevel":0,` +
+		`"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+		`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatalf("unexpected error unmarshaling request: %v", err)
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestRemoteStartTransactionRequestMarshalJSONOmitsOptionalFields(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request("ABC123")
+
+	out, err := json.Marshal(req)
+	if err != nil || string(out) != `{"idTag":"ABC123"}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	profile := txProfile(t, types.ChargingProfilePurposeTypeTxDefaultProfile)
+	req.ChargingProfile = &profile
+
+	if _, err := json.Marshal(req); !errors.Is(err, ErrNotTxProfile) {
+		t.Errorf("expected ErrNotTxProfile, got %v", err)
+	}
+}
+
+func TestRemoteStartTransactionRequestUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"connectorId":0,"chargingProfile":{"chargingProfileId":1,"stackLevel":0,`+
+		`"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",`+
+		`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[]}}}`), &req)
+	for _, sentinel := range []error{ErrInvalidConnectorId, types.ErrMissingRequiredField, types.ErrEmptyValueNotAllowed} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	err = json.Unmarshal([]byte(`{"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,"stackLevel":0,`+
+		`"chargingProfilePurpose":"ChargePointMaxProfile","chargingProfileKind":"Relative",`+
+		`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`), &req)
+	if !errors.Is(err, ErrNotTxProfile) {
+		t.Errorf("expected ErrNotTxProfile, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"idTag":["ABC123"]}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
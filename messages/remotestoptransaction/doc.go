@@ -0,0 +1,20 @@
+// Package remotestoptransaction models the OCPP 1.6J RemoteStopTransaction message
+// pair.
+//
+// A Central System sends a RemoteStopTransaction.req to ask a Charge Point to stop a
+// transaction, identified by the transaction id it assigned in the
+// StartTransaction.conf. This is used, for example, when a driver stops a session
+// from an app.
+//
+// The Charge Point answers with a RemoteStopTransaction.conf telling whether it
+// accepted the request. If it did, it stops the transaction as if it had been
+// stopped locally and sends a StopTransaction.req.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.12: Remote Stop Transaction
+//   - OCPP 1.6J, Section 6.35 / 6.36: RemoteStopTransaction.req / RemoteStopTransaction.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/remotestoptransaction"
+package remotestoptransaction
@@ -0,0 +1,23 @@
+package remotestoptransaction_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/remotestoptransaction"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleConfirmation() {
+	var conf remotestoptransaction.ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{"status":"Rejected"}`), &conf); err != nil {
+		log.Fatalf("failed to decode confirmation: %v", err)
+	}
+
+	if conf.Status == types.RemoteStartStopStatusRejected {
+		fmt.Println("the Charge Point refused to stop the transaction")
+	}
+	// Output:
+	// the Charge Point refused to stop the transaction
+}
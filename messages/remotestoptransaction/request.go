@@ -0,0 +1,71 @@
+package remotestoptransaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// RequestMessage represents the OCPP 1.6J RemoteStopTransaction.req message.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.35: RemoteStopTransaction.req
+type RequestMessage struct {
+	// TransactionId identifies the transaction to stop, as assigned in the
+	// StartTransaction.conf.
+	TransactionId int
+}
+
+// Request constructs a new RequestMessage for the given transaction. It cannot
+// fail, since any integer is a valid transaction id.
+func Request(transactionId int) RequestMessage {
+	return RequestMessage{TransactionId: transactionId}
+}
+
+// Validate performs internal validation on the RequestMessage. Every transaction id
+// is valid, so it always returns nil.
+func (r RequestMessage) Validate() error {
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage. Every transaction id is
+// valid, so it always returns nil.
+func (r RequestMessage) ValidateAll() error {
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+func (r RequestMessage) String() string {
+	return "RemoteStopTransaction.req{transactionId=" + strconv.Itoa(r.TransactionId) + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of RemoteStopTransaction.req.
+type requestPayload struct {
+	TransactionId *int `json:"transactionId"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J RemoteStopTransaction.req
+// payload.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(requestPayload{TransactionId: &r.TransactionId})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J RemoteStopTransaction.req payload into the
+// RequestMessage, failing if the transactionId is missing.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	transactionId, err := types.RequiredValue("transactionId", payload.TransactionId)
+	if err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{TransactionId: transactionId}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package remotestoptransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestRemoteStopTransactionRequest(t *testing.T) {
+	t.Parallel()
+
+	req := Request(42)
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		t.Errorf("expected ValidateAll() to succeed, got error: %v", err)
+	}
+
+	if req.String() != "RemoteStopTransaction.req{transactionId=42}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+}
+
+func TestRemoteStopTransactionRequestJSON(t *testing.T) {
+	t.Parallel()
+
+	out, err := json.Marshal(Request(42))
+	if err != nil || string(out) != `{"transactionId":42}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"transactionId":7}`), &req); err != nil || req.TransactionId != 7 {
+		t.Errorf("unexpected request: %v, %v", req, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &req); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"transactionId":"7"}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
@@ -0,0 +1,18 @@
+// Package reset models the OCPP 1.6J Reset message pair.
+//
+// A Central System sends a Reset.req to ask a Charge Point to restart. A Soft reset
+// stops ongoing transactions gracefully and restarts the application software; a
+// Hard reset restarts the Charge Point, including its hardware, without waiting for
+// transactions to stop.
+//
+// The Charge Point answers with a Reset.conf telling whether it will attempt the
+// reset, before actually performing it.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.14: Reset
+//   - OCPP 1.6J, Section 6.39 / 6.40: Reset.req / Reset.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/reset"
+package reset
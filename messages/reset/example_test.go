@@ -0,0 +1,25 @@
+package reset_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/reset"
+)
+
+func ExampleRequest() {
+	req, err := reset.Request(reset.ResetTypeSoft)
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"type":"Soft"}
+}
@@ -0,0 +1,96 @@
+package reset
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// RequestMessage represents the OCPP 1.6J Reset.req message.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.39: Reset.req
+type RequestMessage struct {
+	// Type is the kind of reset the Charge Point should perform.
+	Type ResetType
+}
+
+// Request constructs a new RequestMessage for the given kind of reset.
+//
+// It returns an error if resetType is not a valid ResetType.
+//
+// Example usage:
+//
+//	req, err := reset.Request(reset.ResetTypeSoft)
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(resetType ResetType) (RequestMessage, error) {
+	req := RequestMessage{Type: resetType}
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := r.ValidateAll(); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns its failures as
+// *types.ValidationError values with their JSON path.
+func (r RequestMessage) ValidateAll() error {
+	if !r.Type.IsValid() {
+		return types.EnumError("type", r.Type, ErrInvalidResetType)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+func (r RequestMessage) String() string {
+	return "Reset.req{type=" + r.Type.String() + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of Reset.req.
+type requestPayload struct {
+	Type *string `json:"type"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J Reset.req payload. The
+// message is validated first, so an invalid RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	resetType := r.Type.String()
+
+	return json.Marshal(requestPayload{Type: &resetType})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J Reset.req payload into the RequestMessage,
+// validating it while decoding.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	resetType, err := types.Required("type", payload.Type, ParseResetType)
+	if err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{Type: resetType}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package reset
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestResetRequest(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request(ResetTypeHard)
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	if req.String() != "Reset.req{type=Hard}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil || string(out) != `{"type":"Hard"}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	if _, err := Request("Warm"); !errors.Is(err, ErrInvalidResetType) {
+		t.Errorf("expected ErrInvalidResetType, got %v", err)
+	}
+
+	if _, err := json.Marshal(RequestMessage{}); !errors.Is(err, ErrInvalidResetType) {
+		t.Errorf("expected ErrInvalidResetType, got %v", err)
+	}
+}
+
+func TestResetRequestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"type":"Soft"}`), &req); err != nil || req.Type != ResetTypeSoft {
+		t.Errorf("unexpected request: %v, %v", req, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &req); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	var verr *types.ValidationError
+	if err := json.Unmarshal([]byte(`{"type":"soft"}`), &req); !errors.As(err, &verr) || verr.Path != "type" {
+		t.Errorf("expected invalid type, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"type":1}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
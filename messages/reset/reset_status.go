@@ -0,0 +1,83 @@
+package reset
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidResetStatus indicates that a ResetStatus is not one of the values
+// defined by OCPP 1.6J.
+var ErrInvalidResetStatus = errors.New("invalid reset status")
+
+// ResetStatus is the result of a Reset.req, reported in the status field of
+// Reset.conf.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.41: ResetStatus
+type ResetStatus string
+
+const (
+	// ResetStatusAccepted means that the Charge Point will perform the reset.
+	ResetStatusAccepted ResetStatus = "Accepted"
+
+	// ResetStatusRejected means that the Charge Point will not perform the reset.
+	ResetStatusRejected ResetStatus = "Rejected"
+)
+
+// IsValid returns true if the ResetStatus is one of the values
+// defined by OCPP 1.6J.
+func (r ResetStatus) IsValid() bool {
+	switch r {
+	case ResetStatusAccepted, ResetStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ResetStatus.
+func (r ResetStatus) String() string {
+	return string(r)
+}
+
+// ParseResetStatus converts a wire value into a ResetStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidResetStatus.
+func ParseResetStatus(value string) (ResetStatus, error) {
+	return types.ParseEnum[ResetStatus](value, ErrInvalidResetStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidResetStatus if the value is not recognized.
+func (r ResetStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(r, ErrInvalidResetStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidResetStatus if the input is not recognized.
+func (r *ResetStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseResetStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (r ResetStatus) MarshalJSON() ([]byte, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (r *ResetStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, r, ParseResetStatus)
+}
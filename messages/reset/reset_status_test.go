@@ -0,0 +1,20 @@
+package reset
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestResetStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[ResetStatus]{
+		Values: []ResetStatus{
+			ResetStatusAccepted, ResetStatusRejected,
+		},
+		Invalid:  []string{"", "accepted", "Scheduled"},
+		Sentinel: ErrInvalidResetStatus,
+		Parse:    ParseResetStatus,
+	})
+}
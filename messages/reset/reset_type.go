@@ -0,0 +1,84 @@
+package reset
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidResetType indicates that a ResetType is not one of the values
+// defined by OCPP 1.6J.
+var ErrInvalidResetType = errors.New("invalid reset type")
+
+// ResetType is the kind of reset requested by a Reset.req.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.42: ResetType
+type ResetType string
+
+const (
+	// ResetTypeHard restarts the Charge Point, including all of its hardware,
+	// without waiting for transactions to be stopped gracefully.
+	ResetTypeHard ResetType = "Hard"
+
+	// ResetTypeSoft stops ongoing transactions gracefully and then restarts the
+	// application software of the Charge Point.
+	ResetTypeSoft ResetType = "Soft"
+)
+
+// IsValid returns true if the ResetType is one of the values
+// defined by OCPP 1.6J.
+func (r ResetType) IsValid() bool {
+	switch r {
+	case ResetTypeHard, ResetTypeSoft:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ResetType.
+func (r ResetType) String() string {
+	return string(r)
+}
+
+// ParseResetType converts a wire value into a ResetType. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidResetType.
+func ParseResetType(value string) (ResetType, error) {
+	return types.ParseEnum[ResetType](value, ErrInvalidResetType)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidResetType if the value is not recognized.
+func (r ResetType) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(r, ErrInvalidResetType)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidResetType if the input is not recognized.
+func (r *ResetType) UnmarshalText(text []byte) error {
+	parsed, err := ParseResetType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (r ResetType) MarshalJSON() ([]byte, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (r *ResetType) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, r, ParseResetType)
+}
@@ -0,0 +1,20 @@
+package reset
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestResetType(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[ResetType]{
+		Values: []ResetType{
+			ResetTypeHard, ResetTypeSoft,
+		},
+		Invalid:  []string{"", "hard", "Reboot"},
+		Sentinel: ErrInvalidResetType,
+		Parse:    ParseResetType,
+	})
+}
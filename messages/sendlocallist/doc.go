@@ -0,0 +1,24 @@
+// Package sendlocallist models the OCPP 1.6J SendLocalList message pair.
+//
+// A Central System sends a SendLocalList.req to update the Local Authorization
+// List of a Charge Point, the list of idTags it can authorize without contacting
+// the Central System. A Full update replaces the list with the entries of the
+// request; a Differential update adds or updates the entries with idTagInfo and
+// removes those without.
+//
+// The Charge Point answers with a SendLocalList.conf. It reports VersionMismatch
+// when a differential update does not apply to the version of its current list.
+//
+// The request is validated beyond the JSON schema: listVersion must be greater
+// than 0, since 0 and -1 are reserved for an empty and an unsupported list, and
+// every entry of a Full update must carry idTagInfo, since removing an entry only
+// makes sense in a Differential update.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.15: Send Local List
+//   - OCPP 1.6J, Section 6.41 / 6.42: SendLocalList.req / SendLocalList.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/sendlocallist"
+package sendlocallist
@@ -0,0 +1,57 @@
+package sendlocallist_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/sendlocallist"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleRequest() {
+	added, err := types.AuthorizationData("ABC123")
+	if err != nil {
+		log.Fatalf("invalid idTag: %v", err)
+	}
+
+	info, err := types.IdTagInfo(types.Accepted)
+	if err != nil {
+		log.Fatalf("invalid idTagInfo: %v", err)
+	}
+
+	added.IdTagInfo = &info
+
+	removed, err := types.AuthorizationData("DEF456")
+	if err != nil {
+		log.Fatalf("invalid idTag: %v", err)
+	}
+
+	req, err := sendlocallist.Request(2, sendlocallist.UpdateTypeDifferential, added, removed)
+	if err != nil {
+		log.Fatalf("invalid request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"listVersion":2,"localAuthorizationList":[{"idTag":"ABC123","idTagInfo":{"status":"Accepted"}},{"idTag":"DEF456"}],"updateType":"Differential"}
+}
+
+func ExampleRequestMessage_UnmarshalJSON() {
+	data := []byte(`{"listVersion":1,"localAuthorizationList":[{"idTag":"ABC123"}],"updateType":"Full"}`)
+
+	var req sendlocallist.RequestMessage
+
+	err := json.Unmarshal(data, &req)
+	if errors.Is(err, sendlocallist.ErrMissingIdTagInfo) {
+		fmt.Println("a Full update needs idTagInfo for every entry")
+	}
+	// Output:
+	// a Full update needs idTagInfo for every entry
+}
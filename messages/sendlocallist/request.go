@@ -0,0 +1,205 @@
+package sendlocallist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConstraintFullUpdate is the ValidationError constraint reported when an entry of
+// a Full update has no idTagInfo.
+const ConstraintFullUpdate = "fullUpdate"
+
+var (
+	// ErrInvalidListVersion indicates that the listVersion of a SendLocalList.req is
+	// not greater than 0.
+	ErrInvalidListVersion = errors.New("invalid listVersion")
+
+	// ErrMissingIdTagInfo indicates that an entry of a Full update has no
+	// idTagInfo, which would remove it from a list that is replaced anyway.
+	ErrMissingIdTagInfo = errors.New("entries of a Full update need idTagInfo")
+)
+
+// RequestMessage represents the OCPP 1.6J SendLocalList.req message.
+//
+// LocalAuthorizationList is nil when the request has no entries.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.41: SendLocalList.req
+type RequestMessage struct {
+	// ListVersion is the version the Local Authorization List has after the update.
+	ListVersion int
+
+	// LocalAuthorizationList holds the entries of the update. In a Full update they
+	// form the new list, which is empty when there are none.
+	LocalAuthorizationList []types.AuthorizationDataType
+
+	// UpdateType tells whether the entries replace or change the current list.
+	UpdateType UpdateType
+}
+
+// Request constructs a new RequestMessage updating the list to listVersion with
+// the given entries.
+//
+// It returns an error if listVersion is not greater than 0, if updateType is not a
+// valid UpdateType, if an entry is invalid, or if an entry of a Full update has no
+// idTagInfo.
+//
+// Example usage:
+//
+//	entry, _ := types.AuthorizationData("ABC123")
+//	entry.IdTagInfo = &info
+//	req, err := sendlocallist.Request(2, sendlocallist.UpdateTypeDifferential, entry)
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(listVersion int, updateType UpdateType, entries ...types.AuthorizationDataType) (RequestMessage, error) {
+	req := RequestMessage{ListVersion: listVersion, LocalAuthorizationList: entries, UpdateType: updateType}
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path, for
+// example "localAuthorizationList[1].idTagInfo".
+func (r RequestMessage) ValidateAll() error {
+	errs := []error{
+		listVersionError(r.ListVersion),
+		types.ListField("localAuthorizationList", r.LocalAuthorizationList),
+	}
+
+	if !r.UpdateType.IsValid() {
+		errs = append(errs, types.EnumError("updateType", r.UpdateType, ErrInvalidUpdateType))
+	}
+
+	errs = append(errs, fullUpdateError(r.UpdateType, r.LocalAuthorizationList))
+
+	return types.Join(errs...)
+}
+
+// listVersionError reports a listVersion that is not greater than 0.
+func listVersionError(listVersion int) error {
+	if listVersion < 1 {
+		return types.MinimumError("listVersion", listVersion, 1, ErrInvalidListVersion)
+	}
+
+	return nil
+}
+
+// fullUpdateError reports the entries of a Full update without idTagInfo.
+func fullUpdateError(updateType UpdateType, entries []types.AuthorizationDataType) error {
+	if updateType != UpdateTypeFull {
+		return nil
+	}
+
+	errs := make([]error, 0, len(entries))
+
+	for i, entry := range entries {
+		if entry.IdTagInfo == nil {
+			errs = append(errs, &types.ValidationError{
+				Path:       types.ElementPath("localAuthorizationList", i) + ".idTagInfo",
+				Constraint: ConstraintFullUpdate,
+				Value:      entry.IdTag.String(),
+				Err:        ErrMissingIdTagInfo,
+			})
+		}
+	}
+
+	return types.Join(errs...)
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// The list is only included when set.
+func (r RequestMessage) String() string {
+	fields := []string{"listVersion=" + strconv.Itoa(r.ListVersion)}
+
+	if r.LocalAuthorizationList != nil {
+		fields = append(fields, "localAuthorizationList="+types.FormatList(r.LocalAuthorizationList))
+	}
+
+	fields = append(fields, "updateType="+r.UpdateType.String())
+
+	return "SendLocalList.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of SendLocalList.req.
+type requestPayload struct {
+	ListVersion            *int              `json:"listVersion"`
+	LocalAuthorizationList []json.RawMessage `json:"localAuthorizationList,omitempty"`
+	UpdateType             *string           `json:"updateType"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J SendLocalList.req payload.
+//
+// An empty list is omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	entries, err := types.EncodeList(r.LocalAuthorizationList)
+	if err != nil {
+		return nil, err
+	}
+
+	updateType := r.UpdateType.String()
+
+	return json.Marshal(requestPayload{
+		ListVersion:            &r.ListVersion,
+		LocalAuthorizationList: entries,
+		UpdateType:             &updateType,
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J SendLocalList.req payload into the
+// RequestMessage.
+//
+// Every field, including the idTagInfo of the entries of a Full update, is
+// validated while decoding and all failures are reported together, so a
+// successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	listVersion, listVersionErr := types.RequiredValue("listVersion", payload.ListVersion)
+	entries, entriesErr := types.DecodeList[types.AuthorizationDataType]("localAuthorizationList",
+		payload.LocalAuthorizationList)
+	updateType, updateTypeErr := types.Required("updateType", payload.UpdateType, ParseUpdateType)
+
+	if listVersionErr == nil {
+		listVersionErr = listVersionError(listVersion)
+	}
+
+	if entriesErr == nil && updateTypeErr == nil {
+		entriesErr = fullUpdateError(updateType, entries)
+	}
+
+	if err := types.Join(listVersionErr, entriesErr, updateTypeErr); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{ListVersion: listVersion, LocalAuthorizationList: entries, UpdateType: updateType}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package sendlocallist
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func entry(t *testing.T, idTag string, status *types.AuthorizationStatus) types.AuthorizationDataType {
+	t.Helper()
+
+	data, err := types.AuthorizationData(idTag)
+	if err != nil {
+		t.Fatalf("unexpected error creating entry: %v", err)
+	}
+
+	if status != nil {
+		info, err := types.IdTagInfo(*status)
+		if err != nil {
+			t.Fatalf("unexpected error creating idTagInfo: %v", err)
+		}
+
+		data.IdTagInfo = &info
+	}
+
+	return data
+}
+
+func TestSendLocalListRequest(t *testing.T) {
+	t.Parallel()
+
+	accepted := types.Accepted
+
+	req, err := Request(2, UpdateTypeDifferential, entry(t, "ABC123", &accepted), entry(t, "DEF456", nil))
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	want := "SendLocalList.req{listVersion=2, localAuthorizationList=[{idTag=ABC123, idTagInfo={status=Accepted}}, " +
+		"{idTag=DEF456}], updateType=Differential}"
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+
+	req, err = Request(1, UpdateTypeFull)
+	if err != nil {
+		t.Fatalf("expected an empty Full update to be valid, got %v", err)
+	}
+
+	if req.String() != "SendLocalList.req{listVersion=1, updateType=Full}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+}
+
+func TestSendLocalListRequestInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Request(0, UpdateTypeFull); !errors.Is(err, ErrInvalidListVersion) {
+		t.Errorf("expected ErrInvalidListVersion, got %v", err)
+	}
+
+	if _, err := Request(1, UpdateType("Partial")); !errors.Is(err, ErrInvalidUpdateType) {
+		t.Errorf("expected ErrInvalidUpdateType, got %v", err)
+	}
+
+	accepted := types.Accepted
+	req := RequestMessage{
+		ListVersion:            -1,
+		LocalAuthorizationList: []types.AuthorizationDataType{entry(t, "ABC123", &accepted), entry(t, "DEF456", nil)},
+		UpdateType:             UpdateTypeFull,
+	}
+
+	err := req.ValidateAll()
+	if !errors.Is(err, ErrInvalidListVersion) || !errors.Is(err, ErrMissingIdTagInfo) {
+		t.Errorf("expected listVersion and idTagInfo failures, got %v", err)
+	}
+
+	var verr *types.ValidationError
+	if !errors.As(fullUpdateError(req.UpdateType, req.LocalAuthorizationList), &verr) ||
+		verr.Path != "localAuthorizationList[1].idTagInfo" || verr.Constraint != ConstraintFullUpdate {
+		t.Errorf("expected failure at localAuthorizationList[1].idTagInfo, got %v", verr)
+	}
+
+	req.UpdateType = UpdateTypeDifferential
+	req.ListVersion = 3
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected a Differential update to allow entries without idTagInfo, got %v", err)
+	}
+
+	req.ListVersion = 0
+	if _, err := json.Marshal(req); !errors.Is(err, ErrInvalidListVersion) {
+		t.Errorf("expected ErrInvalidListVersion, got %v", err)
+	}
+}
+
+func TestSendLocalListRequestJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{
+		`{"listVersion":1,"updateType":"Full"}`,
+		`{"listVersion":4,"localAuthorizationList":[{"idTag":"ABC123","idTagInfo":{"status":"Blocked"}}],"updateType":"Full"}`,
+		`{"listVersion":5,"localAuthorizationList":[{"idTag":"ABC123"}],"updateType":"Differential"}`,
+	} {
+		var req RequestMessage
+		if err := json.Unmarshal([]byte(data), &req); err != nil {
+			t.Fatalf("%s: unexpected error unmarshaling request: %v", data, err)
+		}
+
+		out, err := json.Marshal(req)
+		if err != nil || string(out) != data {
+			t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s, %v", data, out, err)
+		}
+	}
+
+	tests := []struct {
+		data string
+		path string
+	}{
+		{`{"updateType":"Full"}`, "listVersion"},
+		{`{"listVersion":0,"updateType":"Full"}`, "listVersion"},
+		{`{"listVersion":1}`, "updateType"},
+		{`{"listVersion":1,"updateType":"Partial"}`, "updateType"},
+		{`{"listVersion":1,"localAuthorizationList":[{}],"updateType":"Full"}`, "localAuthorizationList[0].idTag"},
+		{`{"listVersion":1,"localAuthorizationList":[{"idTag":"ABC123"}],"updateType":"Full"}`,
+			"localAuthorizationList[0].idTagInfo"},
+	}
+
+	for _, tc := range tests {
+		var req RequestMessage
+
+		err := json.Unmarshal([]byte(tc.data), &req)
+
+		var verr *types.ValidationError
+		if !errors.As(err, &verr) || verr.Path != tc.path {
+			t.Errorf("%s: expected failure at %s, got %v", tc.data, tc.path, err)
+		}
+	}
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`[]`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
@@ -0,0 +1,92 @@
+package sendlocallist
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidUpdateStatus indicates that an UpdateStatus is not one of the values
+// defined by OCPP 1.6J.
+var ErrInvalidUpdateStatus = errors.New("invalid update status")
+
+// UpdateStatus is the outcome of a SendLocalList.req reported by the Charge
+// Point.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.47: UpdateStatus
+type UpdateStatus string
+
+const (
+	// UpdateStatusAccepted means the Local Authorization List was updated.
+	UpdateStatusAccepted UpdateStatus = "Accepted"
+
+	// UpdateStatusFailed means the update failed, for example because the list
+	// could not be stored.
+	UpdateStatusFailed UpdateStatus = "Failed"
+
+	// UpdateStatusNotSupported means the Charge Point does not support a Local
+	// Authorization List.
+	UpdateStatusNotSupported UpdateStatus = "NotSupported"
+
+	// UpdateStatusVersionMismatch means a differential update was not applied
+	// because the version of the current list does not match.
+	UpdateStatusVersionMismatch UpdateStatus = "VersionMismatch"
+)
+
+// IsValid returns true if the UpdateStatus is one of the values defined by
+// OCPP 1.6J.
+func (u UpdateStatus) IsValid() bool {
+	switch u {
+	case UpdateStatusAccepted, UpdateStatusFailed, UpdateStatusNotSupported, UpdateStatusVersionMismatch:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the UpdateStatus.
+func (u UpdateStatus) String() string {
+	return string(u)
+}
+
+// ParseUpdateStatus converts a wire value into a UpdateStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidUpdateStatus.
+func ParseUpdateStatus(value string) (UpdateStatus, error) {
+	return types.ParseEnum[UpdateStatus](value, ErrInvalidUpdateStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidUpdateStatus if the value is not recognized.
+func (u UpdateStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(u, ErrInvalidUpdateStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidUpdateStatus if the input is not recognized.
+func (u *UpdateStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseUpdateStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (u UpdateStatus) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (u *UpdateStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, u, ParseUpdateStatus)
+}
@@ -0,0 +1,20 @@
+package sendlocallist
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestUpdateStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[UpdateStatus]{
+		Values: []UpdateStatus{
+			UpdateStatusAccepted, UpdateStatusFailed, UpdateStatusNotSupported, UpdateStatusVersionMismatch,
+		},
+		Invalid:  []string{"", "accepted", "Rejected"},
+		Sentinel: ErrInvalidUpdateStatus,
+		Parse:    ParseUpdateStatus,
+	})
+}
@@ -0,0 +1,84 @@
+package sendlocallist
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidUpdateType indicates that an UpdateType is not one of the values
+// defined by OCPP 1.6J.
+var ErrInvalidUpdateType = errors.New("invalid update type")
+
+// UpdateType tells whether a SendLocalList.req replaces the Local Authorization
+// List or changes it.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.48: UpdateType
+type UpdateType string
+
+const (
+	// UpdateTypeDifferential means the entries are added to, updated in or,
+	// without idTagInfo, removed from the current list.
+	UpdateTypeDifferential UpdateType = "Differential"
+
+	// UpdateTypeFull means the entries replace the current list.
+	UpdateTypeFull UpdateType = "Full"
+)
+
+// IsValid returns true if the UpdateType is one of the values defined by
+// OCPP 1.6J.
+func (u UpdateType) IsValid() bool {
+	switch u {
+	case UpdateTypeDifferential, UpdateTypeFull:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the UpdateType.
+func (u UpdateType) String() string {
+	return string(u)
+}
+
+// ParseUpdateType converts a wire value into a UpdateType. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidUpdateType.
+func ParseUpdateType(value string) (UpdateType, error) {
+	return types.ParseEnum[UpdateType](value, ErrInvalidUpdateType)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidUpdateType if the value is not recognized.
+func (u UpdateType) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(u, ErrInvalidUpdateType)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidUpdateType if the input is not recognized.
+func (u *UpdateType) UnmarshalText(text []byte) error {
+	parsed, err := ParseUpdateType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (u UpdateType) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (u *UpdateType) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, u, ParseUpdateType)
+}
@@ -0,0 +1,20 @@
+package sendlocallist
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestUpdateType(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[UpdateType]{
+		Values: []UpdateType{
+			UpdateTypeDifferential, UpdateTypeFull,
+		},
+		Invalid:  []string{"", "full", "Partial"},
+		Sentinel: ErrInvalidUpdateType,
+		Parse:    ParseUpdateType,
+	})
+}
@@ -0,0 +1,88 @@
+package setchargingprofile
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidChargingProfileStatus indicates that a ChargingProfileStatus is not
+// one of the values defined by OCPP 1.6J.
+var ErrInvalidChargingProfileStatus = errors.New("invalid charging profile status")
+
+// ChargingProfileStatus is the outcome of a SetChargingProfile.req reported by
+// the Charge Point.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.11: ChargingProfileStatus
+type ChargingProfileStatus string
+
+const (
+	// ChargingProfileStatusAccepted means the charging profile was installed.
+	ChargingProfileStatusAccepted ChargingProfileStatus = "Accepted"
+
+	// ChargingProfileStatusRejected means the charging profile was not installed,
+	// for example because it refers to a transaction that is not running.
+	ChargingProfileStatusRejected ChargingProfileStatus = "Rejected"
+
+	// ChargingProfileStatusNotSupported means the Charge Point does not support
+	// charging profiles.
+	ChargingProfileStatusNotSupported ChargingProfileStatus = "NotSupported"
+)
+
+// IsValid returns true if the ChargingProfileStatus is one of the values defined
+// by OCPP 1.6J.
+func (c ChargingProfileStatus) IsValid() bool {
+	switch c {
+	case ChargingProfileStatusAccepted, ChargingProfileStatusRejected, ChargingProfileStatusNotSupported:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ChargingProfileStatus.
+func (c ChargingProfileStatus) String() string {
+	return string(c)
+}
+
+// ParseChargingProfileStatus converts a wire value into a ChargingProfileStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidChargingProfileStatus.
+func ParseChargingProfileStatus(value string) (ChargingProfileStatus, error) {
+	return types.ParseEnum[ChargingProfileStatus](value, ErrInvalidChargingProfileStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidChargingProfileStatus if the value is not recognized.
+func (c ChargingProfileStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(c, ErrInvalidChargingProfileStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidChargingProfileStatus if the input is not recognized.
+func (c *ChargingProfileStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseChargingProfileStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (c ChargingProfileStatus) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (c *ChargingProfileStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, c, ParseChargingProfileStatus)
+}
@@ -0,0 +1,20 @@
+package setchargingprofile
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestChargingProfileStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[ChargingProfileStatus]{
+		Values: []ChargingProfileStatus{
+			ChargingProfileStatusAccepted, ChargingProfileStatusRejected, ChargingProfileStatusNotSupported,
+		},
+		Invalid:  []string{"", "accepted", "Unknown"},
+		Sentinel: ErrInvalidChargingProfileStatus,
+		Parse:    ParseChargingProfileStatus,
+	})
+}
@@ -0,0 +1,23 @@
+// Package setchargingprofile models the OCPP 1.6J SetChargingProfile message pair.
+//
+// A Central System sends a SetChargingProfile.req to install a charging profile on
+// a connector, or on the Charge Point as a whole when connectorId is 0. A profile
+// with the same chargingProfileId, or with the same stackLevel and purpose, replaces
+// the one already installed.
+//
+// The Charge Point answers with a SetChargingProfile.conf telling whether the
+// profile was installed.
+//
+// Besides the structural rules of types.ChargingProfileType, the request is
+// validated against the purpose of its profile: connectorId must be 0 or greater, a
+// ChargePointMaxProfile can only be set on connector 0, and a TxProfile applies to
+// a running transaction, so it needs a transactionId and a connector other than 0.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.16: Set Charging Profile
+//   - OCPP 1.6J, Section 6.43 / 6.44: SetChargingProfile.req / SetChargingProfile.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/setchargingprofile"
+package setchargingprofile
@@ -0,0 +1,48 @@
+package setchargingprofile_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/setchargingprofile"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleRequest() {
+	period, _ := types.ChargingSchedulePeriod(0, 32)
+	schedule, _ := types.ChargingSchedule(types.ChargingRateUnitTypeA, period)
+
+	profile, err := types.ChargingProfile(1, 0, types.ChargingProfilePurposeTypeChargePointMaxProfile,
+		types.ChargingProfileKindTypeRelative, schedule)
+	if err != nil {
+		log.Fatalf("failed to construct charging profile: %v", err)
+	}
+
+	req, err := setchargingprofile.Request(0, profile)
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"connectorId":0,"csChargingProfiles":{"chargingProfileId":1,"stackLevel":0,"chargingProfilePurpose":"ChargePointMaxProfile","chargingProfileKind":"Relative","chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":32}]}}}
+}
+
+func ExampleConfirmationMessage_UnmarshalJSON() {
+	var conf setchargingprofile.ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{"status":"NotSupported"}`), &conf); err != nil {
+		log.Fatalf("failed to decode confirmation: %v", err)
+	}
+
+	if conf.Status == setchargingprofile.ChargingProfileStatusNotSupported {
+		fmt.Println("smart charging is not supported")
+	}
+	// Output:
+	// smart charging is not supported
+}
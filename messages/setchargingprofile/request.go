@@ -0,0 +1,196 @@
+package setchargingprofile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// Constraint names reported when the charging profile of a SetChargingProfile.req
+// does not fit its connector.
+const (
+	// ConstraintChargePointMaxProfile is reported when a ChargePointMaxProfile is
+	// set on a connector other than 0.
+	ConstraintChargePointMaxProfile = "chargePointMaxProfile"
+
+	// ConstraintTxProfile is reported when a TxProfile has no transactionId or is
+	// set on connector 0.
+	ConstraintTxProfile = "txProfile"
+)
+
+var (
+	// ErrInvalidConnectorId indicates that the connectorId of a
+	// SetChargingProfile.req is negative or does not fit the purpose of the profile.
+	ErrInvalidConnectorId = errors.New("invalid connectorId")
+
+	// ErrMissingTransactionId indicates that a TxProfile sent with a
+	// SetChargingProfile.req has no transactionId.
+	ErrMissingTransactionId = errors.New("TxProfile needs a transactionId")
+)
+
+// RequestMessage represents the OCPP 1.6J SetChargingProfile.req message.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.43: SetChargingProfile.req
+type RequestMessage struct {
+	// ConnectorId is the connector the profile applies to, or 0 for the Charge
+	// Point as a whole.
+	ConnectorId int
+
+	// CsChargingProfiles is the charging profile to install.
+	CsChargingProfiles types.ChargingProfileType
+}
+
+// Request constructs a new RequestMessage installing profile on the given connector.
+//
+// It returns an error if the profile is not valid or does not fit the connector.
+//
+// Example usage:
+//
+//	req, err := setchargingprofile.Request(0, profile)
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(connectorId int, profile types.ChargingProfileType) (RequestMessage, error) {
+	req := RequestMessage{ConnectorId: connectorId, CsChargingProfiles: profile}
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	return types.Join(
+		connectorIdError(r.ConnectorId),
+		types.Field("csChargingProfiles", r.CsChargingProfiles),
+		purposeError(r.ConnectorId, r.CsChargingProfiles),
+	)
+}
+
+// connectorIdError reports a negative connectorId.
+func connectorIdError(connectorId int) error {
+	if connectorId < 0 {
+		return types.MinimumError("connectorId", connectorId, 0, ErrInvalidConnectorId)
+	}
+
+	return nil
+}
+
+// purposeError reports a profile whose purpose does not fit connectorId: a
+// ChargePointMaxProfile on a connector, and a TxProfile without transactionId or on
+// connector 0. Negative connector ids are reported by connectorIdError.
+func purposeError(connectorId int, profile types.ChargingProfileType) error {
+	switch profile.ChargingProfilePurpose {
+	case types.ChargingProfilePurposeTypeChargePointMaxProfile:
+		if connectorId > 0 {
+			return &types.ValidationError{
+				Path:       "connectorId",
+				Constraint: ConstraintChargePointMaxProfile,
+				Value:      connectorId,
+				Err:        fmt.Errorf("%w: a ChargePointMaxProfile applies to connector 0", ErrInvalidConnectorId),
+			}
+		}
+	case types.ChargingProfilePurposeTypeTxProfile:
+		var errs []error
+
+		if connectorId == 0 {
+			errs = append(errs, &types.ValidationError{
+				Path:       "connectorId",
+				Constraint: ConstraintTxProfile,
+				Value:      connectorId,
+				Err:        fmt.Errorf("%w: a TxProfile applies to a connector with a transaction", ErrInvalidConnectorId),
+			})
+		}
+
+		if profile.TransactionId == nil {
+			errs = append(errs, &types.ValidationError{
+				Path:       "csChargingProfiles.transactionId",
+				Constraint: ConstraintTxProfile,
+				Value:      nil,
+				Err:        ErrMissingTransactionId,
+			})
+		}
+
+		return types.Join(errs...)
+	case types.ChargingProfilePurposeTypeTxDefaultProfile:
+		// A TxDefaultProfile fits the Charge Point as well as every connector.
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+func (r RequestMessage) String() string {
+	return "SetChargingProfile.req{connectorId=" + strconv.Itoa(r.ConnectorId) +
+		", csChargingProfiles=" + r.CsChargingProfiles.String() + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of SetChargingProfile.req.
+type requestPayload struct {
+	ConnectorId        *int            `json:"connectorId"`
+	CsChargingProfiles json.RawMessage `json:"csChargingProfiles"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J SetChargingProfile.req
+// payload. The message is validated first, so an invalid RequestMessage is never
+// encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	profile, err := json.Marshal(r.CsChargingProfiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(requestPayload{ConnectorId: &r.ConnectorId, CsChargingProfiles: profile})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J SetChargingProfile.req payload into the
+// RequestMessage.
+//
+// Every field, including the fit between the connector and the purpose of the
+// profile, is validated while decoding and all failures are reported together, so
+// a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	connectorId, connectorIdErr := types.RequiredValue("connectorId", payload.ConnectorId)
+	profile, profileErr := types.DecodeRequired[types.ChargingProfileType]("csChargingProfiles", payload.CsChargingProfiles)
+
+	if connectorIdErr == nil {
+		connectorIdErr = connectorIdError(connectorId)
+	}
+
+	if connectorIdErr == nil && profileErr == nil {
+		profileErr = purposeError(connectorId, profile)
+	}
+
+	if err := types.Join(connectorIdErr, profileErr); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{ConnectorId: connectorId, CsChargingProfiles: profile}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package setchargingprofile
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// chargingProfile returns a valid relative profile of 16 A with the given purpose.
+func chargingProfile(t *testing.T, purpose types.ChargingProfilePurposeType) types.ChargingProfileType {
+	t.Helper()
+
+	period, _ := types.ChargingSchedulePeriod(0, 16)
+	schedule, _ := types.ChargingSchedule(types.ChargingRateUnitTypeA, period)
+
+	profile, err := types.ChargingProfile(1, 0, purpose, types.ChargingProfileKindTypeRelative, schedule)
+	if err != nil {
+		t.Fatalf("unexpected error creating charging profile: %v", err)
+	}
+
+	return profile
+}
+
+func TestSetChargingProfileRequestValid(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request(0, chargingProfile(t, types.ChargingProfilePurposeTypeChargePointMaxProfile))
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	want := "SetChargingProfile.req{connectorId=0, csChargingProfiles={chargingProfileId=1, stackLevel=0, " +
+		"chargingProfilePurpose=ChargePointMaxProfile, chargingProfileKind=Relative, " +
+		"chargingSchedule={chargingRateUnit=A, chargingSchedulePeriod=[{startPeriod=0, limit=16}]}}}"
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+
+	tx := chargingProfile(t, types.ChargingProfilePurposeTypeTxProfile)
+	transactionId := 42
+	tx.TransactionId = &transactionId
+
+	if _, err := Request(1, tx); err != nil {
+		t.Errorf("expected TxProfile on connector 1 to be valid, got %v", err)
+	}
+
+	for _, connectorId := range []int{0, 2} {
+		if _, err := Request(connectorId, chargingProfile(t, types.ChargingProfilePurposeTypeTxDefaultProfile)); err != nil {
+			t.Errorf("expected TxDefaultProfile on connector %d to be valid, got %v", connectorId, err)
+		}
+	}
+}
+
+func TestSetChargingProfileRequestInvalid(t *testing.T) {
+	t.Parallel()
+
+	var verr *types.ValidationError
+
+	_, err := Request(-1, chargingProfile(t, types.ChargingProfilePurposeTypeTxDefaultProfile))
+	if !errors.Is(err, ErrInvalidConnectorId) || !errors.As(err, &verr) || verr.Constraint != "minimum=0" {
+		t.Errorf("expected negative connectorId failure, got %v", err)
+	}
+
+	_, err = Request(1, chargingProfile(t, types.ChargingProfilePurposeTypeChargePointMaxProfile))
+	if !errors.As(err, &verr) || verr.Path != "connectorId" || verr.Constraint != ConstraintChargePointMaxProfile {
+		t.Errorf("expected ChargePointMaxProfile failure, got %v", err)
+	}
+
+	profile := chargingProfile(t, types.ChargingProfilePurposeTypeTxDefaultProfile)
+	profile.StackLevel = -1
+
+	if _, err := Request(0, profile); !errors.Is(err, types.ErrInvalidStackLevel) {
+		t.Errorf("expected ErrInvalidStackLevel, got %v", err)
+	}
+}
+
+func TestSetChargingProfileRequestTxProfile(t *testing.T) {
+	t.Parallel()
+
+	req := RequestMessage{ConnectorId: 0, CsChargingProfiles: chargingProfile(t, types.ChargingProfilePurposeTypeTxProfile)}
+
+	err := req.ValidateAll()
+	if !errors.Is(err, ErrInvalidConnectorId) || !errors.Is(err, ErrMissingTransactionId) {
+		t.Fatalf("expected connectorId and transactionId failures, got %v", err)
+	}
+
+	var verr *types.ValidationError
+	if err := req.Validate(); !errors.As(err, &verr) || verr.Path != "connectorId" || verr.Constraint != ConstraintTxProfile {
+		t.Errorf("expected TxProfile failure at connectorId, got %v", err)
+	}
+
+	req.ConnectorId = 1
+	if err := req.Validate(); !errors.As(err, &verr) || verr.Path != "csChargingProfiles.transactionId" {
+		t.Errorf("expected TxProfile failure at csChargingProfiles.transactionId, got %v", err)
+	}
+}
+
+func TestSetChargingProfileRequestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"connectorId":1,"csChargingProfiles":{"chargingProfileId":7,"transactionId":42,"stackLevel":1,` +
+		`"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+		`"chargingSchedule":{"chargingRateUnit":"W","chargingSchedulePeriod":[{"startPeriod":0,"limit":11000},` +
+		`{"startPeriod":3600,"limit":7400,"numberPhases":1}]}}}`
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatalf("unexpected error unmarshaling request: %v", err)
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestSetChargingProfileRequestUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	txProfile := `{"chargingProfileId":7,"stackLevel":1,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+		`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}`
+	maxProfile := `{"chargingProfileId":7,"stackLevel":1,"chargingProfilePurpose":"ChargePointMaxProfile",` +
+		`"chargingProfileKind":"Relative",` +
+		`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}`
+
+	tests := []struct {
+		data string
+		path string
+	}{
+		{`{"csChargingProfiles":` + maxProfile + `}`, "connectorId"},
+		{`{"connectorId":-1,"csChargingProfiles":` + maxProfile + `}`, "connectorId"},
+		{`{"connectorId":1}`, "csChargingProfiles"},
+		{`{"connectorId":1,"csChargingProfiles":` + maxProfile + `}`, "connectorId"},
+		{`{"connectorId":1,"csChargingProfiles":` + txProfile + `}`, "csChargingProfiles.transactionId"},
+		{`{"connectorId":0,"csChargingProfiles":{"stackLevel":1}}`, "csChargingProfiles.chargingProfileId"},
+	}
+
+	for _, tc := range tests {
+		var req RequestMessage
+
+		err := json.Unmarshal([]byte(tc.data), &req)
+
+		var verr *types.ValidationError
+		if !errors.As(err, &verr) || verr.Path != tc.path {
+			t.Errorf("%s: expected failure at %s, got %v", tc.data, tc.path, err)
+		}
+	}
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`[]`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+
+	if _, err := json.Marshal(RequestMessage{}); err == nil {
+		t.Error("expected error marshaling invalid request, got nil")
+	}
+}
@@ -0,0 +1,105 @@
+package starttransaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConfirmationMessage represents the OCPP 1.6J StartTransaction.conf message.
+//
+// This message is returned by the Central System in response to a
+// StartTransaction.req. It carries the transaction id assigned by the Central
+// System and tells the Charge Point whether the idTag is authorized to charge.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.46: StartTransaction.conf
+type ConfirmationMessage struct {
+	// IdTagInfo contains information about the authorization status, expiry and
+	// parent id of the idTag of the request.
+	IdTagInfo types.IdTagInfoType
+
+	// TransactionId is the transaction id supplied by the Central System.
+	TransactionId int
+}
+
+// Confirmation constructs a new ConfirmationMessage.
+//
+// It returns an error if the IdTagInfo is not valid.
+func Confirmation(idTagInfo types.IdTagInfoType, transactionId int) (ConfirmationMessage, error) {
+	conf := ConfirmationMessage{IdTagInfo: idTagInfo, TransactionId: transactionId}
+	if err := conf.Validate(); err != nil {
+		return ConfirmationMessage{}, err
+	}
+
+	return conf, nil
+}
+
+// Validate performs internal validation on the ConfirmationMessage and returns the
+// first failure.
+func (m ConfirmationMessage) Validate() error {
+	if err := types.FirstError(m.ValidateAll()); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the ConfirmationMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (m ConfirmationMessage) ValidateAll() error {
+	return types.Field("idTagInfo", m.IdTagInfo)
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+func (m ConfirmationMessage) String() string {
+	return "StartTransaction.conf{idTagInfo=" + m.IdTagInfo.String() +
+		", transactionId=" + strconv.Itoa(m.TransactionId) + "}"
+}
+
+// confirmationPayload is the OCPP 1.6J wire representation of StartTransaction.conf.
+type confirmationPayload struct {
+	IdTagInfo     json.RawMessage `json:"idTagInfo"`
+	TransactionId *int            `json:"transactionId"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J StartTransaction.conf
+// payload. The message is validated first, so an invalid ConfirmationMessage is
+// never encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	idTagInfo, err := json.Marshal(m.IdTagInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(confirmationPayload{IdTagInfo: idTagInfo, TransactionId: &m.TransactionId})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J StartTransaction.conf payload into the
+// ConfirmationMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded ConfirmationMessage is always valid.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	idTagInfo, idTagInfoErr := types.DecodeRequired[types.IdTagInfoType]("idTagInfo", payload.IdTagInfo)
+	transactionId, transactionIdErr := types.RequiredValue("transactionId", payload.TransactionId)
+
+	if err := types.Join(idTagInfoErr, transactionIdErr); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	*m = ConfirmationMessage{IdTagInfo: idTagInfo, TransactionId: transactionId}
+
+	return nil
+}
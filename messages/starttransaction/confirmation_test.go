@@ -0,0 +1,94 @@
+package starttransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestStartTransactionConfirmationValid(t *testing.T) {
+	t.Parallel()
+
+	info, _ := types.IdTagInfo(types.Accepted)
+
+	conf, err := Confirmation(info, 42)
+	if err != nil {
+		t.Fatalf("unexpected error constructing ConfirmationMessage: %v", err)
+	}
+
+	want := "StartTransaction.conf{idTagInfo={status=Accepted}, transactionId=42}"
+	if conf.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, conf.String())
+	}
+}
+
+func TestStartTransactionConfirmationInvalid(t *testing.T) {
+	t.Parallel()
+
+	info := types.IdTagInfoType{Status: "Unknown", ExpiryDate: nil, ParentIdTag: nil}
+
+	_, err := Confirmation(info, 42)
+	if !errors.Is(err, types.ErrInvalidAuthorizationStatus) {
+		t.Errorf("expected ErrInvalidAuthorizationStatus, got %v", err)
+	}
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "idTagInfo.status" {
+		t.Errorf("expected failure at idTagInfo.status, got %v", err)
+	}
+}
+
+func TestStartTransactionConfirmationJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"idTagInfo":{"status":"ConcurrentTx","parentIdTag":"GROUP"},"transactionId":7}`
+
+	var conf ConfirmationMessage
+	if err := json.Unmarshal([]byte(data), &conf); err != nil {
+		t.Fatalf("unexpected error unmarshaling confirmation: %v", err)
+	}
+
+	out, err := json.Marshal(conf)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling confirmation: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestStartTransactionConfirmationUnmarshalJSONMissingFields(t *testing.T) {
+	t.Parallel()
+
+	var conf ConfirmationMessage
+
+	err := json.Unmarshal([]byte(`{}`), &conf)
+	if !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"idTagInfo":{"status":"Accepted"}}`), &conf); err == nil {
+		t.Error("expected error for missing transactionId, got nil")
+	}
+}
+
+func TestStartTransactionConfirmationUnmarshalJSONMalformed(t *testing.T) {
+	t.Parallel()
+
+	var conf ConfirmationMessage
+	if err := json.Unmarshal([]byte(`[]`), &conf); err == nil {
+		t.Error("expected error for malformed confirmation, got nil")
+	}
+}
+
+func TestStartTransactionConfirmationMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	conf := ConfirmationMessage{IdTagInfo: types.IdTagInfoType{Status: "", ExpiryDate: nil, ParentIdTag: nil}, TransactionId: 1}
+	if _, err := json.Marshal(conf); err == nil {
+		t.Error("expected error marshaling invalid confirmation, got nil")
+	}
+}
@@ -0,0 +1,25 @@
+// Package starttransaction models the OCPP 1.6J StartTransaction message pair.
+//
+// A Charge Point sends a StartTransaction.req to the Central System when a
+// transaction has started on one of its connectors. The request reports the
+// connector, the identifier that started the transaction, the meter value at the
+// start (in Wh), the time of the start and, if the transaction ends a reservation,
+// the reservation id.
+//
+// The Central System answers with a StartTransaction.conf that contains the
+// transaction id it assigned and the authorization status of the idTag. The
+// Charge Point must use this transaction id in all subsequent messages about the
+// transaction, most notably StopTransaction.req and MeterValues.req.
+//
+// Since the meter values and transaction ids are used to bill the session, both
+// messages are validated beyond the JSON schema: connectorId must be greater than
+// 0 and meterStart must not be negative.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 4.8: Start Transaction
+//   - OCPP 1.6J, Section 6.45 / 6.46: StartTransaction.req / StartTransaction.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/starttransaction"
+package starttransaction
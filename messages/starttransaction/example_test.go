@@ -0,0 +1,45 @@
+package starttransaction_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/messages/starttransaction"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleRequest() {
+	started := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	req, err := starttransaction.Request(1, "ABC123", 2500, started)
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"connectorId":1,"idTag":"ABC123","meterStart":2500,"timestamp":"2025-01-02T03:04:05.000Z"}
+}
+
+func ExampleConfirmation() {
+	info, err := types.IdTagInfo(types.Accepted)
+	if err != nil {
+		log.Fatalf("invalid idTagInfo: %v", err)
+	}
+
+	conf, err := starttransaction.Confirmation(info, 42)
+	if err != nil {
+		log.Fatalf("failed to construct confirmation: %v", err)
+	}
+
+	fmt.Println(conf)
+	// Output:
+	// StartTransaction.conf{idTagInfo={status=Accepted}, transactionId=42}
+}
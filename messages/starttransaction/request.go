@@ -0,0 +1,213 @@
+package starttransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+var (
+	// ErrInvalidConnectorId indicates that the connectorId of a StartTransaction.req
+	// is not greater than 0. Transactions always take place on a physical connector.
+	ErrInvalidConnectorId = errors.New("invalid connectorId")
+
+	// ErrInvalidMeterStart indicates that the meterStart of a StartTransaction.req is
+	// negative.
+	ErrInvalidMeterStart = errors.New("invalid meterStart")
+)
+
+// RequestMessage represents the OCPP 1.6J StartTransaction.req message.
+//
+// This message is sent by a Charge Point to the Central System to report that a
+// transaction has started. Only ReservationId is optional; it is nil when the
+// transaction does not end a reservation.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.45: StartTransaction.req
+type RequestMessage struct {
+	// ConnectorId identifies the connector on which the transaction was started. It
+	// must be greater than 0.
+	ConnectorId int
+
+	// IdTag is the identifier for which the transaction was started (IdToken, required).
+	IdTag types.IdTokenType
+
+	// MeterStart is the meter value in Wh of the connector at the start of the
+	// transaction. It must not be negative.
+	MeterStart int
+
+	// ReservationId is the id of the reservation that terminates as a result of this
+	// transaction.
+	ReservationId *int
+
+	// Timestamp is the date and time on which the transaction was started.
+	Timestamp types.DateTimeType
+}
+
+// Request constructs a new RequestMessage from its required fields.
+//
+// ReservationId is nil and can be set on the returned message afterwards. An error
+// is returned if the connector id is not greater than 0, the meter value is
+// negative, the idTag is not a valid IdToken or the timestamp is the zero time.
+//
+// Example usage:
+//
+//	req, err := starttransaction.Request(1, "ABC123", 2500, time.Now())
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(connectorId int, idTag string, meterStart int, timestamp time.Time) (RequestMessage, error) {
+	token, tokenErr := types.Required("idTag", &idTag, types.IdToken)
+	start, startErr := types.DateTime(timestamp)
+
+	if err := types.Join(tokenErr, types.WithPath("timestamp", startErr)); err != nil {
+		return RequestMessage{}, fmt.Errorf("failed to create RequestMessage: %w", err)
+	}
+
+	req := RequestMessage{
+		ConnectorId:   connectorId,
+		IdTag:         token,
+		MeterStart:    meterStart,
+		ReservationId: nil,
+		Timestamp:     start,
+	}
+
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	return types.Join(
+		connectorIdError(r.ConnectorId),
+		types.Field("idTag", r.IdTag),
+		meterStartError(r.MeterStart),
+		types.Field("timestamp", r.Timestamp),
+	)
+}
+
+// connectorIdError reports a connectorId that is not greater than 0.
+func connectorIdError(connectorId int) error {
+	if connectorId < 1 {
+		return types.MinimumError("connectorId", connectorId, 1, ErrInvalidConnectorId)
+	}
+
+	return nil
+}
+
+// meterStartError reports a negative meterStart.
+func meterStartError(meterStart int) error {
+	if meterStart < 0 {
+		return types.MinimumError("meterStart", meterStart, 0, ErrInvalidMeterStart)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// ReservationId is only included when set.
+func (r RequestMessage) String() string {
+	fields := []string{
+		"connectorId=" + strconv.Itoa(r.ConnectorId),
+		"idTag=" + r.IdTag.String(),
+		"meterStart=" + strconv.Itoa(r.MeterStart),
+	}
+
+	if r.ReservationId != nil {
+		fields = append(fields, "reservationId="+strconv.Itoa(*r.ReservationId))
+	}
+
+	fields = append(fields, "timestamp="+r.Timestamp.String())
+
+	return "StartTransaction.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of StartTransaction.req.
+type requestPayload struct {
+	ConnectorId   *int    `json:"connectorId"`
+	IdTag         *string `json:"idTag"`
+	MeterStart    *int    `json:"meterStart"`
+	ReservationId *int    `json:"reservationId,omitempty"`
+	Timestamp     *string `json:"timestamp"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J StartTransaction.req payload.
+//
+// An unset ReservationId is omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	idTag := r.IdTag.String()
+	timestamp := r.Timestamp.String()
+
+	return json.Marshal(requestPayload{
+		ConnectorId:   &r.ConnectorId,
+		IdTag:         &idTag,
+		MeterStart:    &r.MeterStart,
+		ReservationId: r.ReservationId,
+		Timestamp:     &timestamp,
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J StartTransaction.req payload into the
+// RequestMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	connectorId, connectorIdErr := types.RequiredValue("connectorId", payload.ConnectorId)
+	idTag, idTagErr := types.Required("idTag", payload.IdTag, types.IdToken)
+	meterStart, meterStartErr := types.RequiredValue("meterStart", payload.MeterStart)
+	timestamp, timestampErr := types.Required("timestamp", payload.Timestamp, types.ParseDateTime)
+
+	if connectorIdErr == nil {
+		connectorIdErr = connectorIdError(connectorId)
+	}
+
+	if meterStartErr == nil {
+		meterStartErr = meterStartError(meterStart)
+	}
+
+	err := types.Join(connectorIdErr, idTagErr, meterStartErr, timestampErr)
+	if err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{
+		ConnectorId:   connectorId,
+		IdTag:         idTag,
+		MeterStart:    meterStart,
+		ReservationId: payload.ReservationId,
+		Timestamp:     timestamp,
+	}
+
+	return nil
+}
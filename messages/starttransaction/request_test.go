@@ -0,0 +1,172 @@
+package starttransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+var startTime = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func TestStartTransactionRequestValid(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request(1, "ABC123", 2500, startTime)
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	reservationId := 9
+	req.ReservationId = &reservationId
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	want := "StartTransaction.req{connectorId=1, idTag=ABC123, meterStart=2500, reservationId=9, " +
+		"timestamp=2025-01-02T03:04:05.000Z}"
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+}
+
+func TestStartTransactionRequestInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		connectorId int
+		idTag       string
+		meterStart  int
+		timestamp   time.Time
+		sentinel    error
+	}{
+		{"connector zero", 0, "ABC123", 0, startTime, ErrInvalidConnectorId},
+		{"negative meterStart", 1, "ABC123", -1, startTime, ErrInvalidMeterStart},
+		{"empty idTag", 1, "", 0, startTime, types.ErrEmptyValueNotAllowed},
+		{"zero timestamp", 1, "ABC123", 0, time.Time{}, types.ErrInvalidDateTime},
+	}
+
+	for _, tc := range tests {
+		_, err := Request(tc.connectorId, tc.idTag, tc.meterStart, tc.timestamp)
+		if !errors.Is(err, tc.sentinel) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.sentinel, err)
+		}
+	}
+}
+
+func TestStartTransactionRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	req := RequestMessage{
+		ConnectorId:   -1,
+		IdTag:         types.IdTokenType{},
+		MeterStart:    -10,
+		ReservationId: nil,
+		Timestamp:     types.DateTimeType{},
+	}
+
+	err := req.ValidateAll()
+	for _, sentinel := range []error{ErrInvalidConnectorId, types.ErrEmptyValueNotAllowed, ErrInvalidMeterStart, types.ErrInvalidDateTime} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	var verr *types.ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Path != "connectorId" || verr.Constraint != "minimum=1" {
+		t.Errorf("expected first failure at connectorId, got %v", req.Validate())
+	}
+}
+
+func TestStartTransactionRequestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"connectorId":2,"idTag":"ABC123","meterStart":0,"reservationId":9,"timestamp":"2025-01-02T03:04:05.000Z"}`
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatalf("unexpected error unmarshaling request: %v", err)
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestStartTransactionRequestMarshalJSONOmitsReservationId(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request(1, "ABC123", 100, startTime)
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	want := `{"connectorId":1,"idTag":"ABC123","meterStart":100,"timestamp":"2025-01-02T03:04:05.000Z"}`
+	if string(out) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, out)
+	}
+}
+
+func TestStartTransactionRequestMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request(1, "ABC123", 100, startTime)
+	req.MeterStart = -1
+
+	if _, err := json.Marshal(req); !errors.Is(err, ErrInvalidMeterStart) {
+		t.Errorf("expected ErrInvalidMeterStart, got %v", err)
+	}
+}
+
+func TestStartTransactionRequestUnmarshalJSONReportsAllFailures(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"connectorId":0,"idTag":"ABC1234567890123456789","meterStart":-5}`), &req)
+	for _, sentinel := range []error{
+		ErrInvalidConnectorId, types.ErrExceedsMaxLength, ErrInvalidMeterStart, types.ErrMissingRequiredField,
+	} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+}
+
+func TestStartTransactionRequestUnmarshalJSONMissingFields(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"idTag":"ABC123","timestamp":"2025-01-02T03:04:05Z"}`), &req)
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "connectorId" || verr.Constraint != types.ConstraintRequired {
+		t.Errorf("expected missing connectorId, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "meterStart: ") {
+		t.Errorf("expected missing meterStart, got %v", err)
+	}
+}
+
+func TestStartTransactionRequestUnmarshalJSONMalformed(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"connectorId":"1"}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
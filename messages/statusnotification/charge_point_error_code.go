@@ -0,0 +1,148 @@
+package statusnotification
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidChargePointErrorCode indicates that a ChargePointErrorCode is not one of
+// the values defined by OCPP 1.6J.
+var ErrInvalidChargePointErrorCode = errors.New("invalid charge point error code")
+
+// ChargePointErrorCode is the error reported by a Charge Point in the `errorCode`
+// field of StatusNotification.req.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.6: ChargePointErrorCode
+type ChargePointErrorCode string
+
+const (
+	// ChargePointErrorCodeConnectorLockFailure indicates a failure to lock or unlock
+	// the connector.
+	ChargePointErrorCodeConnectorLockFailure ChargePointErrorCode = "ConnectorLockFailure"
+
+	// ChargePointErrorCodeEVCommunicationError indicates a communication failure with
+	// the vehicle. This is not a real error: the connector should go to
+	// SuspendedEVSE rather than Faulted.
+	ChargePointErrorCodeEVCommunicationError ChargePointErrorCode = "EVCommunicationError"
+
+	// ChargePointErrorCodeGroundFailure indicates that the ground fault circuit
+	// interrupter has been activated.
+	ChargePointErrorCodeGroundFailure ChargePointErrorCode = "GroundFailure"
+
+	// ChargePointErrorCodeHighTemperature indicates that the temperature inside the
+	// Charge Point is too high.
+	ChargePointErrorCodeHighTemperature ChargePointErrorCode = "HighTemperature"
+
+	// ChargePointErrorCodeInternalError indicates an error in an internal hardware or
+	// software component.
+	ChargePointErrorCodeInternalError ChargePointErrorCode = "InternalError"
+
+	// ChargePointErrorCodeLocalListConflict indicates that the authorization
+	// information received from the Central System conflicts with the local
+	// authorization list.
+	ChargePointErrorCodeLocalListConflict ChargePointErrorCode = "LocalListConflict"
+
+	// ChargePointErrorCodeNoError indicates that there is no error to report.
+	ChargePointErrorCodeNoError ChargePointErrorCode = "NoError"
+
+	// ChargePointErrorCodeOtherError indicates any other error. More information is
+	// given in vendorErrorCode.
+	ChargePointErrorCodeOtherError ChargePointErrorCode = "OtherError"
+
+	// ChargePointErrorCodeOverCurrentFailure indicates that the over current
+	// protection device has tripped.
+	ChargePointErrorCodeOverCurrentFailure ChargePointErrorCode = "OverCurrentFailure"
+
+	// ChargePointErrorCodeOverVoltage indicates that the voltage has risen above an
+	// acceptable level.
+	ChargePointErrorCodeOverVoltage ChargePointErrorCode = "OverVoltage"
+
+	// ChargePointErrorCodePowerMeterFailure indicates a failure to read the
+	// electrical, energy or power meter.
+	ChargePointErrorCodePowerMeterFailure ChargePointErrorCode = "PowerMeterFailure"
+
+	// ChargePointErrorCodePowerSwitchFailure indicates a failure to control the power
+	// switch.
+	ChargePointErrorCodePowerSwitchFailure ChargePointErrorCode = "PowerSwitchFailure"
+
+	// ChargePointErrorCodeReaderFailure indicates a failure of the idTag reader.
+	ChargePointErrorCodeReaderFailure ChargePointErrorCode = "ReaderFailure"
+
+	// ChargePointErrorCodeResetFailure indicates that the Charge Point was unable to
+	// perform a reset.
+	ChargePointErrorCodeResetFailure ChargePointErrorCode = "ResetFailure"
+
+	// ChargePointErrorCodeUnderVoltage indicates that the voltage has dropped below an
+	// acceptable level.
+	ChargePointErrorCodeUnderVoltage ChargePointErrorCode = "UnderVoltage"
+
+	// ChargePointErrorCodeWeakSignal indicates that the wireless communication device
+	// reports a weak signal.
+	ChargePointErrorCodeWeakSignal ChargePointErrorCode = "WeakSignal"
+)
+
+// IsValid returns true if the ChargePointErrorCode is one of the values defined by
+// OCPP 1.6J.
+func (c ChargePointErrorCode) IsValid() bool {
+	switch c {
+	case ChargePointErrorCodeConnectorLockFailure, ChargePointErrorCodeEVCommunicationError,
+		ChargePointErrorCodeGroundFailure, ChargePointErrorCodeHighTemperature,
+		ChargePointErrorCodeInternalError, ChargePointErrorCodeLocalListConflict,
+		ChargePointErrorCodeNoError, ChargePointErrorCodeOtherError,
+		ChargePointErrorCodeOverCurrentFailure, ChargePointErrorCodeOverVoltage,
+		ChargePointErrorCodePowerMeterFailure, ChargePointErrorCodePowerSwitchFailure,
+		ChargePointErrorCodeReaderFailure, ChargePointErrorCodeResetFailure,
+		ChargePointErrorCodeUnderVoltage, ChargePointErrorCodeWeakSignal:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ChargePointErrorCode.
+func (c ChargePointErrorCode) String() string {
+	return string(c)
+}
+
+// ParseChargePointErrorCode converts a wire value into a ChargePointErrorCode. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidChargePointErrorCode.
+func ParseChargePointErrorCode(value string) (ChargePointErrorCode, error) {
+	return types.ParseEnum[ChargePointErrorCode](value, ErrInvalidChargePointErrorCode)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidChargePointErrorCode if the value is not recognized.
+func (c ChargePointErrorCode) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(c, ErrInvalidChargePointErrorCode)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidChargePointErrorCode if the input is not recognized.
+func (c *ChargePointErrorCode) UnmarshalText(text []byte) error {
+	parsed, err := ParseChargePointErrorCode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (c ChargePointErrorCode) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (c *ChargePointErrorCode) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, c, ParseChargePointErrorCode)
+}
@@ -0,0 +1,26 @@
+package statusnotification
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestChargePointErrorCode(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[ChargePointErrorCode]{
+		Values: []ChargePointErrorCode{
+			ChargePointErrorCodeConnectorLockFailure, ChargePointErrorCodeEVCommunicationError,
+			ChargePointErrorCodeGroundFailure, ChargePointErrorCodeHighTemperature,
+			ChargePointErrorCodeInternalError, ChargePointErrorCodeLocalListConflict,
+			ChargePointErrorCodeNoError, ChargePointErrorCodeOtherError, ChargePointErrorCodeOverCurrentFailure,
+			ChargePointErrorCodeOverVoltage, ChargePointErrorCodePowerMeterFailure,
+			ChargePointErrorCodePowerSwitchFailure, ChargePointErrorCodeReaderFailure,
+			ChargePointErrorCodeResetFailure, ChargePointErrorCodeUnderVoltage, ChargePointErrorCodeWeakSignal,
+		},
+		Invalid:  []string{"", "noError", "Overheated"},
+		Sentinel: ErrInvalidChargePointErrorCode,
+		Parse:    ParseChargePointErrorCode,
+	})
+}
@@ -0,0 +1,129 @@
+package statusnotification
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidChargePointStatus indicates that a ChargePointStatus is not one of the
+// values defined by OCPP 1.6J.
+var ErrInvalidChargePointStatus = errors.New("invalid charge point status")
+
+// ChargePointStatus is the status of a connector, or of the whole Charge Point for
+// connector 0, reported in the `status` field of StatusNotification.req.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.7: ChargePointStatus
+type ChargePointStatus string
+
+const (
+	// ChargePointStatusAvailable indicates that the connector is available for a new
+	// user.
+	ChargePointStatusAvailable ChargePointStatus = "Available"
+
+	// ChargePointStatusPreparing indicates that the connector is no longer available
+	// for a new user but no transaction has started yet, for example after an idTag
+	// was presented or a cable was plugged in.
+	ChargePointStatusPreparing ChargePointStatus = "Preparing"
+
+	// ChargePointStatusCharging indicates that the contactor of the connector is
+	// closed, allowing the vehicle to charge.
+	ChargePointStatusCharging ChargePointStatus = "Charging"
+
+	// ChargePointStatusSuspendedEVSE indicates that the EV is connected but the EVSE
+	// is not offering energy, for example because of a charging profile.
+	ChargePointStatusSuspendedEVSE ChargePointStatus = "SuspendedEVSE"
+
+	// ChargePointStatusSuspendedEV indicates that the EVSE is offering energy but the
+	// EV is not taking any.
+	ChargePointStatusSuspendedEV ChargePointStatus = "SuspendedEV"
+
+	// ChargePointStatusFinishing indicates that the transaction has stopped but the
+	// connector is not yet available for a new user, for example because the cable
+	// is still plugged in.
+	ChargePointStatusFinishing ChargePointStatus = "Finishing"
+
+	// ChargePointStatusReserved indicates that the connector is reserved as a result
+	// of a ReserveNow.req.
+	ChargePointStatusReserved ChargePointStatus = "Reserved"
+
+	// ChargePointStatusUnavailable indicates that the connector is not available for
+	// charging, for example after a ChangeAvailability.req.
+	ChargePointStatusUnavailable ChargePointStatus = "Unavailable"
+
+	// ChargePointStatusFaulted indicates that the Charge Point or connector has
+	// reported an error and is not available for energy delivery.
+	ChargePointStatusFaulted ChargePointStatus = "Faulted"
+)
+
+// IsValid returns true if the ChargePointStatus is one of the values defined by
+// OCPP 1.6J.
+func (s ChargePointStatus) IsValid() bool {
+	switch s {
+	case ChargePointStatusAvailable, ChargePointStatusPreparing, ChargePointStatusCharging,
+		ChargePointStatusSuspendedEVSE, ChargePointStatusSuspendedEV, ChargePointStatusFinishing,
+		ChargePointStatusReserved, ChargePointStatusUnavailable, ChargePointStatusFaulted:
+		return true
+	default:
+		return false
+	}
+}
+
+// AllowedOnConnectorZero returns true if the status may be reported for connector 0,
+// that is for the Charge Point as a whole. Only Available, Unavailable and Faulted
+// are allowed.
+func (s ChargePointStatus) AllowedOnConnectorZero() bool {
+	switch s {
+	case ChargePointStatusAvailable, ChargePointStatusUnavailable, ChargePointStatusFaulted:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ChargePointStatus.
+func (s ChargePointStatus) String() string {
+	return string(s)
+}
+
+// ParseChargePointStatus converts a wire value into a ChargePointStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidChargePointStatus.
+func ParseChargePointStatus(value string) (ChargePointStatus, error) {
+	return types.ParseEnum[ChargePointStatus](value, ErrInvalidChargePointStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidChargePointStatus if the value is not recognized.
+func (s ChargePointStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(s, ErrInvalidChargePointStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidChargePointStatus if the input is not recognized.
+func (s *ChargePointStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseChargePointStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (s ChargePointStatus) MarshalJSON() ([]byte, error) {
+	text, err := s.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (s *ChargePointStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, s, ParseChargePointStatus)
+}
@@ -0,0 +1,32 @@
+package statusnotification
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestChargePointStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[ChargePointStatus]{
+		Values: []ChargePointStatus{
+			ChargePointStatusAvailable, ChargePointStatusPreparing, ChargePointStatusCharging,
+			ChargePointStatusSuspendedEVSE, ChargePointStatusSuspendedEV, ChargePointStatusFinishing,
+			ChargePointStatusReserved, ChargePointStatusUnavailable, ChargePointStatusFaulted,
+		},
+		Invalid:  []string{"", "available", "Occupied"},
+		Sentinel: ErrInvalidChargePointStatus,
+		Parse:    ParseChargePointStatus,
+	})
+}
+
+func TestInvalidChargePointStatusNotAllowedOnConnectorZero(t *testing.T) {
+	t.Parallel()
+
+	for _, status := range []ChargePointStatus{"", "available", "Occupied"} {
+		if status.AllowedOnConnectorZero() {
+			t.Errorf("expected AllowedOnConnectorZero() to return false for %q", status)
+		}
+	}
+}
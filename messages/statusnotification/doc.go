@@ -0,0 +1,22 @@
+// Package statusnotification models the OCPP 1.6J StatusNotification message pair.
+//
+// A Charge Point sends a StatusNotification.req to the Central System to report a
+// change of status or an error, either of one of its connectors or, with
+// connectorId 0, of the Charge Point as a whole. The request carries the new
+// ChargePointStatus, a ChargePointErrorCode (NoError when there is nothing to
+// report) and optional free-form and vendor-specific error information.
+//
+// Connector 0 can only be Available, Unavailable or Faulted: the other statuses
+// describe a transaction or reservation, which always take place on a physical
+// connector. Requests violating this rule are rejected.
+//
+// The Central System answers with an empty StatusNotification.conf.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 4.9: Status Notification
+//   - OCPP 1.6J, Section 6.47 / 6.48: StatusNotification.req / StatusNotification.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/statusnotification"
+package statusnotification
@@ -0,0 +1,40 @@
+package statusnotification_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/statusnotification"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleRequest() {
+	req, err := statusnotification.Request(1, statusnotification.ChargePointErrorCodeNoError,
+		statusnotification.ChargePointStatusCharging)
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"connectorId":1,"errorCode":"NoError","status":"Charging"}
+}
+
+func ExampleRequest_connectorZero() {
+	_, err := statusnotification.Request(0, statusnotification.ChargePointErrorCodeNoError,
+		statusnotification.ChargePointStatusReserved)
+
+	var verr *types.ValidationError
+	if errors.As(err, &verr) {
+		fmt.Println(verr.Path, verr.Constraint, verr.Value)
+	}
+	// Output:
+	// status connectorZeroStatus Reserved
+}
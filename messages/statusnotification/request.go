@@ -0,0 +1,271 @@
+package statusnotification
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConstraintConnectorZeroStatus is the ValidationError constraint reported when a
+// status that is not allowed on connector 0 is reported for connector 0.
+const ConstraintConnectorZeroStatus = "connectorZeroStatus"
+
+var (
+	// ErrInvalidConnectorId indicates that the connectorId of a StatusNotification.req
+	// is negative.
+	ErrInvalidConnectorId = errors.New("invalid connectorId")
+
+	// ErrStatusNotAllowedOnConnectorZero indicates that a StatusNotification.req
+	// reports a status other than Available, Unavailable or Faulted for connector 0.
+	ErrStatusNotAllowedOnConnectorZero = errors.New("status not allowed on connector 0")
+)
+
+// RequestMessage represents the OCPP 1.6J StatusNotification.req message.
+//
+// This message is sent by a Charge Point to report the status of a connector, or of
+// the whole Charge Point when ConnectorId is 0. ConnectorId, ErrorCode and Status
+// are required; all other fields are optional and are nil when not reported.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.47: StatusNotification.req
+type RequestMessage struct {
+	// ConnectorId identifies the connector the status is reported for, or 0 for the
+	// Charge Point as a whole. It must not be negative.
+	ConnectorId int
+
+	// ErrorCode is the error reported by the Charge Point, NoError if there is none.
+	ErrorCode ChargePointErrorCode
+
+	// Info is additional free-format information related to the error (CiString[50]).
+	Info *types.CiString50Type
+
+	// Status is the current status of the connector or Charge Point.
+	Status ChargePointStatus
+
+	// Timestamp is the time for which the status is reported. When nil, the time of
+	// receipt of the message is used.
+	Timestamp *types.DateTimeType
+
+	// VendorId identifies the vendor-specific implementation (CiString[255]).
+	VendorId *types.CiString255Type
+
+	// VendorErrorCode is the vendor-specific error code (CiString[50]).
+	VendorErrorCode *types.CiString50Type
+}
+
+// Request constructs a new RequestMessage from its required fields.
+//
+// Optional fields can be set on the returned message afterwards. An error is
+// returned if the connector id is negative, the error code or status is unknown,
+// or the status is not allowed on connector 0.
+//
+// Example usage:
+//
+//	req, err := statusnotification.Request(1, statusnotification.ChargePointErrorCodeNoError,
+//	    statusnotification.ChargePointStatusCharging)
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(connectorId int, errorCode ChargePointErrorCode, status ChargePointStatus) (RequestMessage, error) {
+	req := RequestMessage{
+		ConnectorId:     connectorId,
+		ErrorCode:       errorCode,
+		Info:            nil,
+		Status:          status,
+		Timestamp:       nil,
+		VendorId:        nil,
+		VendorErrorCode: nil,
+	}
+
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage, and the rules between them,
+// and returns all failures joined with errors.Join, each as a
+// *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	errs := []error{connectorIdError(r.ConnectorId)}
+
+	if !r.ErrorCode.IsValid() {
+		errs = append(errs, types.EnumError("errorCode", r.ErrorCode, ErrInvalidChargePointErrorCode))
+	}
+
+	errs = append(errs, types.OptionalField("info", r.Info))
+
+	if !r.Status.IsValid() {
+		errs = append(errs, types.EnumError("status", r.Status, ErrInvalidChargePointStatus))
+	}
+
+	errs = append(errs,
+		connectorStatusError(r.ConnectorId, r.Status),
+		types.OptionalField("timestamp", r.Timestamp),
+		types.OptionalField("vendorId", r.VendorId),
+		types.OptionalField("vendorErrorCode", r.VendorErrorCode),
+	)
+
+	return types.Join(errs...)
+}
+
+// connectorIdError reports a negative connectorId.
+func connectorIdError(connectorId int) error {
+	if connectorId < 0 {
+		return types.MinimumError("connectorId", connectorId, 0, ErrInvalidConnectorId)
+	}
+
+	return nil
+}
+
+// connectorStatusError reports a valid status that is not allowed on connector 0.
+func connectorStatusError(connectorId int, status ChargePointStatus) error {
+	if connectorId != 0 || !status.IsValid() || status.AllowedOnConnectorZero() {
+		return nil
+	}
+
+	return &types.ValidationError{
+		Path:       "status",
+		Constraint: ConstraintConnectorZeroStatus,
+		Value:      status,
+		Err:        fmt.Errorf("%w: %s", ErrStatusNotAllowedOnConnectorZero, status),
+	}
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// Optional fields are only included when set.
+func (r RequestMessage) String() string {
+	fields := []string{
+		"connectorId=" + strconv.Itoa(r.ConnectorId),
+		"errorCode=" + r.ErrorCode.String(),
+	}
+
+	if r.Info != nil {
+		fields = append(fields, "info="+r.Info.String())
+	}
+
+	fields = append(fields, "status="+r.Status.String())
+
+	optional := []struct {
+		name  string
+		value fmt.Stringer
+		isSet bool
+	}{
+		{"timestamp", r.Timestamp, r.Timestamp != nil},
+		{"vendorId", r.VendorId, r.VendorId != nil},
+		{"vendorErrorCode", r.VendorErrorCode, r.VendorErrorCode != nil},
+	}
+
+	for _, field := range optional {
+		if field.isSet {
+			fields = append(fields, field.name+"="+field.value.String())
+		}
+	}
+
+	return "StatusNotification.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of StatusNotification.req.
+type requestPayload struct {
+	ConnectorId     *int    `json:"connectorId"`
+	ErrorCode       *string `json:"errorCode"`
+	Info            *string `json:"info,omitempty"`
+	Status          *string `json:"status"`
+	Timestamp       *string `json:"timestamp,omitempty"`
+	VendorId        *string `json:"vendorId,omitempty"`
+	VendorErrorCode *string `json:"vendorErrorCode,omitempty"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J StatusNotification.req
+// payload.
+//
+// Unset optional fields are omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	errorCode := r.ErrorCode.String()
+	status := r.Status.String()
+
+	return json.Marshal(requestPayload{
+		ConnectorId:     &r.ConnectorId,
+		ErrorCode:       &errorCode,
+		Info:            types.OptionalString(r.Info),
+		Status:          &status,
+		Timestamp:       types.OptionalString(r.Timestamp),
+		VendorId:        types.OptionalString(r.VendorId),
+		VendorErrorCode: types.OptionalString(r.VendorErrorCode),
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J StatusNotification.req payload into the
+// RequestMessage.
+//
+// Every field, and the rules between them, is validated while decoding and all
+// failures are reported together, so a successfully decoded RequestMessage is
+// always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	connectorId, connectorIdErr := types.RequiredValue("connectorId", payload.ConnectorId)
+	errorCode, errorCodeErr := types.Required("errorCode", payload.ErrorCode, ParseChargePointErrorCode)
+	info, infoErr := types.Optional("info", payload.Info, types.CiString50)
+	status, statusErr := types.Required("status", payload.Status, ParseChargePointStatus)
+	timestamp, timestampErr := types.Optional("timestamp", payload.Timestamp, types.ParseDateTime)
+	vendorId, vendorIdErr := types.Optional("vendorId", payload.VendorId, types.CiString255)
+	vendorErrorCode, vendorErrorCodeErr := types.Optional("vendorErrorCode", payload.VendorErrorCode, types.CiString50)
+
+	if connectorIdErr == nil {
+		connectorIdErr = connectorIdError(connectorId)
+	}
+
+	if connectorIdErr == nil && statusErr == nil {
+		statusErr = connectorStatusError(connectorId, status)
+	}
+
+	err := types.Join(
+		connectorIdErr,
+		errorCodeErr,
+		infoErr,
+		statusErr,
+		timestampErr,
+		vendorIdErr,
+		vendorErrorCodeErr,
+	)
+	if err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{
+		ConnectorId:     connectorId,
+		ErrorCode:       errorCode,
+		Info:            info,
+		Status:          status,
+		Timestamp:       timestamp,
+		VendorId:        vendorId,
+		VendorErrorCode: vendorErrorCode,
+	}
+
+	return nil
+}
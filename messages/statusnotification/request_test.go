@@ -0,0 +1,204 @@
+package statusnotification
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestStatusNotificationRequestValid(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request(1, ChargePointErrorCodeOtherError, ChargePointStatusFaulted)
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	info, _ := types.CiString50("ground fault")
+	timestamp, _ := types.ParseDateTime("2025-01-02T03:04:05Z")
+	vendorId, _ := types.CiString255("com.example")
+	req.Info = &info
+	req.Timestamp = &timestamp
+	req.VendorId = &vendorId
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	want := "StatusNotification.req{connectorId=1, errorCode=OtherError, info=ground fault, status=Faulted, " +
+		"timestamp=2025-01-02T03:04:05.000Z, vendorId=com.example}"
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+}
+
+func TestStatusNotificationRequestConnectorZero(t *testing.T) {
+	t.Parallel()
+
+	for _, status := range []ChargePointStatus{
+		ChargePointStatusAvailable, ChargePointStatusUnavailable, ChargePointStatusFaulted,
+	} {
+		if _, err := Request(0, ChargePointErrorCodeNoError, status); err != nil {
+			t.Errorf("%s: expected status to be allowed on connector 0, got %v", status, err)
+		}
+	}
+
+	for _, status := range []ChargePointStatus{
+		ChargePointStatusPreparing, ChargePointStatusCharging, ChargePointStatusSuspendedEVSE,
+		ChargePointStatusSuspendedEV, ChargePointStatusFinishing, ChargePointStatusReserved,
+	} {
+		_, err := Request(0, ChargePointErrorCodeNoError, status)
+
+		var verr *types.ValidationError
+		if !errors.As(err, &verr) || verr.Path != "status" || verr.Constraint != ConstraintConnectorZeroStatus {
+			t.Errorf("%s: expected connector 0 status failure, got %v", status, err)
+		}
+
+		if !errors.Is(err, ErrStatusNotAllowedOnConnectorZero) {
+			t.Errorf("%s: expected ErrStatusNotAllowedOnConnectorZero, got %v", status, err)
+		}
+	}
+}
+
+func TestStatusNotificationRequestInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		connectorId int
+		errorCode   ChargePointErrorCode
+		status      ChargePointStatus
+		sentinel    error
+	}{
+		{"negative connectorId", -1, ChargePointErrorCodeNoError, ChargePointStatusAvailable, ErrInvalidConnectorId},
+		{"unknown errorCode", 1, "Overheated", ChargePointStatusAvailable, ErrInvalidChargePointErrorCode},
+		{"unknown status", 1, ChargePointErrorCodeNoError, "Occupied", ErrInvalidChargePointStatus},
+	}
+
+	for _, tc := range tests {
+		_, err := Request(tc.connectorId, tc.errorCode, tc.status)
+		if !errors.Is(err, tc.sentinel) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.sentinel, err)
+		}
+	}
+}
+
+func TestStatusNotificationRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	info := types.CiString50Type{}
+	req := RequestMessage{
+		ConnectorId:     -1,
+		ErrorCode:       "",
+		Info:            &info,
+		Status:          "",
+		Timestamp:       &types.DateTimeType{},
+		VendorId:        nil,
+		VendorErrorCode: nil,
+	}
+
+	err := req.ValidateAll()
+	for _, sentinel := range []error{
+		ErrInvalidConnectorId, ErrInvalidChargePointErrorCode, types.ErrEmptyValueNotAllowed,
+		ErrInvalidChargePointStatus, types.ErrInvalidDateTime,
+	} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	if errors.Is(err, ErrStatusNotAllowedOnConnectorZero) {
+		t.Errorf("did not expect a connector 0 failure for an invalid status, got %v", err)
+	}
+}
+
+func TestStatusNotificationRequestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"connectorId":2,"errorCode":"OtherError","info":"ground fault","status":"Faulted",` +
+		`"timestamp":"2025-01-02T03:04:05.000Z","vendorId":"com.example","vendorErrorCode":"E42"}`
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatalf("unexpected error unmarshaling request: %v", err)
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestStatusNotificationRequestMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request(0, ChargePointErrorCodeNoError, ChargePointStatusAvailable)
+	req.Status = ChargePointStatusReserved
+
+	if _, err := json.Marshal(req); !errors.Is(err, ErrStatusNotAllowedOnConnectorZero) {
+		t.Errorf("expected ErrStatusNotAllowedOnConnectorZero, got %v", err)
+	}
+}
+
+func TestStatusNotificationRequestUnmarshalJSONReportsAllFailures(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"connectorId":-1,"errorCode":"Overheated","status":"Occupied",`+
+		`"vendorErrorCode":"E12345678901234567890123456789012345678901234567890"}`), &req)
+	for _, sentinel := range []error{
+		ErrInvalidConnectorId, ErrInvalidChargePointErrorCode, ErrInvalidChargePointStatus, types.ErrExceedsMaxLength,
+	} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+}
+
+func TestStatusNotificationRequestUnmarshalJSONConnectorZero(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"connectorId":0,"errorCode":"NoError","status":"Reserved"}`), &req)
+	if !errors.Is(err, ErrStatusNotAllowedOnConnectorZero) {
+		t.Errorf("expected ErrStatusNotAllowedOnConnectorZero, got %v", err)
+	}
+
+	if req != (RequestMessage{}) {
+		t.Errorf("expected the request to be left untouched, got %v", req)
+	}
+}
+
+func TestStatusNotificationRequestUnmarshalJSONMissingFields(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"connectorId":1}`), &req)
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "errorCode" || verr.Constraint != types.ConstraintRequired {
+		t.Errorf("expected missing errorCode, got %v", err)
+	}
+
+	if !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+}
+
+func TestStatusNotificationRequestUnmarshalJSONMalformed(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"connectorId":"1"}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
@@ -0,0 +1,103 @@
+package stoptransaction
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ConfirmationMessage represents the OCPP 1.6J StopTransaction.conf message.
+//
+// This message is returned by the Central System in response to a
+// StopTransaction.req. IdTagInfo is nil unless the request contained an idTag.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.50: StopTransaction.conf
+type ConfirmationMessage struct {
+	// IdTagInfo contains information about the authorization status, expiry and
+	// parent id of the idTag of the request.
+	IdTagInfo *types.IdTagInfoType
+}
+
+// Confirmation constructs a new ConfirmationMessage.
+//
+// The idTagInfo may be nil when the StopTransaction.req did not contain an idTag.
+// It returns an error if a non-nil IdTagInfo is not valid.
+func Confirmation(idTagInfo *types.IdTagInfoType) (ConfirmationMessage, error) {
+	conf := ConfirmationMessage{IdTagInfo: idTagInfo}
+	if err := conf.Validate(); err != nil {
+		return ConfirmationMessage{}, err
+	}
+
+	return conf, nil
+}
+
+// Validate performs internal validation on the ConfirmationMessage and returns the
+// first failure.
+func (m ConfirmationMessage) Validate() error {
+	if err := types.FirstError(m.ValidateAll()); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the ConfirmationMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (m ConfirmationMessage) ValidateAll() error {
+	return types.OptionalField("idTagInfo", m.IdTagInfo)
+}
+
+// String returns a human-readable representation of the ConfirmationMessage.
+//
+// IdTagInfo is only included when set.
+func (m ConfirmationMessage) String() string {
+	if m.IdTagInfo == nil {
+		return "StopTransaction.conf{}"
+	}
+
+	return "StopTransaction.conf{idTagInfo=" + m.IdTagInfo.String() + "}"
+}
+
+// confirmationPayload is the OCPP 1.6J wire representation of StopTransaction.conf.
+type confirmationPayload struct {
+	IdTagInfo json.RawMessage `json:"idTagInfo,omitempty"`
+}
+
+// MarshalJSON encodes the ConfirmationMessage as an OCPP 1.6J StopTransaction.conf
+// payload. An unset IdTagInfo is omitted. The message is validated first, so an
+// invalid ConfirmationMessage is never encoded.
+func (m ConfirmationMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	idTagInfo, err := types.EncodeOptional(m.IdTagInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(confirmationPayload{IdTagInfo: idTagInfo})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J StopTransaction.conf payload into the
+// ConfirmationMessage.
+//
+// The idTagInfo is validated while decoding, so a successfully decoded
+// ConfirmationMessage is always valid.
+func (m *ConfirmationMessage) UnmarshalJSON(data []byte) error {
+	var payload confirmationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	idTagInfo, err := types.DecodeOptional[types.IdTagInfoType]("idTagInfo", payload.IdTagInfo)
+	if err != nil {
+		return fmt.Errorf("ConfirmationMessage validation failed: %w", err)
+	}
+
+	*m = ConfirmationMessage{IdTagInfo: idTagInfo}
+
+	return nil
+}
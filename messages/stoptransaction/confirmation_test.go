@@ -0,0 +1,79 @@
+package stoptransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestStopTransactionConfirmationValid(t *testing.T) {
+	t.Parallel()
+
+	conf, err := Confirmation(nil)
+	if err != nil {
+		t.Fatalf("unexpected error constructing ConfirmationMessage: %v", err)
+	}
+
+	if conf.String() != "StopTransaction.conf{}" {
+		t.Errorf("unexpected String() output: %s", conf.String())
+	}
+
+	info, _ := types.IdTagInfo(types.Expired)
+
+	conf, err = Confirmation(&info)
+	if err != nil {
+		t.Fatalf("unexpected error constructing ConfirmationMessage: %v", err)
+	}
+
+	if conf.String() != "StopTransaction.conf{idTagInfo={status=Expired}}" {
+		t.Errorf("unexpected String() output: %s", conf.String())
+	}
+}
+
+func TestStopTransactionConfirmationInvalid(t *testing.T) {
+	t.Parallel()
+
+	info := types.IdTagInfoType{Status: "Gone", ExpiryDate: nil, ParentIdTag: nil}
+
+	_, err := Confirmation(&info)
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "idTagInfo.status" {
+		t.Errorf("expected failure at idTagInfo.status, got %v", err)
+	}
+}
+
+func TestStopTransactionConfirmationJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{}`, `{"idTagInfo":{"status":"Expired"}}`} {
+		var conf ConfirmationMessage
+		if err := json.Unmarshal([]byte(data), &conf); err != nil {
+			t.Fatalf("unexpected error unmarshaling %s: %v", data, err)
+		}
+
+		out, err := json.Marshal(conf)
+		if err != nil {
+			t.Fatalf("unexpected error marshaling confirmation: %v", err)
+		}
+
+		if string(out) != data {
+			t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+		}
+	}
+}
+
+func TestStopTransactionConfirmationUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var conf ConfirmationMessage
+	if err := json.Unmarshal([]byte(`{"idTagInfo":{"status":"Gone"}}`), &conf); !errors.Is(err, types.ErrInvalidAuthorizationStatus) {
+		t.Errorf("expected ErrInvalidAuthorizationStatus, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"idTagInfo":[]}`), &conf); err == nil {
+		t.Error("expected error for malformed idTagInfo, got nil")
+	}
+}
@@ -0,0 +1,23 @@
+// Package stoptransaction models the OCPP 1.6J StopTransaction message pair.
+//
+// A Charge Point sends a StopTransaction.req to the Central System when a
+// transaction has stopped. The request reports the transaction id received in the
+// StartTransaction.conf, the meter value at the end of the transaction (in Wh), the
+// time of the stop and, optionally, the identifier that stopped the transaction,
+// the reason for stopping and meter values sampled during the transaction
+// (transactionData). When no reason is given, Local is assumed.
+//
+// The Central System answers with a StopTransaction.conf that contains the
+// authorization status of the idTag, when one was given in the request.
+//
+// Since the meter values are used to bill the session, meterStop must not be
+// negative, and every sampled value of the transaction data is validated.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 4.10: Stop Transaction
+//   - OCPP 1.6J, Section 6.49 / 6.50: StopTransaction.req / StopTransaction.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/stoptransaction"
+package stoptransaction
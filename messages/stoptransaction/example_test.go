@@ -0,0 +1,31 @@
+package stoptransaction_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/messages/stoptransaction"
+)
+
+func ExampleRequest() {
+	stopped := time.Date(2025, 1, 2, 4, 4, 5, 0, time.UTC)
+
+	req, err := stoptransaction.Request(4200, stopped, 42)
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	reason := stoptransaction.ReasonEVDisconnected
+	req.Reason = &reason
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// {"meterStop":4200,"timestamp":"2025-01-02T04:04:05.000Z","transactionId":42,"reason":"EVDisconnected"}
+}
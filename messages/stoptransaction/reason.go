@@ -0,0 +1,117 @@
+package stoptransaction
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidReason indicates that a Reason is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidReason = errors.New("invalid reason")
+
+// Reason is the reason for stopping a transaction, reported in the `reason` field of
+// StopTransaction.req.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.36: Reason
+type Reason string
+
+const (
+	// ReasonEmergencyStop indicates that the emergency stop button was used.
+	ReasonEmergencyStop Reason = "EmergencyStop"
+
+	// ReasonEVDisconnected indicates that the cable was disconnected from the EV
+	// while StopTransactionOnEVSideDisconnect is true.
+	ReasonEVDisconnected Reason = "EVDisconnected"
+
+	// ReasonHardReset indicates a hard reset command was received.
+	ReasonHardReset Reason = "HardReset"
+
+	// ReasonLocal indicates that the transaction was stopped locally on request of
+	// the user, for example by presenting an idTag. This is the default when no
+	// reason is given.
+	ReasonLocal Reason = "Local"
+
+	// ReasonOther indicates any other reason.
+	ReasonOther Reason = "Other"
+
+	// ReasonPowerLoss indicates a complete loss of power.
+	ReasonPowerLoss Reason = "PowerLoss"
+
+	// ReasonReboot indicates a locally initiated reset or reboot of the Charge Point.
+	ReasonReboot Reason = "Reboot"
+
+	// ReasonRemote indicates that the transaction was stopped remotely, for example
+	// with a RemoteStopTransaction.req.
+	ReasonRemote Reason = "Remote"
+
+	// ReasonSoftReset indicates a soft reset command was received.
+	ReasonSoftReset Reason = "SoftReset"
+
+	// ReasonUnlockCommand indicates that the Central System sent an
+	// UnlockConnector.req.
+	ReasonUnlockCommand Reason = "UnlockCommand"
+
+	// ReasonDeAuthorized indicates that the transaction was stopped because the idTag
+	// was deauthorized in a StartTransaction.conf.
+	ReasonDeAuthorized Reason = "DeAuthorized"
+)
+
+// IsValid returns true if the Reason is one of the values defined by OCPP 1.6J.
+func (r Reason) IsValid() bool {
+	switch r {
+	case ReasonEmergencyStop, ReasonEVDisconnected, ReasonHardReset, ReasonLocal,
+		ReasonOther, ReasonPowerLoss, ReasonReboot, ReasonRemote, ReasonSoftReset,
+		ReasonUnlockCommand, ReasonDeAuthorized:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the Reason.
+func (r Reason) String() string {
+	return string(r)
+}
+
+// ParseReason converts a wire value into a Reason. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidReason.
+func ParseReason(value string) (Reason, error) {
+	return types.ParseEnum[Reason](value, ErrInvalidReason)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidReason if the value is not recognized.
+func (r Reason) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(r, ErrInvalidReason)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidReason if the input is not recognized.
+func (r *Reason) UnmarshalText(text []byte) error {
+	parsed, err := ParseReason(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (r Reason) MarshalJSON() ([]byte, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (r *Reason) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, r, ParseReason)
+}
@@ -0,0 +1,22 @@
+package stoptransaction
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestReason(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[Reason]{
+		Values: []Reason{
+			ReasonEmergencyStop, ReasonEVDisconnected, ReasonHardReset, ReasonLocal, ReasonOther,
+			ReasonPowerLoss, ReasonReboot, ReasonRemote, ReasonSoftReset, ReasonUnlockCommand,
+			ReasonDeAuthorized,
+		},
+		Invalid:  []string{"", "local", "Deauthorized"},
+		Sentinel: ErrInvalidReason,
+		Parse:    ParseReason,
+	})
+}
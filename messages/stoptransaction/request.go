@@ -0,0 +1,222 @@
+package stoptransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidMeterStop indicates that the meterStop of a StopTransaction.req is
+// negative.
+var ErrInvalidMeterStop = errors.New("invalid meterStop")
+
+// RequestMessage represents the OCPP 1.6J StopTransaction.req message.
+//
+// This message is sent by a Charge Point to the Central System to report that a
+// transaction has stopped. IdTag and Reason are nil and TransactionData is empty
+// when they are not reported.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.49: StopTransaction.req
+type RequestMessage struct {
+	// IdTag is the identifier which requested to stop the charging. It is optional
+	// because a Charge Point may stop a transaction without an identifier, for
+	// example after a reset.
+	IdTag *types.IdTokenType
+
+	// MeterStop is the meter value in Wh of the connector at the end of the
+	// transaction. It must not be negative.
+	MeterStop int
+
+	// Timestamp is the date and time on which the transaction was stopped.
+	Timestamp types.DateTimeType
+
+	// TransactionId is the transaction id as received in the StartTransaction.conf.
+	TransactionId int
+
+	// Reason is the reason why the transaction was stopped. A nil Reason means Local.
+	Reason *Reason
+
+	// TransactionData contains the meter values relevant for billing purposes.
+	TransactionData []types.MeterValueType
+}
+
+// Request constructs a new RequestMessage from its required fields.
+//
+// Optional fields are unset and can be set on the returned message afterwards. An
+// error is returned if the meter value is negative or the timestamp is the zero
+// time.
+//
+// Example usage:
+//
+//	req, err := stoptransaction.Request(4200, time.Now(), 42)
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+//	reason := stoptransaction.ReasonEVDisconnected
+//	req.Reason = &reason
+func Request(meterStop int, timestamp time.Time, transactionId int) (RequestMessage, error) {
+	stop, err := types.DateTime(timestamp)
+	if err != nil {
+		return RequestMessage{}, fmt.Errorf("failed to create RequestMessage: %w", types.WithPath("timestamp", err))
+	}
+
+	req := RequestMessage{
+		IdTag:           nil,
+		MeterStop:       meterStop,
+		Timestamp:       stop,
+		TransactionId:   transactionId,
+		Reason:          nil,
+		TransactionData: nil,
+	}
+
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := types.FirstError(r.ValidateAll()); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns all failures
+// joined with errors.Join, each as a *types.ValidationError with its JSON path.
+func (r RequestMessage) ValidateAll() error {
+	errs := []error{
+		types.OptionalField("idTag", r.IdTag),
+		meterStopError(r.MeterStop),
+		types.Field("timestamp", r.Timestamp),
+	}
+
+	if r.Reason != nil && !r.Reason.IsValid() {
+		errs = append(errs, types.EnumError("reason", *r.Reason, ErrInvalidReason))
+	}
+
+	errs = append(errs, types.ListField("transactionData", r.TransactionData))
+
+	return types.Join(errs...)
+}
+
+// meterStopError reports a negative meterStop.
+func meterStopError(meterStop int) error {
+	if meterStop < 0 {
+		return types.MinimumError("meterStop", meterStop, 0, ErrInvalidMeterStop)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+//
+// Optional fields are only included when set.
+func (r RequestMessage) String() string {
+	var fields []string
+
+	if r.IdTag != nil {
+		fields = append(fields, "idTag="+r.IdTag.String())
+	}
+
+	fields = append(fields,
+		"meterStop="+strconv.Itoa(r.MeterStop),
+		"timestamp="+r.Timestamp.String(),
+		"transactionId="+strconv.Itoa(r.TransactionId),
+	)
+
+	if r.Reason != nil {
+		fields = append(fields, "reason="+r.Reason.String())
+	}
+
+	if len(r.TransactionData) > 0 {
+		fields = append(fields, "transactionData="+types.FormatList(r.TransactionData))
+	}
+
+	return "StopTransaction.req{" + strings.Join(fields, ", ") + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of StopTransaction.req.
+type requestPayload struct {
+	IdTag           *string           `json:"idTag,omitempty"`
+	MeterStop       *int              `json:"meterStop"`
+	Timestamp       *string           `json:"timestamp"`
+	TransactionId   *int              `json:"transactionId"`
+	Reason          *string           `json:"reason,omitempty"`
+	TransactionData []json.RawMessage `json:"transactionData,omitempty"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J StopTransaction.req payload.
+//
+// Unset optional fields are omitted. The message is validated first, so an invalid
+// RequestMessage is never encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	timestamp := r.Timestamp.String()
+
+	transactionData, err := types.EncodeList(r.TransactionData)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(requestPayload{
+		IdTag:           types.OptionalString(r.IdTag),
+		MeterStop:       &r.MeterStop,
+		Timestamp:       &timestamp,
+		TransactionId:   &r.TransactionId,
+		Reason:          types.OptionalString(r.Reason),
+		TransactionData: transactionData,
+	})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J StopTransaction.req payload into the
+// RequestMessage.
+//
+// Every field is validated while decoding and all failures are reported together,
+// so a successfully decoded RequestMessage is always valid.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	idTag, idTagErr := types.Optional("idTag", payload.IdTag, types.IdToken)
+	meterStop, meterStopErr := types.RequiredValue("meterStop", payload.MeterStop)
+	timestamp, timestampErr := types.Required("timestamp", payload.Timestamp, types.ParseDateTime)
+	transactionId, transactionIdErr := types.RequiredValue("transactionId", payload.TransactionId)
+	reason, reasonErr := types.Optional("reason", payload.Reason, ParseReason)
+	transactionData, transactionDataErr := types.DecodeList[types.MeterValueType]("transactionData", payload.TransactionData)
+
+	if meterStopErr == nil {
+		meterStopErr = meterStopError(meterStop)
+	}
+
+	err := types.Join(idTagErr, meterStopErr, timestampErr, transactionIdErr, reasonErr, transactionDataErr)
+	if err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{
+		IdTag:           idTag,
+		MeterStop:       meterStop,
+		Timestamp:       timestamp,
+		TransactionId:   transactionId,
+		Reason:          reason,
+		TransactionData: transactionData,
+	}
+
+	return nil
+}
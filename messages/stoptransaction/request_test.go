@@ -0,0 +1,176 @@
+package stoptransaction
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+var stopTime = time.Date(2025, 1, 2, 4, 4, 5, 0, time.UTC)
+
+func TestStopTransactionRequestValid(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request(1500, stopTime, 42)
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	idTag, _ := types.IdToken("ABC123")
+	reason := ReasonEVDisconnected
+	req.IdTag = &idTag
+	req.Reason = &reason
+
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	want := "StopTransaction.req{idTag=ABC123, meterStop=1500, timestamp=2025-01-02T04:04:05.000Z, " +
+		"transactionId=42, reason=EVDisconnected}"
+	if req.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, req.String())
+	}
+}
+
+func TestStopTransactionRequestInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Request(-1, stopTime, 42); !errors.Is(err, ErrInvalidMeterStop) {
+		t.Errorf("expected ErrInvalidMeterStop, got %v", err)
+	}
+
+	if _, err := Request(1500, time.Time{}, 42); !errors.Is(err, types.ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+}
+
+func TestStopTransactionRequestValidateAll(t *testing.T) {
+	t.Parallel()
+
+	reason := Reason("Unplugged")
+	unit := types.UnitOfMeasure("kWh/h")
+
+	req := RequestMessage{
+		IdTag:         &types.IdTokenType{},
+		MeterStop:     -1,
+		Timestamp:     types.DateTimeType{},
+		TransactionId: 42,
+		Reason:        &reason,
+		TransactionData: []types.MeterValueType{{
+			Timestamp: types.DateTimeType{},
+			SampledValue: []types.SampledValueType{
+				{Value: "1", Context: nil, Format: nil, Measurand: nil, Phase: nil, Location: nil, Unit: &unit},
+			},
+		}},
+	}
+
+	err := req.ValidateAll()
+	for _, sentinel := range []error{
+		types.ErrEmptyValueNotAllowed, ErrInvalidMeterStop, types.ErrInvalidDateTime, ErrInvalidReason, types.ErrInvalidUnitOfMeasure,
+	} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	var verr *types.ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Path != "idTag" {
+		t.Errorf("expected first failure at idTag, got %v", req.Validate())
+	}
+}
+
+func TestStopTransactionRequestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"idTag":"ABC123","meterStop":1500,"timestamp":"2025-01-02T04:04:05.000Z","transactionId":42,` +
+		`"reason":"Remote","transactionData":[{"timestamp":"2025-01-02T04:04:05.000Z","sampledValue":` +
+		`[{"value":"1500","context":"Transaction.End","measurand":"Energy.Active.Import.Register","unit":"Wh"}]}]}`
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatalf("unexpected error unmarshaling request: %v", err)
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestStopTransactionRequestMarshalJSONMinimal(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request(0, stopTime, 7)
+
+	out, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling request: %v", err)
+	}
+
+	want := `{"meterStop":0,"timestamp":"2025-01-02T04:04:05.000Z","transactionId":7}`
+	if string(out) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, out)
+	}
+}
+
+func TestStopTransactionRequestMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	req, _ := Request(0, stopTime, 7)
+	reason := Reason("local")
+	req.Reason = &reason
+
+	if _, err := json.Marshal(req); !errors.Is(err, ErrInvalidReason) {
+		t.Errorf("expected ErrInvalidReason, got %v", err)
+	}
+}
+
+func TestStopTransactionRequestUnmarshalJSONReportsAllFailures(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"meterStop":-3,"timestamp":"2025-01-02T04:04:05Z","reason":"Unplugged",`+
+		`"transactionData":[{"timestamp":"2025-01-02T04:04:05Z","sampledValue":[{"value":"1","measurand":"Energy"}]}]}`), &req)
+
+	for _, sentinel := range []error{ErrInvalidMeterStop, types.ErrMissingRequiredField, ErrInvalidReason, types.ErrInvalidMeasurand} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "meterStop" {
+		t.Errorf("expected first failure at meterStop, got %v", err)
+	}
+}
+
+func TestStopTransactionRequestUnmarshalJSONReportsNestedPath(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+
+	err := json.Unmarshal([]byte(`{"meterStop":1,"timestamp":"2025-01-02T04:04:05Z","transactionId":1,`+
+		`"transactionData":[{"timestamp":"2025-01-02T04:04:05Z","sampledValue":[{"value":"1"},{"value":"2","phase":"L4"}]}]}`), &req)
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "transactionData[0].sampledValue[1].phase" {
+		t.Errorf("expected failure at transactionData[0].sampledValue[1].phase, got %v", err)
+	}
+}
+
+func TestStopTransactionRequestUnmarshalJSONMalformed(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"meterStop":"1500"}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
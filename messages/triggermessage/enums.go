@@ -0,0 +1,166 @@
+// Code generated by ocppgen from TriggerMessage.json and TriggerMessageResponse.json. DO NOT EDIT.
+
+package triggermessage
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidMessageTrigger indicates that a MessageTrigger is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidMessageTrigger = errors.New("invalid message trigger")
+
+// MessageTrigger enumerates the values allowed for the requestedMessage field of TriggerMessage.req.
+type MessageTrigger string
+
+const (
+	// MessageTriggerBootNotification is the "BootNotification" value of MessageTrigger.
+	MessageTriggerBootNotification MessageTrigger = "BootNotification"
+
+	// MessageTriggerDiagnosticsStatusNotification is the "DiagnosticsStatusNotification" value of MessageTrigger.
+	MessageTriggerDiagnosticsStatusNotification MessageTrigger = "DiagnosticsStatusNotification"
+
+	// MessageTriggerFirmwareStatusNotification is the "FirmwareStatusNotification" value of MessageTrigger.
+	MessageTriggerFirmwareStatusNotification MessageTrigger = "FirmwareStatusNotification"
+
+	// MessageTriggerHeartbeat is the "Heartbeat" value of MessageTrigger.
+	MessageTriggerHeartbeat MessageTrigger = "Heartbeat"
+
+	// MessageTriggerMeterValues is the "MeterValues" value of MessageTrigger.
+	MessageTriggerMeterValues MessageTrigger = "MeterValues"
+
+	// MessageTriggerStatusNotification is the "StatusNotification" value of MessageTrigger.
+	MessageTriggerStatusNotification MessageTrigger = "StatusNotification"
+)
+
+// IsValid returns true if the MessageTrigger is one of the values defined by OCPP 1.6J.
+func (m MessageTrigger) IsValid() bool {
+	switch m {
+	case MessageTriggerBootNotification, MessageTriggerDiagnosticsStatusNotification, MessageTriggerFirmwareStatusNotification,
+		MessageTriggerHeartbeat, MessageTriggerMeterValues, MessageTriggerStatusNotification:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the MessageTrigger.
+func (m MessageTrigger) String() string {
+	return string(m)
+}
+
+// ParseMessageTrigger converts a wire value into a MessageTrigger. Unknown values are rejected with a
+// *types.ValidationError wrapping ErrInvalidMessageTrigger.
+func ParseMessageTrigger(value string) (MessageTrigger, error) {
+	return types.ParseEnum[MessageTrigger](value, ErrInvalidMessageTrigger)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with ErrInvalidMessageTrigger if the
+// value is not recognized.
+func (m MessageTrigger) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(m, ErrInvalidMessageTrigger)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with ErrInvalidMessageTrigger if
+// the input is not recognized.
+func (m *MessageTrigger) UnmarshalText(text []byte) error {
+	parsed, err := ParseMessageTrigger(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (m MessageTrigger) MarshalJSON() ([]byte, error) {
+	text, err := m.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (m *MessageTrigger) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, m, ParseMessageTrigger)
+}
+
+// ErrInvalidTriggerMessageStatus indicates that a TriggerMessageStatus is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidTriggerMessageStatus = errors.New("invalid trigger message status")
+
+// TriggerMessageStatus enumerates the values allowed for the status field of TriggerMessage.conf.
+type TriggerMessageStatus string
+
+const (
+	// TriggerMessageStatusAccepted is the "Accepted" value of TriggerMessageStatus.
+	TriggerMessageStatusAccepted TriggerMessageStatus = "Accepted"
+
+	// TriggerMessageStatusRejected is the "Rejected" value of TriggerMessageStatus.
+	TriggerMessageStatusRejected TriggerMessageStatus = "Rejected"
+
+	// TriggerMessageStatusNotImplemented is the "NotImplemented" value of TriggerMessageStatus.
+	TriggerMessageStatusNotImplemented TriggerMessageStatus = "NotImplemented"
+)
+
+// IsValid returns true if the TriggerMessageStatus is one of the values defined by OCPP 1.6J.
+func (t TriggerMessageStatus) IsValid() bool {
+	switch t {
+	case TriggerMessageStatusAccepted, TriggerMessageStatusRejected, TriggerMessageStatusNotImplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the TriggerMessageStatus.
+func (t TriggerMessageStatus) String() string {
+	return string(t)
+}
+
+// ParseTriggerMessageStatus converts a wire value into a TriggerMessageStatus. Unknown values are rejected with a
+// *types.ValidationError wrapping ErrInvalidTriggerMessageStatus.
+func ParseTriggerMessageStatus(value string) (TriggerMessageStatus, error) {
+	return types.ParseEnum[TriggerMessageStatus](value, ErrInvalidTriggerMessageStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with ErrInvalidTriggerMessageStatus if the
+// value is not recognized.
+func (t TriggerMessageStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(t, ErrInvalidTriggerMessageStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with ErrInvalidTriggerMessageStatus if
+// the input is not recognized.
+func (t *TriggerMessageStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseTriggerMessageStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (t TriggerMessageStatus) MarshalJSON() ([]byte, error) {
+	text, err := t.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (t *TriggerMessageStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, t, ParseTriggerMessageStatus)
+}
@@ -0,0 +1,18 @@
+// Package unlockconnector models the OCPP 1.6J UnlockConnector message pair.
+//
+// A Central System sends an UnlockConnector.req to ask a Charge Point to unlock one
+// of its connectors, typically to help a driver who cannot remove the cable. If a
+// transaction is in progress on the connector, the Charge Point stops it first.
+//
+// The Charge Point answers with an UnlockConnector.conf telling whether the
+// connector was unlocked. Connectors are numbered from 1, so the request is
+// validated beyond the JSON schema: connectorId must be greater than 0.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 5.18: Unlock Connector
+//   - OCPP 1.6J, Section 6.53 / 6.54: UnlockConnector.req / UnlockConnector.conf
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/messages/unlockconnector"
+package unlockconnector
@@ -0,0 +1,31 @@
+package unlockconnector_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/unlockconnector"
+)
+
+func ExampleRequest() {
+	if _, err := unlockconnector.Request(0); errors.Is(err, unlockconnector.ErrInvalidConnectorId) {
+		fmt.Println("connector 0 cannot be unlocked")
+	}
+
+	req, err := unlockconnector.Request(1)
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		log.Fatalf("failed to encode request: %v", err)
+	}
+
+	fmt.Println(string(payload))
+	// Output:
+	// connector 0 cannot be unlocked
+	// {"connectorId":1}
+}
@@ -0,0 +1,110 @@
+package unlockconnector
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidConnectorId indicates that the connectorId of an UnlockConnector.req is
+// not greater than 0.
+var ErrInvalidConnectorId = errors.New("invalid connectorId")
+
+// RequestMessage represents the OCPP 1.6J UnlockConnector.req message.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 6.53: UnlockConnector.req
+type RequestMessage struct {
+	// ConnectorId is the connector to unlock. It must be greater than 0.
+	ConnectorId int
+}
+
+// Request constructs a new RequestMessage for the given connector.
+//
+// It returns an error if connectorId is not greater than 0.
+//
+// Example usage:
+//
+//	req, err := unlockconnector.Request(1)
+//	if err != nil {
+//	    log.Fatalf("invalid request: %v", err)
+//	}
+func Request(connectorId int) (RequestMessage, error) {
+	req := RequestMessage{ConnectorId: connectorId}
+	if err := req.Validate(); err != nil {
+		return RequestMessage{}, err
+	}
+
+	return req, nil
+}
+
+// Validate performs a revalidation of the RequestMessage fields and returns the
+// first failure.
+func (r RequestMessage) Validate() error {
+	if err := r.ValidateAll(); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateAll checks every field of the RequestMessage and returns its failures as
+// *types.ValidationError values with their JSON path.
+func (r RequestMessage) ValidateAll() error {
+	return connectorIdError(r.ConnectorId)
+}
+
+// connectorIdError reports a connectorId that is not greater than 0.
+func connectorIdError(connectorId int) error {
+	if connectorId < 1 {
+		return types.MinimumError("connectorId", connectorId, 1, ErrInvalidConnectorId)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the RequestMessage.
+func (r RequestMessage) String() string {
+	return "UnlockConnector.req{connectorId=" + strconv.Itoa(r.ConnectorId) + "}"
+}
+
+// requestPayload is the OCPP 1.6J wire representation of UnlockConnector.req.
+type requestPayload struct {
+	ConnectorId *int `json:"connectorId"`
+}
+
+// MarshalJSON encodes the RequestMessage as an OCPP 1.6J UnlockConnector.req
+// payload. The message is validated first, so an invalid RequestMessage is never
+// encoded.
+func (r RequestMessage) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(requestPayload{ConnectorId: &r.ConnectorId})
+}
+
+// UnmarshalJSON decodes an OCPP 1.6J UnlockConnector.req payload into the
+// RequestMessage, validating it while decoding.
+func (r *RequestMessage) UnmarshalJSON(data []byte) error {
+	var payload requestPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	connectorId, err := types.RequiredValue("connectorId", payload.ConnectorId)
+	if err == nil {
+		err = connectorIdError(connectorId)
+	}
+
+	if err != nil {
+		return fmt.Errorf("RequestMessage validation failed: %w", err)
+	}
+
+	*r = RequestMessage{ConnectorId: connectorId}
+
+	return nil
+}
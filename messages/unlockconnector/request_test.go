@@ -0,0 +1,59 @@
+package unlockconnector
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func TestUnlockConnectorRequest(t *testing.T) {
+	t.Parallel()
+
+	req, err := Request(2)
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %v", err)
+	}
+
+	if req.String() != "UnlockConnector.req{connectorId=2}" {
+		t.Errorf("unexpected String() output: %s", req.String())
+	}
+
+	out, err := json.Marshal(req)
+	if err != nil || string(out) != `{"connectorId":2}` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	_, err = Request(0)
+
+	var verr *types.ValidationError
+	if !errors.Is(err, ErrInvalidConnectorId) || !errors.As(err, &verr) || verr.Constraint != "minimum=1" {
+		t.Errorf("expected minimum failure for connector 0, got %v", err)
+	}
+
+	if _, err := json.Marshal(RequestMessage{ConnectorId: -1}); !errors.Is(err, ErrInvalidConnectorId) {
+		t.Errorf("expected ErrInvalidConnectorId, got %v", err)
+	}
+}
+
+func TestUnlockConnectorRequestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var req RequestMessage
+	if err := json.Unmarshal([]byte(`{"connectorId":1}`), &req); err != nil || req.ConnectorId != 1 {
+		t.Errorf("unexpected request: %v, %v", req, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &req); !errors.Is(err, types.ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"connectorId":0}`), &req); !errors.Is(err, ErrInvalidConnectorId) {
+		t.Errorf("expected ErrInvalidConnectorId, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"connectorId":"1"}`), &req); err == nil {
+		t.Error("expected error for malformed request, got nil")
+	}
+}
@@ -0,0 +1,88 @@
+package unlockconnector
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidUnlockStatus indicates that a UnlockStatus is not one of the values
+// defined by OCPP 1.6J.
+var ErrInvalidUnlockStatus = errors.New("invalid unlock status")
+
+// UnlockStatus is the result of an UnlockConnector.req, reported in the status
+// field of UnlockConnector.conf.
+//
+// Specification Reference:
+// - OCPP 1.6J, Section 7.46: UnlockStatus
+type UnlockStatus string
+
+const (
+	// UnlockStatusUnlocked means that the connector has successfully been
+	// unlocked.
+	UnlockStatusUnlocked UnlockStatus = "Unlocked"
+
+	// UnlockStatusUnlockFailed means that the connector could not be unlocked.
+	UnlockStatusUnlockFailed UnlockStatus = "UnlockFailed"
+
+	// UnlockStatusNotSupported means that the connector has no locking mechanism
+	// that can be controlled.
+	UnlockStatusNotSupported UnlockStatus = "NotSupported"
+)
+
+// IsValid returns true if the UnlockStatus is one of the values
+// defined by OCPP 1.6J.
+func (u UnlockStatus) IsValid() bool {
+	switch u {
+	case UnlockStatusUnlocked, UnlockStatusUnlockFailed, UnlockStatusNotSupported:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the UnlockStatus.
+func (u UnlockStatus) String() string {
+	return string(u)
+}
+
+// ParseUnlockStatus converts a wire value into a UnlockStatus. Unknown values are rejected
+// with a *types.ValidationError wrapping ErrInvalidUnlockStatus.
+func ParseUnlockStatus(value string) (UnlockStatus, error) {
+	return types.ParseEnum[UnlockStatus](value, ErrInvalidUnlockStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidUnlockStatus if the value is not recognized.
+func (u UnlockStatus) MarshalText() ([]byte, error) {
+	return types.MarshalEnum(u, ErrInvalidUnlockStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidUnlockStatus if the input is not recognized.
+func (u *UnlockStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseUnlockStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (u UnlockStatus) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (u *UnlockStatus) UnmarshalJSON(data []byte) error {
+	return types.UnmarshalJSONEnum(data, u, ParseUnlockStatus)
+}
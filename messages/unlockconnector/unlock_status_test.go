@@ -0,0 +1,20 @@
+package unlockconnector
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/internal/enumtest"
+)
+
+func TestUnlockStatus(t *testing.T) {
+	t.Parallel()
+
+	enumtest.Run(t, enumtest.Case[UnlockStatus]{
+		Values: []UnlockStatus{
+			UnlockStatusUnlocked, UnlockStatusUnlockFailed, UnlockStatusNotSupported,
+		},
+		Invalid:  []string{"", "unlocked", "Failed"},
+		Sentinel: ErrInvalidUnlockStatus,
+		Parse:    ParseUnlockStatus,
+	})
+}
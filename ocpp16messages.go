@@ -7,4 +7,12 @@
 // Subpackages:
 //   - types: Core data types with validation (e.g., CiStringXXType)
 //   - messages: OCPP message payloads grouped by action
+//   - ocppj: OCPP-J RPC framing (CALL, CALLRESULT, CALLERROR)
+//   - registry: Decoding of payloads into typed messages by action name
+//   - schemas: Embedded official OCPP 1.6J JSON schemas and a draft-04 validator
+//   - config: Catalog of the standard configuration keys and their typed values
+//   - smartcharging: Composite schedule calculation from installed charging profiles
+//   - locallist: Local Authorization List with versioned Full and Differential updates
+//
+// The cmd/ocppgen command generates the messages packages from the schemas.
 package ocpp16messages
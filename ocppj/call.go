@@ -0,0 +1,118 @@
+package ocppj
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// callFrameSize is the number of elements of a CALL frame.
+const callFrameSize = 4
+
+// CallMessage represents an OCPP-J CALL frame: [2, uniqueId, action, payload].
+//
+// A CALL carries a request, such as Authorize.req, from the Charge Point to the
+// Central System or the other way around.
+//
+// Specification Reference:
+//   - OCPP-J 1.6, Section 4.2.1: CALL
+type CallMessage struct {
+	// UniqueID identifies the request; the matching CALLRESULT or CALLERROR carries the same value.
+	UniqueID string
+
+	// Action is the name of the OCPP operation, for example "Authorize".
+	Action string
+
+	// Payload is the raw JSON object of the request.
+	Payload json.RawMessage
+}
+
+// Call constructs a new CallMessage.
+//
+// The payload is encoded with encoding/json, so any typed request message (for
+// example authorize.RequestMessage) can be passed directly. A nil payload is
+// encoded as an empty object. The resulting frame is validated before it is returned.
+func Call(uniqueID, action string, payload any) (CallMessage, error) {
+	raw, err := encodePayload(payload)
+	if err != nil {
+		return CallMessage{}, fmt.Errorf("failed to encode CALL payload: %w", err)
+	}
+
+	msg := CallMessage{UniqueID: uniqueID, Action: action, Payload: raw}
+	if err := msg.Validate(); err != nil {
+		return CallMessage{}, err
+	}
+
+	return msg, nil
+}
+
+// MessageType returns CallType.
+func (m CallMessage) MessageType() MessageType {
+	return CallType
+}
+
+// Validate checks the uniqueId, the action and that the payload is a JSON object.
+func (m CallMessage) Validate() error {
+	if err := validateUniqueID(m.UniqueID); err != nil {
+		return err
+	}
+
+	if m.Action == "" {
+		return fmt.Errorf("%w: must not be empty", ErrInvalidAction)
+	}
+
+	if err := validateObject(m.Payload); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the CallMessage.
+func (m CallMessage) String() string {
+	return fmt.Sprintf("CALL{uniqueId=%s, action=%s, payload=%s}", m.UniqueID, m.Action, m.Payload)
+}
+
+// MarshalJSON encodes the CallMessage as a CALL array. Invalid frames are never encoded.
+func (m CallMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal([]any{CallType, m.UniqueID, m.Action, m.Payload})
+}
+
+// UnmarshalJSON decodes a CALL array and validates it.
+func (m *CallMessage) UnmarshalJSON(data []byte) error {
+	elements, err := splitFrame(data)
+	if err != nil {
+		return err
+	}
+
+	return m.decode(elements)
+}
+
+// decode populates the CallMessage from the elements of a frame.
+func (m *CallMessage) decode(elements []json.RawMessage) error {
+	if err := expectFrame(elements, CallType, callFrameSize); err != nil {
+		return err
+	}
+
+	uniqueID, err := decodeString(elements[1], "uniqueId")
+	if err != nil {
+		return err
+	}
+
+	action, err := decodeString(elements[2], "action")
+	if err != nil {
+		return err
+	}
+
+	msg := CallMessage{UniqueID: uniqueID, Action: action, Payload: elements[3]}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	*m = msg
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package ocppj
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// callErrorFrameSize is the number of elements of a CALLERROR frame.
+const callErrorFrameSize = 5
+
+// CallErrorMessage represents an OCPP-J CALLERROR frame:
+// [4, uniqueId, errorCode, errorDescription, errorDetails].
+//
+// A CALLERROR is returned instead of a CALLRESULT when a CALL could not be
+// processed, for example because its payload is invalid or its action is unknown.
+//
+// Specification Reference:
+//   - OCPP-J 1.6, Section 4.2.3: CALLERROR
+type CallErrorMessage struct {
+	// UniqueID is the uniqueId of the CALL this error answers.
+	UniqueID string
+
+	// ErrorCode identifies the kind of error, for example FormationViolation.
+	ErrorCode ErrorCode
+
+	// ErrorDescription is a human-readable description of the error. It may be empty.
+	ErrorDescription string
+
+	// ErrorDetails is a raw JSON object with additional error details. It may be empty.
+	ErrorDetails json.RawMessage
+}
+
+// CallError constructs a new CallErrorMessage.
+//
+// The details are encoded with encoding/json; nil details are encoded as an empty
+// object. The resulting frame is validated before it is returned.
+func CallError(uniqueID string, errorCode ErrorCode, description string, details any) (CallErrorMessage, error) {
+	raw, err := encodePayload(details)
+	if err != nil {
+		return CallErrorMessage{}, fmt.Errorf("failed to encode CALLERROR details: %w", err)
+	}
+
+	msg := CallErrorMessage{
+		UniqueID:         uniqueID,
+		ErrorCode:        errorCode,
+		ErrorDescription: description,
+		ErrorDetails:     raw,
+	}
+
+	if err := msg.Validate(); err != nil {
+		return CallErrorMessage{}, err
+	}
+
+	return msg, nil
+}
+
+// MessageType returns CallErrorType.
+func (m CallErrorMessage) MessageType() MessageType {
+	return CallErrorType
+}
+
+// Validate checks the uniqueId, that the errorCode is one of the codes defined by
+// OCPP-J 1.6 and that errorDetails is a JSON object.
+func (m CallErrorMessage) Validate() error {
+	if err := validateUniqueID(m.UniqueID); err != nil {
+		return err
+	}
+
+	if !m.ErrorCode.IsValid() {
+		return fmt.Errorf("%w: %q", ErrInvalidErrorCode, m.ErrorCode)
+	}
+
+	if err := validateObject(m.ErrorDetails); err != nil {
+		return fmt.Errorf("invalid errorDetails: %w", err)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the CallErrorMessage.
+func (m CallErrorMessage) String() string {
+	return fmt.Sprintf(
+		"CALLERROR{uniqueId=%s, errorCode=%s, errorDescription=%s, errorDetails=%s}",
+		m.UniqueID, m.ErrorCode, m.ErrorDescription, m.ErrorDetails,
+	)
+}
+
+// MarshalJSON encodes the CallErrorMessage as a CALLERROR array. Invalid frames are
+// never encoded.
+func (m CallErrorMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal([]any{CallErrorType, m.UniqueID, m.ErrorCode, m.ErrorDescription, m.ErrorDetails})
+}
+
+// UnmarshalJSON decodes a CALLERROR array and validates it.
+func (m *CallErrorMessage) UnmarshalJSON(data []byte) error {
+	elements, err := splitFrame(data)
+	if err != nil {
+		return err
+	}
+
+	return m.decode(elements)
+}
+
+// decode populates the CallErrorMessage from the elements of a frame.
+func (m *CallErrorMessage) decode(elements []json.RawMessage) error {
+	if err := expectFrame(elements, CallErrorType, callErrorFrameSize); err != nil {
+		return err
+	}
+
+	uniqueID, err := decodeString(elements[1], "uniqueId")
+	if err != nil {
+		return err
+	}
+
+	code, err := decodeString(elements[2], "errorCode")
+	if err != nil {
+		return err
+	}
+
+	description, err := decodeString(elements[3], "errorDescription")
+	if err != nil {
+		return err
+	}
+
+	msg := CallErrorMessage{
+		UniqueID:         uniqueID,
+		ErrorCode:        ErrorCode(code),
+		ErrorDescription: description,
+		ErrorDetails:     elements[4],
+	}
+
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	*m = msg
+
+	return nil
+}
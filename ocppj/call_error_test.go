@@ -0,0 +1,95 @@
+package ocppj
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestCallErrorMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	msg, err := CallError("msg-001", "NotImplemented", "Requested Action is not known", nil)
+	if err != nil {
+		t.Fatalf("unexpected error building CALLERROR: %v", err)
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling CALLERROR: %v", err)
+	}
+
+	want := `[4,"msg-001","NotImplemented","Requested Action is not known",{}]`
+	if string(data) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, data)
+	}
+}
+
+func TestCallErrorInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := CallError("1", "", "", nil); !errors.Is(err, ErrInvalidErrorCode) {
+		t.Errorf("expected ErrInvalidErrorCode, got %v", err)
+	}
+
+	if _, err := CallError("1", "Oops", "", nil); !errors.Is(err, ErrInvalidErrorCode) {
+		t.Errorf("expected ErrInvalidErrorCode, got %v", err)
+	}
+
+	if _, err := CallError("1", "GenericError", "", 12); !errors.Is(err, ErrInvalidPayload) {
+		t.Errorf("expected ErrInvalidPayload, got %v", err)
+	}
+
+	if _, err := CallError("", "GenericError", "", nil); !errors.Is(err, ErrInvalidUniqueID) {
+		t.Errorf("expected ErrInvalidUniqueID, got %v", err)
+	}
+
+	if _, err := CallError("1", "GenericError", "", func() {}); err == nil {
+		t.Error("expected error for unencodable details, got nil")
+	}
+
+	if _, err := json.Marshal(CallErrorMessage{}); err == nil {
+		t.Error("expected error marshaling zero-value CallErrorMessage, got nil")
+	}
+}
+
+func TestCallErrorUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var msg CallErrorMessage
+	if err := json.Unmarshal([]byte(`[4,"42","GenericError","boom",{"hint":"retry"}]`), &msg); err != nil {
+		t.Fatalf("unexpected error unmarshaling CALLERROR: %v", err)
+	}
+
+	if msg.ErrorCode != "GenericError" || string(msg.ErrorDetails) != `{"hint":"retry"}` {
+		t.Errorf("unexpected CALLERROR contents: %s", msg)
+	}
+
+	if msg.MessageType() != CallErrorType {
+		t.Errorf("expected %s, got %s", CallErrorType, msg.MessageType())
+	}
+
+	if err := json.Unmarshal([]byte(`[3,"42",{}]`), &msg); !errors.Is(err, ErrInvalidMessageType) {
+		t.Errorf("expected ErrInvalidMessageType, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &msg); !errors.Is(err, ErrInvalidFrame) {
+		t.Errorf("expected ErrInvalidFrame, got %v", err)
+	}
+}
+
+func TestCallErrorString(t *testing.T) {
+	t.Parallel()
+
+	msg := CallErrorMessage{
+		UniqueID:         "1",
+		ErrorCode:        "GenericError",
+		ErrorDescription: "boom",
+		ErrorDetails:     json.RawMessage("{}"),
+	}
+
+	want := "CALLERROR{uniqueId=1, errorCode=GenericError, errorDescription=boom, errorDetails={}}"
+	if msg.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, msg.String())
+	}
+}
@@ -0,0 +1,103 @@
+package ocppj
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// callResultFrameSize is the number of elements of a CALLRESULT frame.
+const callResultFrameSize = 3
+
+// CallResultMessage represents an OCPP-J CALLRESULT frame: [3, uniqueId, payload].
+//
+// A CALLRESULT carries the confirmation, such as Authorize.conf, of a previously
+// received CALL with the same uniqueId.
+//
+// Specification Reference:
+//   - OCPP-J 1.6, Section 4.2.2: CALLRESULT
+type CallResultMessage struct {
+	// UniqueID is the uniqueId of the CALL this result answers.
+	UniqueID string
+
+	// Payload is the raw JSON object of the confirmation.
+	Payload json.RawMessage
+}
+
+// CallResult constructs a new CallResultMessage.
+//
+// The payload is encoded with encoding/json, so any typed confirmation message can
+// be passed directly. A nil payload is encoded as an empty object. The resulting
+// frame is validated before it is returned.
+func CallResult(uniqueID string, payload any) (CallResultMessage, error) {
+	raw, err := encodePayload(payload)
+	if err != nil {
+		return CallResultMessage{}, fmt.Errorf("failed to encode CALLRESULT payload: %w", err)
+	}
+
+	msg := CallResultMessage{UniqueID: uniqueID, Payload: raw}
+	if err := msg.Validate(); err != nil {
+		return CallResultMessage{}, err
+	}
+
+	return msg, nil
+}
+
+// MessageType returns CallResultType.
+func (m CallResultMessage) MessageType() MessageType {
+	return CallResultType
+}
+
+// Validate checks the uniqueId and that the payload is a JSON object.
+func (m CallResultMessage) Validate() error {
+	if err := validateUniqueID(m.UniqueID); err != nil {
+		return err
+	}
+
+	return validateObject(m.Payload)
+}
+
+// String returns a human-readable representation of the CallResultMessage.
+func (m CallResultMessage) String() string {
+	return fmt.Sprintf("CALLRESULT{uniqueId=%s, payload=%s}", m.UniqueID, m.Payload)
+}
+
+// MarshalJSON encodes the CallResultMessage as a CALLRESULT array. Invalid frames are
+// never encoded.
+func (m CallResultMessage) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal([]any{CallResultType, m.UniqueID, m.Payload})
+}
+
+// UnmarshalJSON decodes a CALLRESULT array and validates it.
+func (m *CallResultMessage) UnmarshalJSON(data []byte) error {
+	elements, err := splitFrame(data)
+	if err != nil {
+		return err
+	}
+
+	return m.decode(elements)
+}
+
+// decode populates the CallResultMessage from the elements of a frame.
+func (m *CallResultMessage) decode(elements []json.RawMessage) error {
+	if err := expectFrame(elements, CallResultType, callResultFrameSize); err != nil {
+		return err
+	}
+
+	uniqueID, err := decodeString(elements[1], "uniqueId")
+	if err != nil {
+		return err
+	}
+
+	msg := CallResultMessage{UniqueID: uniqueID, Payload: elements[2]}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	*m = msg
+
+	return nil
+}
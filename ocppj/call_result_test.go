@@ -0,0 +1,78 @@
+package ocppj
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestCallResultMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	msg, err := CallResult("msg-001", map[string]string{"currentTime": "2025-01-01T00:00:00Z"})
+	if err != nil {
+		t.Fatalf("unexpected error building CALLRESULT: %v", err)
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling CALLRESULT: %v", err)
+	}
+
+	want := `[3,"msg-001",{"currentTime":"2025-01-01T00:00:00Z"}]`
+	if string(data) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, data)
+	}
+}
+
+func TestCallResultInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := CallResult("", nil); !errors.Is(err, ErrInvalidUniqueID) {
+		t.Errorf("expected ErrInvalidUniqueID, got %v", err)
+	}
+
+	if _, err := CallResult("1", "text"); !errors.Is(err, ErrInvalidPayload) {
+		t.Errorf("expected ErrInvalidPayload, got %v", err)
+	}
+
+	if _, err := CallResult("1", make(chan int)); err == nil {
+		t.Error("expected error for unencodable payload, got nil")
+	}
+
+	if _, err := json.Marshal(CallResultMessage{}); err == nil {
+		t.Error("expected error marshaling zero-value CallResultMessage, got nil")
+	}
+}
+
+func TestCallResultUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var msg CallResultMessage
+	if err := json.Unmarshal([]byte(`[3,"42",{}]`), &msg); err != nil {
+		t.Fatalf("unexpected error unmarshaling CALLRESULT: %v", err)
+	}
+
+	if msg.UniqueID != "42" || msg.MessageType() != CallResultType {
+		t.Errorf("unexpected CALLRESULT contents: %s", msg)
+	}
+
+	if err := json.Unmarshal([]byte(`[2,"42","Heartbeat",{}]`), &msg); !errors.Is(err, ErrInvalidMessageType) {
+		t.Errorf("expected ErrInvalidMessageType, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &msg); !errors.Is(err, ErrInvalidFrame) {
+		t.Errorf("expected ErrInvalidFrame, got %v", err)
+	}
+}
+
+func TestCallResultString(t *testing.T) {
+	t.Parallel()
+
+	msg := CallResultMessage{UniqueID: "1", Payload: json.RawMessage("{}")}
+
+	want := "CALLRESULT{uniqueId=1, payload={}}"
+	if msg.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, msg.String())
+	}
+}
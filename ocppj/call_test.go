@@ -0,0 +1,118 @@
+package ocppj
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCallMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	msg, err := Call("msg-001", "Authorize", map[string]string{"idTag": "ABC123"})
+	if err != nil {
+		t.Fatalf("unexpected error building CALL: %v", err)
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling CALL: %v", err)
+	}
+
+	want := `[2,"msg-001","Authorize",{"idTag":"ABC123"}]`
+	if string(data) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, data)
+	}
+}
+
+func TestCallNilPayload(t *testing.T) {
+	t.Parallel()
+
+	msg, err := Call("msg-002", "Heartbeat", nil)
+	if err != nil {
+		t.Fatalf("unexpected error building CALL: %v", err)
+	}
+
+	if string(msg.Payload) != "{}" {
+		t.Errorf("expected empty object payload, got %s", msg.Payload)
+	}
+}
+
+func TestCallRawPayload(t *testing.T) {
+	t.Parallel()
+
+	msg, err := Call("msg-003", "Heartbeat", json.RawMessage(`{"a":1}`))
+	if err != nil {
+		t.Fatalf("unexpected error building CALL: %v", err)
+	}
+
+	if string(msg.Payload) != `{"a":1}` {
+		t.Errorf("unexpected payload: %s", msg.Payload)
+	}
+}
+
+func TestCallInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Call(strings.Repeat("x", 37), "Authorize", nil); !errors.Is(err, ErrInvalidUniqueID) {
+		t.Errorf("expected ErrInvalidUniqueID, got %v", err)
+	}
+
+	if _, err := Call("1", "", nil); !errors.Is(err, ErrInvalidAction) {
+		t.Errorf("expected ErrInvalidAction, got %v", err)
+	}
+
+	if _, err := Call("1", "Authorize", []string{"a"}); !errors.Is(err, ErrInvalidPayload) {
+		t.Errorf("expected ErrInvalidPayload, got %v", err)
+	}
+
+	if _, err := Call("1", "Authorize", func() {}); err == nil {
+		t.Error("expected error for unencodable payload, got nil")
+	}
+}
+
+func TestCallMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := json.Marshal(CallMessage{}); err == nil {
+		t.Error("expected error marshaling zero-value CallMessage, got nil")
+	}
+}
+
+func TestCallUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var msg CallMessage
+	if err := json.Unmarshal([]byte(`[2,"42","BootNotification",{}]`), &msg); err != nil {
+		t.Fatalf("unexpected error unmarshaling CALL: %v", err)
+	}
+
+	if msg.UniqueID != "42" || msg.Action != "BootNotification" {
+		t.Errorf("unexpected CALL contents: %s", msg)
+	}
+}
+
+func TestCallUnmarshalJSONWrongType(t *testing.T) {
+	t.Parallel()
+
+	var msg CallMessage
+	if err := json.Unmarshal([]byte(`[3,"42",{}]`), &msg); !errors.Is(err, ErrInvalidMessageType) {
+		t.Errorf("expected ErrInvalidMessageType, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`"frame"`), &msg); !errors.Is(err, ErrInvalidFrame) {
+		t.Errorf("expected ErrInvalidFrame, got %v", err)
+	}
+}
+
+func TestCallString(t *testing.T) {
+	t.Parallel()
+
+	msg := CallMessage{UniqueID: "1", Action: "Heartbeat", Payload: json.RawMessage("{}")}
+
+	want := "CALL{uniqueId=1, action=Heartbeat, payload={}}"
+	if msg.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, msg.String())
+	}
+}
@@ -0,0 +1,20 @@
+// Package ocppj implements the OCPP-J RPC framing used to transport OCPP 1.6 messages
+// over WebSocket.
+//
+// Every OCPP-J message is a JSON array whose first element is the message type ID:
+//
+//	[2, "<uniqueId>", "<action>", {<payload>}]                                  CALL
+//	[3, "<uniqueId>", {<payload>}]                                              CALLRESULT
+//	[4, "<uniqueId>", "<errorCode>", "<errorDescription>", {<errorDetails>}]     CALLERROR
+//
+// This package parses and encodes these frames and checks the message type ID, the
+// uniqueId (maximum 36 characters) and the shape of each frame. Payloads are kept as
+// raw JSON so they can be decoded into the typed messages of the messages packages.
+//
+// Specification Reference:
+//   - OCPP-J 1.6 Specification, Section 4: RPC Framework
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/ocppj"
+package ocppj
@@ -0,0 +1,147 @@
+package ocppj
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrorCode is the errorCode element of a CALLERROR frame.
+//
+// OCPP-J 1.6 defines a closed set of error codes that a receiver uses to explain
+// why a CALL could not be processed. Spelling follows the specification, including
+// "OccurenceConstraintViolation".
+//
+// Specification Reference:
+//   - OCPP-J 1.6, Section 4.2.3: CALLERROR, Table 7: Valid Error Codes
+type ErrorCode string
+
+const (
+	// NotImplemented indicates that the requested action is not known by the receiver.
+	NotImplemented ErrorCode = "NotImplemented"
+
+	// NotSupported indicates that the requested action is recognized but not supported
+	// by the receiver.
+	NotSupported ErrorCode = "NotSupported"
+
+	// InternalError indicates that an internal error occurred and the receiver was not
+	// able to process the requested action successfully.
+	InternalError ErrorCode = "InternalError"
+
+	// ProtocolError indicates that the payload for the action is incomplete.
+	ProtocolError ErrorCode = "ProtocolError"
+
+	// SecurityError indicates that a security issue occurred during processing of the
+	// action that prevented the receiver from completing it successfully.
+	SecurityError ErrorCode = "SecurityError"
+
+	// FormationViolation indicates that the payload for the action is syntactically
+	// incorrect or does not conform to the PDU structure for the action.
+	FormationViolation ErrorCode = "FormationViolation"
+
+	// PropertyConstraintViolation indicates that the payload is syntactically correct
+	// but at least one field contains an invalid value.
+	PropertyConstraintViolation ErrorCode = "PropertyConstraintViolation"
+
+	// OccurenceConstraintViolation indicates that the payload is syntactically correct
+	// but at least one field violates occurrence constraints, such as a missing
+	// required field.
+	OccurenceConstraintViolation ErrorCode = "OccurenceConstraintViolation"
+
+	// TypeConstraintViolation indicates that the payload is syntactically correct but
+	// at least one field violates data type constraints (e.g. "somestring": 12).
+	TypeConstraintViolation ErrorCode = "TypeConstraintViolation"
+
+	// GenericError indicates any other error not covered by the previous codes.
+	GenericError ErrorCode = "GenericError"
+)
+
+// IsValid returns true if the ErrorCode is one of the error codes defined by OCPP-J 1.6.
+func (c ErrorCode) IsValid() bool {
+	switch c {
+	case NotImplemented, NotSupported, InternalError, ProtocolError, SecurityError,
+		FormationViolation, PropertyConstraintViolation, OccurenceConstraintViolation,
+		TypeConstraintViolation, GenericError:
+		return true
+	default:
+		return false
+	}
+}
+
+// errorCodeMapping associates sentinel errors with the error code they map to.
+// Entries are checked in order, so more specific sentinels come first.
+var errorCodeMapping = []struct {
+	target error
+	code   ErrorCode
+}{
+	{ErrUnknownAction, NotImplemented},
+	{ErrInvalidPayload, FormationViolation},
+	{ErrInvalidFrame, ProtocolError},
+	{ErrInvalidMessageType, ProtocolError},
+	{ErrInvalidUniqueID, ProtocolError},
+	{ErrInvalidAction, ProtocolError},
+	{ErrInvalidErrorCode, ProtocolError},
+	{types.ErrMissingRequiredField, OccurenceConstraintViolation},
+	{types.ErrExceedsMaxLength, PropertyConstraintViolation},
+	{types.ErrNonPrintableASCII, PropertyConstraintViolation},
+	{types.ErrEmptyValueNotAllowed, PropertyConstraintViolation},
+	{types.ErrInvalidAuthorizationStatus, PropertyConstraintViolation},
+	{types.ErrInvalidExpiryDate, PropertyConstraintViolation},
+	{types.ErrInvalidDateTime, PropertyConstraintViolation},
+	{types.ErrInvalidParentIdTag, PropertyConstraintViolation},
+}
+
+// ErrorCodeFor returns the CALLERROR error code that describes err.
+//
+// Errors produced by this module are mapped to the OCPP-J vocabulary: unknown
+// actions become NotImplemented, malformed JSON becomes FormationViolation, JSON
+// values of the wrong type become TypeConstraintViolation, missing required fields
+// become OccurenceConstraintViolation and values violating a constraint (length,
+// character set, enumeration) become PropertyConstraintViolation. A
+// *types.ValidationError that does not wrap a known sentinel is classified by its
+// Constraint. Anything else, including a nil error, maps to GenericError.
+func ErrorCodeFor(err error) ErrorCode {
+	if err == nil {
+		return GenericError
+	}
+
+	for _, mapping := range errorCodeMapping {
+		if errors.Is(err, mapping.target) {
+			return mapping.code
+		}
+	}
+
+	var verr *types.ValidationError
+	if errors.As(err, &verr) {
+		if verr.Constraint == types.ConstraintRequired {
+			return OccurenceConstraintViolation
+		}
+
+		return PropertyConstraintViolation
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return FormationViolation
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return TypeConstraintViolation
+	}
+
+	return GenericError
+}
+
+// CallErrorFor builds the CALLERROR frame answering the CALL identified by uniqueID
+// that failed with err. The error code is chosen with ErrorCodeFor and the error
+// message is used as errorDescription.
+func CallErrorFor(uniqueID string, err error) (CallErrorMessage, error) {
+	description := ""
+	if err != nil {
+		description = err.Error()
+	}
+
+	return CallError(uniqueID, ErrorCodeFor(err), description, nil)
+}
@@ -0,0 +1,86 @@
+package ocppj
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+var errBoom = errors.New("boom")
+
+func TestErrorCodeIsValid(t *testing.T) {
+	t.Parallel()
+
+	codes := []ErrorCode{
+		NotImplemented, NotSupported, InternalError, ProtocolError, SecurityError, FormationViolation,
+		PropertyConstraintViolation, OccurenceConstraintViolation, TypeConstraintViolation, GenericError,
+	}
+
+	for _, code := range codes {
+		if !code.IsValid() {
+			t.Errorf("expected IsValid() to return true for %s", code)
+		}
+	}
+
+	if ErrorCode("OccurrenceConstraintViolation").IsValid() {
+		t.Error("expected IsValid() to return false for a misspelled code")
+	}
+}
+
+func TestErrorCodeForSentinels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err  error
+		want ErrorCode
+	}{
+		{nil, GenericError},
+		{errors.New("boom"), GenericError},
+		{fmt.Errorf("wrapped: %w", ErrUnknownAction), NotImplemented},
+		{ErrInvalidFrame, ProtocolError},
+		{ErrInvalidMessageType, ProtocolError},
+		{ErrInvalidUniqueID, ProtocolError},
+		{ErrInvalidPayload, FormationViolation},
+		{fmt.Errorf("idTag: %w", types.ErrMissingRequiredField), OccurenceConstraintViolation},
+		{fmt.Errorf("%w: actual length 21, max 20", types.ErrExceedsMaxLength), PropertyConstraintViolation},
+		{types.ErrNonPrintableASCII, PropertyConstraintViolation},
+		{types.ErrEmptyValueNotAllowed, PropertyConstraintViolation},
+		{types.ErrInvalidAuthorizationStatus, PropertyConstraintViolation},
+		{fmt.Errorf("%w: %w", types.ErrInvalidParentIdTag, types.ErrExceedsMaxLength), PropertyConstraintViolation},
+		{&types.ValidationError{Path: "x", Constraint: types.ConstraintEnum, Value: "y", Err: errBoom}, PropertyConstraintViolation},
+		{&types.ValidationError{Path: "x", Constraint: types.ConstraintRequired, Value: nil, Err: errBoom}, OccurenceConstraintViolation},
+	}
+
+	for _, tc := range tests {
+		if got := ErrorCodeFor(tc.err); got != tc.want {
+			t.Errorf("ErrorCodeFor(%v): expected %s, got %s", tc.err, tc.want, got)
+		}
+	}
+}
+
+func TestCallErrorFor(t *testing.T) {
+	t.Parallel()
+
+	msg, err := CallErrorFor("42", fmt.Errorf("%w: FooBar", ErrUnknownAction))
+	if err != nil {
+		t.Fatalf("unexpected error building CALLERROR: %v", err)
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling CALLERROR: %v", err)
+	}
+
+	want := `[4,"42","NotImplemented","unknown action: FooBar",{}]`
+	if string(data) != want {
+		t.Errorf("unexpected JSON output:\nwant: %s\ngot : %s", want, data)
+	}
+
+	msg, err = CallErrorFor("43", nil)
+	if err != nil || msg.ErrorCode != GenericError || msg.ErrorDescription != "" {
+		t.Errorf("unexpected CALLERROR for nil error: %s, %v", msg, err)
+	}
+}
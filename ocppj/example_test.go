@@ -0,0 +1,68 @@
+package ocppj_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/authorize"
+	"github.com/aasanchez/ocpp16messages/ocppj"
+	"github.com/aasanchez/ocpp16messages/registry"
+)
+
+func ExampleParse() {
+	frame := `[2, "19223201", "Authorize", {"idTag": "ThisIsMySuperIDTag"}]`
+
+	msg, err := ocppj.Parse([]byte(frame))
+	if err != nil {
+		log.Fatalf("failed to parse frame: %v", err)
+	}
+
+	call, ok := msg.(ocppj.CallMessage)
+	if !ok {
+		log.Fatalf("expected a CALL, got %s", msg.MessageType())
+	}
+
+	var req authorize.RequestMessage
+	if err := json.Unmarshal(call.Payload, &req); err != nil {
+		log.Fatalf("invalid Authorize.req: %v", err)
+	}
+
+	fmt.Println(call.Action, call.UniqueID, req.String())
+	// Output:
+	// Authorize 19223201 {idTag=ThisIsMySuperIDTag}
+}
+
+func ExampleCall() {
+	req, err := authorize.Request("ABC123456789")
+	if err != nil {
+		log.Fatalf("failed to construct request: %v", err)
+	}
+
+	call, err := ocppj.Call("msg-001", "Authorize", req)
+	if err != nil {
+		log.Fatalf("failed to build CALL: %v", err)
+	}
+
+	data, err := json.Marshal(call)
+	if err != nil {
+		log.Fatalf("failed to encode CALL: %v", err)
+	}
+
+	fmt.Println(string(data))
+	// Output:
+	// [2,"msg-001","Authorize",{"idTag":"ABC123456789"}]
+}
+
+func ExampleErrorCodeFor() {
+	_, err := registry.DecodeRequest("Authorize", []byte(`{"idTag":"ThisIdTagIsWayTooLongForOCPP"}`))
+
+	reply, buildErr := ocppj.CallErrorFor("19223201", err)
+	if buildErr != nil {
+		log.Fatalf("failed to build CALLERROR: %v", buildErr)
+	}
+
+	fmt.Println(reply.ErrorCode)
+	// Output:
+	// PropertyConstraintViolation
+}
@@ -0,0 +1,236 @@
+package ocppj
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// maxLenUniqueID is the maximum length of the uniqueId of an OCPP-J message.
+const maxLenUniqueID = 36
+
+// Predefined errors returned while parsing, building or validating OCPP-J frames.
+var (
+	// ErrInvalidFrame indicates that a message is not a JSON array with the number
+	// and kind of elements required by its message type.
+	ErrInvalidFrame = errors.New("invalid OCPP-J frame")
+
+	// ErrInvalidMessageType indicates that the message type ID is not 2 (CALL),
+	// 3 (CALLRESULT) or 4 (CALLERROR).
+	ErrInvalidMessageType = errors.New("invalid message type id")
+
+	// ErrInvalidUniqueID indicates that the uniqueId is empty or exceeds 36 characters.
+	ErrInvalidUniqueID = errors.New("invalid uniqueId")
+
+	// ErrInvalidAction indicates that the action of a CALL is empty.
+	ErrInvalidAction = errors.New("invalid action")
+
+	// ErrInvalidErrorCode indicates that the errorCode of a CALLERROR is not one of the
+	// error codes defined by OCPP-J 1.6.
+	ErrInvalidErrorCode = errors.New("invalid errorCode")
+
+	// ErrInvalidPayload indicates that a payload or errorDetails value is not a JSON object.
+	ErrInvalidPayload = errors.New("payload must be a JSON object")
+
+	// ErrUnknownAction indicates that the action of a CALL is not implemented by the
+	// receiver. ErrorCodeFor maps it to NotImplemented.
+	ErrUnknownAction = errors.New("unknown action")
+)
+
+// MessageType is the message type ID placed in the first element of every OCPP-J frame.
+type MessageType int
+
+const (
+	// CallType identifies a CALL frame, a request sent by either party.
+	CallType MessageType = 2
+
+	// CallResultType identifies a CALLRESULT frame, the successful response to a CALL.
+	CallResultType MessageType = 3
+
+	// CallErrorType identifies a CALLERROR frame, the error response to a CALL.
+	CallErrorType MessageType = 4
+)
+
+// IsValid returns true if the MessageType is one of the message type IDs defined
+// by OCPP-J 1.6.
+func (t MessageType) IsValid() bool {
+	switch t {
+	case CallType, CallResultType, CallErrorType:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the name of the message type as used in the specification.
+func (t MessageType) String() string {
+	switch t {
+	case CallType:
+		return "CALL"
+	case CallResultType:
+		return "CALLRESULT"
+	case CallErrorType:
+		return "CALLERROR"
+	default:
+		return fmt.Sprintf("MessageType(%d)", int(t))
+	}
+}
+
+// Message is implemented by every OCPP-J frame: CallMessage, CallResultMessage and
+// CallErrorMessage.
+type Message interface {
+	// MessageType returns the message type ID of the frame.
+	MessageType() MessageType
+
+	// Validate checks the frame against the OCPP-J constraints.
+	Validate() error
+
+	// String returns a human-readable representation of the frame.
+	String() string
+}
+
+// Parse decodes a raw OCPP-J frame and returns the corresponding CallMessage,
+// CallResultMessage or CallErrorMessage.
+//
+// It fails with ErrInvalidFrame if the input is not a JSON array of the expected
+// shape, with ErrInvalidMessageType for an unknown message type ID, and with the
+// frame-specific errors when a field violates its constraints.
+func Parse(data []byte) (Message, error) {
+	elements, err := splitFrame(data)
+	if err != nil {
+		return nil, err
+	}
+
+	msgType, err := decodeMessageType(elements[0])
+	if err != nil {
+		return nil, err
+	}
+
+	switch msgType {
+	case CallType:
+		var msg CallMessage
+		if err := msg.decode(elements); err != nil {
+			return nil, err
+		}
+
+		return msg, nil
+	case CallResultType:
+		var msg CallResultMessage
+		if err := msg.decode(elements); err != nil {
+			return nil, err
+		}
+
+		return msg, nil
+	default:
+		var msg CallErrorMessage
+		if err := msg.decode(elements); err != nil {
+			return nil, err
+		}
+
+		return msg, nil
+	}
+}
+
+// splitFrame decodes the outer JSON array of a frame into its raw elements.
+func splitFrame(data []byte) ([]json.RawMessage, error) {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFrame, err)
+	}
+
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("%w: empty array", ErrInvalidFrame)
+	}
+
+	return elements, nil
+}
+
+// decodeMessageType decodes and checks the message type ID element of a frame.
+func decodeMessageType(raw json.RawMessage) (MessageType, error) {
+	var id int
+	if err := json.Unmarshal(raw, &id); err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidMessageType, raw)
+	}
+
+	msgType := MessageType(id)
+	if !msgType.IsValid() {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidMessageType, id)
+	}
+
+	return msgType, nil
+}
+
+// expectFrame checks that a frame has the message type and element count of msgType.
+func expectFrame(elements []json.RawMessage, msgType MessageType, size int) error {
+	if len(elements) == 0 {
+		return fmt.Errorf("%w: empty array", ErrInvalidFrame)
+	}
+
+	got, err := decodeMessageType(elements[0])
+	if err != nil {
+		return err
+	}
+
+	if got != msgType {
+		return fmt.Errorf("%w: expected %s, got %s", ErrInvalidMessageType, msgType, got)
+	}
+
+	if len(elements) != size {
+		return fmt.Errorf("%w: %s must have %d elements, got %d", ErrInvalidFrame, msgType, size, len(elements))
+	}
+
+	return nil
+}
+
+// decodeString decodes a frame element that must be a JSON string.
+func decodeString(raw json.RawMessage, name string) (string, error) {
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", fmt.Errorf("%w: %s must be a string", ErrInvalidFrame, name)
+	}
+
+	return value, nil
+}
+
+// validateUniqueID checks the uniqueId constraints shared by all frames.
+func validateUniqueID(uniqueID string) error {
+	if uniqueID == "" {
+		return fmt.Errorf("%w: must not be empty", ErrInvalidUniqueID)
+	}
+
+	if len(uniqueID) > maxLenUniqueID {
+		return fmt.Errorf("%w: actual length %d, max %d", ErrInvalidUniqueID, len(uniqueID), maxLenUniqueID)
+	}
+
+	return nil
+}
+
+// validateObject checks that a raw payload is a JSON object.
+func validateObject(raw json.RawMessage) error {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || trimmed[0] != '{' || !json.Valid(trimmed) {
+		return ErrInvalidPayload
+	}
+
+	return nil
+}
+
+// encodePayload marshals a payload for use in a frame.
+//
+// A json.RawMessage is used as-is; a nil payload is encoded as an empty object.
+func encodePayload(payload any) (json.RawMessage, error) {
+	switch value := payload.(type) {
+	case nil:
+		return json.RawMessage("{}"), nil
+	case json.RawMessage:
+		return value, nil
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return data, nil
+	}
+}
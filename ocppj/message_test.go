@@ -0,0 +1,131 @@
+package ocppj
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseCall(t *testing.T) {
+	t.Parallel()
+
+	msg, err := Parse([]byte(`[2, "19223201", "Authorize", {"idTag": "ABC123"}]`))
+	if err != nil {
+		t.Fatalf("unexpected error parsing CALL: %v", err)
+	}
+
+	call, ok := msg.(CallMessage)
+	if !ok {
+		t.Fatalf("expected CallMessage, got %T", msg)
+	}
+
+	if call.UniqueID != "19223201" || call.Action != "Authorize" {
+		t.Errorf("unexpected CALL contents: %s", call)
+	}
+
+	if msg.MessageType() != CallType {
+		t.Errorf("expected %s, got %s", CallType, msg.MessageType())
+	}
+}
+
+func TestParseCallResult(t *testing.T) {
+	t.Parallel()
+
+	msg, err := Parse([]byte(`[3, "19223201", {"idTagInfo": {"status": "Accepted"}}]`))
+	if err != nil {
+		t.Fatalf("unexpected error parsing CALLRESULT: %v", err)
+	}
+
+	if _, ok := msg.(CallResultMessage); !ok {
+		t.Fatalf("expected CallResultMessage, got %T", msg)
+	}
+}
+
+func TestParseCallError(t *testing.T) {
+	t.Parallel()
+
+	msg, err := Parse([]byte(`[4, "19223201", "NotImplemented", "unknown action", {}]`))
+	if err != nil {
+		t.Fatalf("unexpected error parsing CALLERROR: %v", err)
+	}
+
+	callErr, ok := msg.(CallErrorMessage)
+	if !ok {
+		t.Fatalf("expected CallErrorMessage, got %T", msg)
+	}
+
+	if callErr.ErrorCode != "NotImplemented" || callErr.ErrorDescription != "unknown action" {
+		t.Errorf("unexpected CALLERROR contents: %s", callErr)
+	}
+}
+
+func TestParseInvalidFrames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{"not json", `[2, "1"`, ErrInvalidFrame},
+		{"not an array", `{"messageTypeId": 2}`, ErrInvalidFrame},
+		{"empty array", `[]`, ErrInvalidFrame},
+		{"unknown message type", `[5, "1", {}]`, ErrInvalidMessageType},
+		{"message type as string", `["2", "1", "Authorize", {}]`, ErrInvalidMessageType},
+		{"call too short", `[2, "1", "Authorize"]`, ErrInvalidFrame},
+		{"call too long", `[2, "1", "Authorize", {}, {}]`, ErrInvalidFrame},
+		{"call result too short", `[3, "1"]`, ErrInvalidFrame},
+		{"call error too short", `[4, "1", "GenericError", {}]`, ErrInvalidFrame},
+		{"uniqueId not a string", `[3, 1, {}]`, ErrInvalidFrame},
+		{"empty uniqueId", `[3, "", {}]`, ErrInvalidUniqueID},
+		{"uniqueId too long", `[3, "` + strings.Repeat("x", 37) + `", {}]`, ErrInvalidUniqueID},
+		{"action not a string", `[2, "1", 7, {}]`, ErrInvalidFrame},
+		{"empty action", `[2, "1", "", {}]`, ErrInvalidAction},
+		{"payload not an object", `[2, "1", "Heartbeat", []]`, ErrInvalidPayload},
+		{"result payload null", `[3, "1", null]`, ErrInvalidPayload},
+		{"empty errorCode", `[4, "1", "", "", {}]`, ErrInvalidErrorCode},
+		{"unknown errorCode", `[4, "1", "Whatever", "", {}]`, ErrInvalidErrorCode},
+		{"errorDescription not a string", `[4, "1", "GenericError", 1, {}]`, ErrInvalidFrame},
+		{"errorCode not a string", `[4, "1", 1, "", {}]`, ErrInvalidFrame},
+		{"errorDetails not an object", `[4, "1", "GenericError", "", "details"]`, ErrInvalidPayload},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Parse([]byte(tc.input)); !errors.Is(err, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestMessageTypeString(t *testing.T) {
+	t.Parallel()
+
+	want := map[MessageType]string{
+		CallType:       "CALL",
+		CallResultType: "CALLRESULT",
+		CallErrorType:  "CALLERROR",
+		MessageType(9): "MessageType(9)",
+	}
+
+	for msgType, name := range want {
+		if msgType.String() != name {
+			t.Errorf("expected %s, got %s", name, msgType.String())
+		}
+	}
+}
+
+func TestMessageTypeIsValid(t *testing.T) {
+	t.Parallel()
+
+	if MessageType(1).IsValid() || MessageType(5).IsValid() {
+		t.Error("expected IsValid() to return false for unknown message types")
+	}
+
+	if !CallType.IsValid() || !CallResultType.IsValid() || !CallErrorType.IsValid() {
+		t.Error("expected IsValid() to return true for known message types")
+	}
+}
@@ -0,0 +1,66 @@
+package registry
+
+import (
+	"github.com/aasanchez/ocpp16messages/messages/authorize"
+	"github.com/aasanchez/ocpp16messages/messages/bootnotification"
+	"github.com/aasanchez/ocpp16messages/messages/cancelreservation"
+	"github.com/aasanchez/ocpp16messages/messages/changeavailability"
+	"github.com/aasanchez/ocpp16messages/messages/changeconfiguration"
+	"github.com/aasanchez/ocpp16messages/messages/clearcache"
+	"github.com/aasanchez/ocpp16messages/messages/clearchargingprofile"
+	"github.com/aasanchez/ocpp16messages/messages/datatransfer"
+	"github.com/aasanchez/ocpp16messages/messages/diagnosticsstatusnotification"
+	"github.com/aasanchez/ocpp16messages/messages/firmwarestatusnotification"
+	"github.com/aasanchez/ocpp16messages/messages/getcompositeschedule"
+	"github.com/aasanchez/ocpp16messages/messages/getconfiguration"
+	"github.com/aasanchez/ocpp16messages/messages/getdiagnostics"
+	"github.com/aasanchez/ocpp16messages/messages/getlocallistversion"
+	"github.com/aasanchez/ocpp16messages/messages/heartbeat"
+	"github.com/aasanchez/ocpp16messages/messages/metervalues"
+	"github.com/aasanchez/ocpp16messages/messages/remotestarttransaction"
+	"github.com/aasanchez/ocpp16messages/messages/remotestoptransaction"
+	"github.com/aasanchez/ocpp16messages/messages/reservenow"
+	"github.com/aasanchez/ocpp16messages/messages/reset"
+	"github.com/aasanchez/ocpp16messages/messages/sendlocallist"
+	"github.com/aasanchez/ocpp16messages/messages/setchargingprofile"
+	"github.com/aasanchez/ocpp16messages/messages/starttransaction"
+	"github.com/aasanchez/ocpp16messages/messages/statusnotification"
+	"github.com/aasanchez/ocpp16messages/messages/stoptransaction"
+	"github.com/aasanchez/ocpp16messages/messages/triggermessage"
+	"github.com/aasanchez/ocpp16messages/messages/unlockconnector"
+	"github.com/aasanchez/ocpp16messages/messages/updatefirmware"
+)
+
+// builtin returns the actions implemented by the messages packages of this module.
+func builtin() []Action {
+	return []Action{
+		Define[authorize.RequestMessage, authorize.ConfirmationMessage]("Authorize"),
+		Define[bootnotification.RequestMessage, bootnotification.ConfirmationMessage]("BootNotification"),
+		Define[cancelreservation.RequestMessage, cancelreservation.ConfirmationMessage]("CancelReservation"),
+		Define[changeavailability.RequestMessage, changeavailability.ConfirmationMessage]("ChangeAvailability"),
+		Define[changeconfiguration.RequestMessage, changeconfiguration.ConfirmationMessage]("ChangeConfiguration"),
+		Define[clearcache.RequestMessage, clearcache.ConfirmationMessage]("ClearCache"),
+		Define[clearchargingprofile.RequestMessage, clearchargingprofile.ConfirmationMessage]("ClearChargingProfile"),
+		Define[datatransfer.RequestMessage, datatransfer.ConfirmationMessage]("DataTransfer"),
+		Define[diagnosticsstatusnotification.RequestMessage, diagnosticsstatusnotification.ConfirmationMessage]("DiagnosticsStatusNotification"),
+		Define[firmwarestatusnotification.RequestMessage, firmwarestatusnotification.ConfirmationMessage]("FirmwareStatusNotification"),
+		Define[getcompositeschedule.RequestMessage, getcompositeschedule.ConfirmationMessage]("GetCompositeSchedule"),
+		Define[getconfiguration.RequestMessage, getconfiguration.ConfirmationMessage]("GetConfiguration"),
+		Define[getdiagnostics.RequestMessage, getdiagnostics.ConfirmationMessage]("GetDiagnostics"),
+		Define[getlocallistversion.RequestMessage, getlocallistversion.ConfirmationMessage]("GetLocalListVersion"),
+		Define[heartbeat.RequestMessage, heartbeat.ConfirmationMessage]("Heartbeat"),
+		Define[metervalues.RequestMessage, metervalues.ConfirmationMessage]("MeterValues"),
+		Define[remotestarttransaction.RequestMessage, remotestarttransaction.ConfirmationMessage]("RemoteStartTransaction"),
+		Define[remotestoptransaction.RequestMessage, remotestoptransaction.ConfirmationMessage]("RemoteStopTransaction"),
+		Define[reservenow.RequestMessage, reservenow.ConfirmationMessage]("ReserveNow"),
+		Define[reset.RequestMessage, reset.ConfirmationMessage]("Reset"),
+		Define[sendlocallist.RequestMessage, sendlocallist.ConfirmationMessage]("SendLocalList"),
+		Define[setchargingprofile.RequestMessage, setchargingprofile.ConfirmationMessage]("SetChargingProfile"),
+		Define[starttransaction.RequestMessage, starttransaction.ConfirmationMessage]("StartTransaction"),
+		Define[statusnotification.RequestMessage, statusnotification.ConfirmationMessage]("StatusNotification"),
+		Define[stoptransaction.RequestMessage, stoptransaction.ConfirmationMessage]("StopTransaction"),
+		Define[triggermessage.RequestMessage, triggermessage.ConfirmationMessage]("TriggerMessage"),
+		Define[unlockconnector.RequestMessage, unlockconnector.ConfirmationMessage]("UnlockConnector"),
+		Define[updatefirmware.RequestMessage, updatefirmware.ConfirmationMessage]("UpdateFirmware"),
+	}
+}
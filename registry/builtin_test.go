@@ -0,0 +1,137 @@
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/schemas"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// samplePayloads holds a valid request and confirmation payload per OCPP 1.6J action,
+// in the canonical form produced by MarshalJSON.
+var samplePayloads = map[string][2]string{
+	"Authorize":           {`{"idTag":"ABC123"}`, `{"idTagInfo":{"status":"Accepted"}}`},
+	"BootNotification":    {`{"chargePointVendor":"VendorX","chargePointModel":"ModelY"}`, `{"currentTime":"2025-01-02T03:04:05.000Z","interval":300,"status":"Accepted"}`},
+	"CancelReservation":   {`{"reservationId":7}`, `{"status":"Rejected"}`},
+	"ChangeAvailability":  {`{"connectorId":1,"type":"Inoperative"}`, `{"status":"Scheduled"}`},
+	"ChangeConfiguration": {`{"key":"HeartbeatInterval","value":"300"}`, `{"status":"RebootRequired"}`},
+	"ClearCache":          {`{}`, `{"status":"Accepted"}`},
+	"ClearChargingProfile": {`{"id":3,"connectorId":1,"chargingProfilePurpose":"TxProfile","stackLevel":2}`,
+		`{"status":"Unknown"}`},
+	"DataTransfer": {`{"vendorId":"com.example","messageId":"Ping","data":"{\"a\":1}"}`,
+		`{"status":"UnknownMessageId","data":"pong"}`},
+	"DiagnosticsStatusNotification": {`{"status":"Uploaded"}`, `{}`},
+	"FirmwareStatusNotification":    {`{"status":"Installed"}`, `{}`},
+	"GetCompositeSchedule": {`{"connectorId":1,"duration":3600,"chargingRateUnit":"W"}`,
+		`{"status":"Accepted","connectorId":1,"scheduleStart":"2025-01-02T03:04:05.000Z","chargingSchedule":` +
+			`{"duration":3600,"startSchedule":"2025-01-02T03:04:05.000Z","chargingRateUnit":"A","chargingSchedulePeriod":` +
+			`[{"startPeriod":0,"limit":16.5,"numberPhases":3},{"startPeriod":1800,"limit":8}],"minChargingRate":6.1}}`},
+	"GetConfiguration": {`{"key":["HeartbeatInterval","MeterValueSampleInterval"]}`,
+		`{"configurationKey":[{"key":"HeartbeatInterval","readonly":false,"value":"300"}],"unknownKey":["Foo"]}`},
+	"GetDiagnostics": {`{"location":"ftp://example.com/diagnostics","retries":3,"retryInterval":60,` +
+		`"startTime":"2025-01-01T00:00:00.000Z","stopTime":"2025-01-02T00:00:00.000Z"}`, `{"fileName":"diag.zip"}`},
+	"GetLocalListVersion": {`{}`, `{"listVersion":4}`},
+	"Heartbeat":           {`{}`, `{"currentTime":"2025-01-02T03:04:05.000Z"}`},
+	"MeterValues": {`{"connectorId":1,"transactionId":42,"meterValue":[{"timestamp":"2025-01-02T03:04:05.000Z",` +
+		`"sampledValue":[{"value":"1234","context":"Sample.Periodic","format":"Raw","measurand":"Energy.Active.Import.Register",` +
+		`"phase":"L1-N","location":"Outlet","unit":"Wh"}]}]}`, `{}`},
+	"RemoteStartTransaction": {`{"connectorId":1,"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,` +
+		`"stackLevel":0,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Recurring","recurrencyKind":"Daily",` +
+		`"validFrom":"2025-01-01T00:00:00.000Z","validTo":"2025-12-31T00:00:00.000Z","chargingSchedule":` +
+		`{"startSchedule":"2025-01-01T06:00:00.000Z","chargingRateUnit":"W","chargingSchedulePeriod":[{"startPeriod":0,"limit":11000}]}}}`,
+		`{"status":"Accepted"}`},
+	"RemoteStopTransaction": {`{"transactionId":42}`, `{"status":"Rejected"}`},
+	"ReserveNow": {`{"connectorId":1,"expiryDate":"2025-01-02T03:04:05.000Z","idTag":"ABC123","parentIdTag":"GROUP",` +
+		`"reservationId":9}`, `{"status":"Occupied"}`},
+	"Reset": {`{"type":"Soft"}`, `{"status":"Accepted"}`},
+	"SendLocalList": {`{"listVersion":2,"localAuthorizationList":[{"idTag":"ABC123","idTagInfo":{"status":"Accepted"}},` +
+		`{"idTag":"DEF456"}],"updateType":"Differential"}`, `{"status":"VersionMismatch"}`},
+	"SetChargingProfile": {`{"connectorId":0,"csChargingProfiles":{"chargingProfileId":2,"stackLevel":1,` +
+		`"chargingProfilePurpose":"ChargePointMaxProfile","chargingProfileKind":"Absolute","chargingSchedule":` +
+		`{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":32}]}}}`, `{"status":"NotSupported"}`},
+	"StartTransaction": {`{"connectorId":1,"idTag":"ABC123","meterStart":0,"reservationId":9,"timestamp":"2025-01-02T03:04:05.000Z"}`,
+		`{"idTagInfo":{"status":"Accepted","parentIdTag":"GROUP"},"transactionId":42}`},
+	"StatusNotification": {`{"connectorId":1,"errorCode":"NoError","info":"ok","status":"Charging",` +
+		`"timestamp":"2025-01-02T03:04:05.000Z","vendorId":"com.example","vendorErrorCode":"E0"}`, `{}`},
+	"StopTransaction": {`{"idTag":"ABC123","meterStop":1500,"timestamp":"2025-01-02T04:04:05.000Z","transactionId":42,` +
+		`"reason":"EVDisconnected","transactionData":[{"timestamp":"2025-01-02T04:04:05.000Z","sampledValue":[{"value":"1500"}]}]}`,
+		`{"idTagInfo":{"status":"Expired"}}`},
+	"TriggerMessage":  {`{"requestedMessage":"StatusNotification","connectorId":1}`, `{"status":"NotImplemented"}`},
+	"UnlockConnector": {`{"connectorId":1}`, `{"status":"UnlockFailed"}`},
+	"UpdateFirmware": {`{"location":"https://example.com/fw.bin","retries":2,"retrieveDate":"2025-01-02T03:04:05.000Z",` +
+		`"retryInterval":30}`, `{}`},
+}
+
+func TestStandardCoversEveryAction(t *testing.T) {
+	t.Parallel()
+
+	names := Standard().Actions()
+	if len(names) != len(samplePayloads) {
+		t.Errorf("expected %d standard actions, got %d: %v", len(samplePayloads), len(names), names)
+	}
+
+	for _, name := range names {
+		if _, ok := samplePayloads[name]; !ok {
+			t.Errorf("no sample payloads for action %s", name)
+		}
+	}
+}
+
+func TestStandardRoundTripsEveryAction(t *testing.T) {
+	t.Parallel()
+
+	reg := Standard()
+
+	for action, payloads := range samplePayloads {
+		decoders := []struct {
+			kind     string
+			decode   func(string, []byte) (Message, error)
+			validate func(string, []byte) error
+		}{
+			{"request", reg.DecodeRequest, schemas.ValidateRequest},
+			{"confirmation", reg.DecodeConfirmation, schemas.ValidateConfirmation},
+		}
+
+		for i, decoder := range decoders {
+			payload := payloads[i]
+
+			if err := decoder.validate(action, []byte(payload)); err != nil {
+				t.Errorf("%s %s: sample does not match the schema: %v", action, decoder.kind, err)
+			}
+
+			msg, err := decoder.decode(action, []byte(payload))
+			if err != nil {
+				t.Errorf("%s %s: unexpected decoding error: %v", action, decoder.kind, err)
+
+				continue
+			}
+
+			out, err := json.Marshal(msg)
+			if err != nil {
+				t.Errorf("%s %s: unexpected encoding error: %v", action, decoder.kind, err)
+
+				continue
+			}
+
+			if string(out) != payload {
+				t.Errorf("%s %s: unexpected round trip:\nwant: %s\ngot : %s", action, decoder.kind, payload, out)
+			}
+		}
+	}
+}
+
+func TestStandardReportsNestedFieldPaths(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"connectorId":1,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z","sampledValue":` +
+		`[{"value":"1"},{"value":"2","measurand":"Energy"}]}]}`
+
+	_, err := DecodeRequest("MeterValues", []byte(payload))
+
+	var verr *types.ValidationError
+	if !errors.As(err, &verr) || verr.Path != "meterValue[0].sampledValue[1].measurand" || verr.Constraint != types.ConstraintEnum {
+		t.Errorf("expected enum failure at meterValue[0].sampledValue[1].measurand, got %v", err)
+	}
+}
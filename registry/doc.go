@@ -0,0 +1,13 @@
+// Package registry maps OCPP 1.6J action names to their typed request and
+// confirmation messages.
+//
+// It provides a single entry point that takes an action name, such as "Authorize",
+// and a raw JSON payload, and returns the corresponding typed and validated message
+// (for example authorize.RequestMessage). All actions implemented by the messages
+// packages are registered by default, and vendor or extension actions can be added
+// with Register.
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/registry"
+package registry
@@ -0,0 +1,25 @@
+package registry_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aasanchez/ocpp16messages/messages/authorize"
+	"github.com/aasanchez/ocpp16messages/registry"
+)
+
+func ExampleDecodeRequest() {
+	msg, err := registry.DecodeRequest("Authorize", []byte(`{"idTag":"ABC123"}`))
+	if err != nil {
+		log.Fatalf("failed to decode request: %v", err)
+	}
+
+	switch req := msg.(type) {
+	case authorize.RequestMessage:
+		fmt.Println("Authorize.req for", req.IdTag.String())
+	default:
+		fmt.Println("unhandled request", req)
+	}
+	// Output:
+	// Authorize.req for ABC123
+}
@@ -0,0 +1,210 @@
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/aasanchez/ocpp16messages/ocppj"
+)
+
+// Predefined errors returned by the registry.
+var (
+	// ErrUnknownAction indicates that no message types are registered for an action.
+	// It is ocppj.ErrUnknownAction, so ocppj.ErrorCodeFor maps it to NotImplemented.
+	ErrUnknownAction = ocppj.ErrUnknownAction
+
+	// ErrDuplicateAction indicates that an action is already registered.
+	ErrDuplicateAction = errors.New("action already registered")
+
+	// ErrInvalidAction indicates that an action definition is incomplete.
+	ErrInvalidAction = errors.New("invalid action definition")
+)
+
+// Message is implemented by every typed OCPP request and confirmation message.
+type Message interface {
+	// Validate checks the message against the OCPP 1.6J constraints.
+	Validate() error
+
+	// String returns a human-readable representation of the message.
+	String() string
+}
+
+// decodeFunc decodes and validates a raw JSON payload into a typed Message.
+type decodeFunc func(data []byte) (Message, error)
+
+// Action describes the request and confirmation message types of an OCPP action.
+//
+// Use Define to build an Action from the Go types of its messages.
+type Action struct {
+	// Name is the OCPP action name, as carried in CALL frames (e.g. "Authorize").
+	Name string
+
+	decodeRequest      decodeFunc
+	decodeConfirmation decodeFunc
+}
+
+// Define returns the Action for name, decoding requests into Req and confirmations
+// into Conf.
+//
+// Decoding uses encoding/json, so Req and Conf should implement json.Unmarshaler
+// (as all types in the messages packages do). Every decoded message is validated
+// before it is returned.
+//
+// Example usage:
+//
+//	action := registry.Define[authorize.RequestMessage, authorize.ConfirmationMessage]("Authorize")
+func Define[Req, Conf Message](name string) Action {
+	return Action{
+		Name:               name,
+		decodeRequest:      decoder[Req](),
+		decodeConfirmation: decoder[Conf](),
+	}
+}
+
+// decoder returns a decodeFunc producing values of type T.
+func decoder[T Message]() decodeFunc {
+	return func(data []byte) (Message, error) {
+		var msg T
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return nil, err
+		}
+
+		if err := msg.Validate(); err != nil {
+			return nil, err
+		}
+
+		return msg, nil
+	}
+}
+
+// Registry holds the known actions. It is safe for concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	actions map[string]Action
+}
+
+// New returns an empty Registry.
+func New() *Registry {
+	return &Registry{actions: make(map[string]Action)}
+}
+
+// Standard returns a new Registry with every action implemented by this module
+// already registered.
+func Standard() *Registry {
+	reg := New()
+
+	for _, action := range builtin() {
+		if err := reg.Register(action); err != nil {
+			panic(err)
+		}
+	}
+
+	return reg
+}
+
+// Register adds an action to the registry.
+//
+// It fails with ErrInvalidAction if the action was not built with Define, and with
+// ErrDuplicateAction if an action with the same name is already registered.
+func (r *Registry) Register(action Action) error {
+	if action.Name == "" || action.decodeRequest == nil || action.decodeConfirmation == nil {
+		return fmt.Errorf("%w: %q", ErrInvalidAction, action.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.actions[action.Name]; exists {
+		return fmt.Errorf("%w: %s", ErrDuplicateAction, action.Name)
+	}
+
+	r.actions[action.Name] = action
+
+	return nil
+}
+
+// Lookup returns the registered action with the given name.
+func (r *Registry) Lookup(name string) (Action, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	action, ok := r.actions[name]
+
+	return action, ok
+}
+
+// Actions returns the names of all registered actions in lexical order.
+func (r *Registry) Actions() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.actions))
+	for name := range r.actions {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// DecodeRequest decodes payload into the typed request message of action.
+//
+// The returned Message holds the concrete value (e.g. authorize.RequestMessage)
+// and has already been validated. It fails with ErrUnknownAction if the action is
+// not registered.
+func (r *Registry) DecodeRequest(action string, payload []byte) (Message, error) {
+	def, ok := r.Lookup(action)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAction, action)
+	}
+
+	msg, err := def.decodeRequest(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s request: %w", action, err)
+	}
+
+	return msg, nil
+}
+
+// DecodeConfirmation decodes payload into the typed confirmation message of action.
+//
+// The returned Message holds the concrete value (e.g. authorize.ConfirmationMessage)
+// and has already been validated. It fails with ErrUnknownAction if the action is
+// not registered.
+func (r *Registry) DecodeConfirmation(action string, payload []byte) (Message, error) {
+	def, ok := r.Lookup(action)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAction, action)
+	}
+
+	msg, err := def.decodeConfirmation(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s confirmation: %w", action, err)
+	}
+
+	return msg, nil
+}
+
+// defaultRegistry backs the package-level functions.
+var defaultRegistry = Standard()
+
+// Register adds an action to the default registry. See Registry.Register.
+func Register(action Action) error {
+	return defaultRegistry.Register(action)
+}
+
+// DecodeRequest decodes a request payload using the default registry.
+// See Registry.DecodeRequest.
+func DecodeRequest(action string, payload []byte) (Message, error) {
+	return defaultRegistry.DecodeRequest(action, payload)
+}
+
+// DecodeConfirmation decodes a confirmation payload using the default registry.
+// See Registry.DecodeConfirmation.
+func DecodeConfirmation(action string, payload []byte) (Message, error) {
+	return defaultRegistry.DecodeConfirmation(action, payload)
+}
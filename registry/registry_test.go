@@ -0,0 +1,191 @@
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/messages/authorize"
+	"github.com/aasanchez/ocpp16messages/ocppj"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// vendorRequest is a minimal extension message used to exercise Register.
+type vendorRequest struct {
+	Code string `json:"code"`
+}
+
+func (v vendorRequest) Validate() error {
+	if v.Code == "" {
+		return types.ErrEmptyValueNotAllowed
+	}
+
+	return nil
+}
+
+func (v vendorRequest) String() string {
+	return "{code=" + v.Code + "}"
+}
+
+func TestDecodeRequestAuthorize(t *testing.T) {
+	t.Parallel()
+
+	msg, err := DecodeRequest("Authorize", []byte(`{"idTag":"ABC123"}`))
+	if err != nil {
+		t.Fatalf("unexpected error decoding request: %v", err)
+	}
+
+	req, ok := msg.(authorize.RequestMessage)
+	if !ok {
+		t.Fatalf("expected authorize.RequestMessage, got %T", msg)
+	}
+
+	if req.IdTag.String() != "ABC123" {
+		t.Errorf("expected idTag ABC123, got %s", req.IdTag.String())
+	}
+}
+
+func TestDecodeConfirmationAuthorize(t *testing.T) {
+	t.Parallel()
+
+	msg, err := DecodeConfirmation("Authorize", []byte(`{"idTagInfo":{"status":"Accepted"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error decoding confirmation: %v", err)
+	}
+
+	if _, ok := msg.(authorize.ConfirmationMessage); !ok {
+		t.Fatalf("expected authorize.ConfirmationMessage, got %T", msg)
+	}
+}
+
+func TestDecodeInvalidPayload(t *testing.T) {
+	t.Parallel()
+
+	if _, err := DecodeRequest("Authorize", []byte(`{"idTag":""}`)); !errors.Is(err, types.ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+
+	_, err := DecodeConfirmation("Authorize", []byte(`{"idTagInfo":{"status":"Nope"}}`))
+	if !errors.Is(err, types.ErrInvalidAuthorizationStatus) {
+		t.Errorf("expected ErrInvalidAuthorizationStatus, got %v", err)
+	}
+}
+
+func TestDecodeUnknownAction(t *testing.T) {
+	t.Parallel()
+
+	if _, err := DecodeRequest("Unknown", []byte(`{}`)); !errors.Is(err, ErrUnknownAction) {
+		t.Errorf("expected ErrUnknownAction, got %v", err)
+	}
+
+	if _, err := DecodeConfirmation("Unknown", []byte(`{}`)); !errors.Is(err, ErrUnknownAction) {
+		t.Errorf("expected ErrUnknownAction, got %v", err)
+	}
+}
+
+func TestRegisterVendorAction(t *testing.T) {
+	t.Parallel()
+
+	reg := Standard()
+	if err := reg.Register(Define[vendorRequest, vendorRequest]("VendorPing")); err != nil {
+		t.Fatalf("unexpected error registering action: %v", err)
+	}
+
+	msg, err := reg.DecodeRequest("VendorPing", []byte(`{"code":"42"}`))
+	if err != nil {
+		t.Fatalf("unexpected error decoding vendor request: %v", err)
+	}
+
+	if msg.String() != "{code=42}" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	// Types without a validating UnmarshalJSON are still validated after decoding.
+	if _, err := reg.DecodeConfirmation("VendorPing", []byte(`{}`)); !errors.Is(err, types.ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+
+	var syntaxErr *json.SyntaxError
+	if _, err := reg.DecodeRequest("VendorPing", []byte(`{`)); !errors.As(err, &syntaxErr) {
+		t.Errorf("expected json.SyntaxError, got %v", err)
+	}
+}
+
+func TestRegisterDuplicateAction(t *testing.T) {
+	t.Parallel()
+
+	reg := Standard()
+
+	err := reg.Register(Define[authorize.RequestMessage, authorize.ConfirmationMessage]("Authorize"))
+	if !errors.Is(err, ErrDuplicateAction) {
+		t.Errorf("expected ErrDuplicateAction, got %v", err)
+	}
+}
+
+func TestRegisterInvalidAction(t *testing.T) {
+	t.Parallel()
+
+	reg := New()
+
+	if err := reg.Register(Action{Name: "Incomplete"}); !errors.Is(err, ErrInvalidAction) {
+		t.Errorf("expected ErrInvalidAction, got %v", err)
+	}
+
+	if err := reg.Register(Define[vendorRequest, vendorRequest]("")); !errors.Is(err, ErrInvalidAction) {
+		t.Errorf("expected ErrInvalidAction, got %v", err)
+	}
+}
+
+func TestRegisterDefault(t *testing.T) {
+	t.Parallel()
+
+	if err := Register(Define[vendorRequest, vendorRequest]("DefaultVendorPing")); err != nil {
+		t.Fatalf("unexpected error registering action: %v", err)
+	}
+
+	if _, ok := defaultRegistry.Lookup("DefaultVendorPing"); !ok {
+		t.Error("expected action to be registered in the default registry")
+	}
+}
+
+func TestActionsSorted(t *testing.T) {
+	t.Parallel()
+
+	reg := New()
+	_ = reg.Register(Define[vendorRequest, vendorRequest]("Zeta"))
+	_ = reg.Register(Define[vendorRequest, vendorRequest]("Alpha"))
+
+	names := reg.Actions()
+	if len(names) != 2 || names[0] != "Alpha" || names[1] != "Zeta" {
+		t.Errorf("unexpected actions: %v", names)
+	}
+}
+
+func TestDecodeErrorsMapToErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	_, err := DecodeRequest("Authorize", []byte(`{"idTag":`))
+	if got := ocppj.ErrorCodeFor(err); got != ocppj.FormationViolation {
+		t.Errorf("expected %s for malformed JSON, got %s (%v)", ocppj.FormationViolation, got, err)
+	}
+
+	_, err = DecodeRequest("Authorize", []byte(`{"idTag":12}`))
+	if got := ocppj.ErrorCodeFor(err); got != ocppj.TypeConstraintViolation {
+		t.Errorf("expected %s for wrong JSON type, got %s (%v)", ocppj.TypeConstraintViolation, got, err)
+	}
+
+	_, err = DecodeRequest("Authorize", []byte(`{}`))
+	if got := ocppj.ErrorCodeFor(err); got != ocppj.OccurenceConstraintViolation {
+		t.Errorf("expected %s for missing idTag, got %s (%v)", ocppj.OccurenceConstraintViolation, got, err)
+	}
+
+	_, err = DecodeRequest("Authorize", []byte(`{"idTag":"ABCDEFGHIJKLMNOPQRSTUVWXYZ"}`))
+	if got := ocppj.ErrorCodeFor(err); got != ocppj.PropertyConstraintViolation {
+		t.Errorf("expected %s for too long idTag, got %s (%v)", ocppj.PropertyConstraintViolation, got, err)
+	}
+
+	_, err = DecodeRequest("FooBar", []byte(`{}`))
+	if got := ocppj.ErrorCodeFor(err); got != ocppj.NotImplemented {
+		t.Errorf("expected %s for unknown action, got %s (%v)", ocppj.NotImplemented, got, err)
+	}
+}
@@ -0,0 +1,238 @@
+package schemas
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+)
+
+// Errors reported while compiling a schema document. They are wrapped in
+// ErrInvalidSchema by Compile.
+var (
+	errUnsupportedKeyword = errors.New("unsupported keyword")
+	errInvalidKeyword     = errors.New("invalid keyword value")
+)
+
+// Instance types known to draft-04.
+const (
+	typeArray   = "array"
+	typeBoolean = "boolean"
+	typeInteger = "integer"
+	typeNull    = "null"
+	typeNumber  = "number"
+	typeObject  = "object"
+	typeString  = "string"
+)
+
+// Formats checked by the validator.
+const (
+	formatDateTime = "date-time"
+	formatURI      = "uri"
+)
+
+// node is a compiled schema or subschema. Unset keywords are nil or empty.
+type node struct {
+	types                []string
+	properties           map[string]*node
+	required             []string
+	additionalProperties *bool
+	items                *node
+	minItems             *int
+	maxItems             *int
+	enum                 []string
+	minLength            *int
+	maxLength            *int
+	pattern              *regexp.Regexp
+	minimum              *big.Rat
+	maximum              *big.Rat
+	exclusiveMinimum     bool
+	exclusiveMaximum     bool
+	multipleOf           *big.Rat
+	format               string
+}
+
+// compileKeyword decodes the value of one keyword into n.
+func compileKeyword(n *node, name string, raw json.RawMessage) error {
+	switch name {
+	case "$schema", "id", "title", "description":
+		return nil
+	case "type":
+		return compileType(n, raw)
+	case "properties":
+		return compileProperties(n, raw)
+	case "required":
+		return decodeKeyword(raw, &n.required)
+	case "additionalProperties":
+		return decodeKeyword(raw, &n.additionalProperties)
+	case "items":
+		items, err := compile(raw)
+		n.items = items
+
+		return err
+	case "minItems":
+		return decodeKeyword(raw, &n.minItems)
+	case "maxItems":
+		return decodeKeyword(raw, &n.maxItems)
+	case "enum":
+		return decodeKeyword(raw, &n.enum)
+	case "minLength":
+		return decodeKeyword(raw, &n.minLength)
+	case "maxLength":
+		return decodeKeyword(raw, &n.maxLength)
+	case "pattern":
+		return compilePattern(n, raw)
+	case "minimum":
+		return decodeRat(raw, &n.minimum)
+	case "maximum":
+		return decodeRat(raw, &n.maximum)
+	case "exclusiveMinimum":
+		return decodeKeyword(raw, &n.exclusiveMinimum)
+	case "exclusiveMaximum":
+		return decodeKeyword(raw, &n.exclusiveMaximum)
+	case "multipleOf":
+		return compileMultipleOf(n, raw)
+	case "format":
+		return compileFormat(n, raw)
+	default:
+		return fmt.Errorf("%w: %s", errUnsupportedKeyword, name)
+	}
+}
+
+// compile compiles a schema document or subschema.
+func compile(data []byte) (*node, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(doc))
+	for name := range doc {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	compiled := &node{}
+
+	for _, name := range names {
+		if err := compileKeyword(compiled, name, doc[name]); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return compiled, nil
+}
+
+// decodeKeyword decodes a keyword value into target, rejecting unknown shapes.
+func decodeKeyword(raw json.RawMessage, target any) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidKeyword, err)
+	}
+
+	return nil
+}
+
+// decodeRat decodes a JSON number keyword into an exact rational value.
+func decodeRat(raw json.RawMessage, target **big.Rat) error {
+	var number json.Number
+	if err := decodeKeyword(raw, &number); err != nil {
+		return err
+	}
+
+	value, ok := new(big.Rat).SetString(number.String())
+	if !ok {
+		return fmt.Errorf("%w: %s is not a number", errInvalidKeyword, number)
+	}
+
+	*target = value
+
+	return nil
+}
+
+// compileType accepts both a single type name and a list of type names.
+func compileType(n *node, raw json.RawMessage) error {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		n.types = []string{single}
+	} else if err := decodeKeyword(raw, &n.types); err != nil {
+		return err
+	}
+
+	for _, name := range n.types {
+		switch name {
+		case typeArray, typeBoolean, typeInteger, typeNull, typeNumber, typeObject, typeString:
+		default:
+			return fmt.Errorf("%w: unknown type %q", errInvalidKeyword, name)
+		}
+	}
+
+	return nil
+}
+
+func compileProperties(n *node, raw json.RawMessage) error {
+	var properties map[string]json.RawMessage
+	if err := decodeKeyword(raw, &properties); err != nil {
+		return err
+	}
+
+	n.properties = make(map[string]*node, len(properties))
+
+	for name, property := range properties {
+		compiled, err := compile(property)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		n.properties[name] = compiled
+	}
+
+	return nil
+}
+
+func compileMultipleOf(n *node, raw json.RawMessage) error {
+	if err := decodeRat(raw, &n.multipleOf); err != nil {
+		return err
+	}
+
+	if n.multipleOf.Sign() <= 0 {
+		return fmt.Errorf("%w: multipleOf must be greater than 0", errInvalidKeyword)
+	}
+
+	return nil
+}
+
+func compilePattern(n *node, raw json.RawMessage) error {
+	var pattern string
+	if err := decodeKeyword(raw, &pattern); err != nil {
+		return err
+	}
+
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidKeyword, err)
+	}
+
+	n.pattern = compiled
+
+	return nil
+}
+
+func compileFormat(n *node, raw json.RawMessage) error {
+	if err := decodeKeyword(raw, &n.format); err != nil {
+		return err
+	}
+
+	switch n.format {
+	case formatDateTime, formatURI:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown format %q", errUnsupportedKeyword, n.format)
+	}
+}
@@ -0,0 +1,52 @@
+package schemas
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCompileRejectsUnsupportedSchemas(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{"unsupported keyword", `{"type":"object","oneOf":[]}`},
+		{"nested unsupported keyword", `{"properties":{"a":{"$ref":"#/definitions/a"}}}`},
+		{"unknown type", `{"type":"decimal"}`},
+		{"invalid type list", `{"type":["string",1]}`},
+		{"schema additionalProperties", `{"additionalProperties":{"type":"string"}}`},
+		{"non-string enum", `{"enum":[1,2]}`},
+		{"zero multipleOf", `{"multipleOf":0}`},
+		{"invalid pattern", `{"pattern":"("}`},
+		{"unknown format", `{"format":"email"}`},
+		{"invalid maxLength", `{"maxLength":"20"}`},
+		{"not an object", `[]`},
+	}
+
+	for _, tc := range tests {
+		if _, err := Compile(tc.name, []byte(tc.schema)); !errors.Is(err, ErrInvalidSchema) {
+			t.Errorf("%s: expected ErrInvalidSchema, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestCompileTypeList(t *testing.T) {
+	t.Parallel()
+
+	schema, err := Compile("nullable", []byte(`{"type":["string","null"],"maxLength":3}`))
+	if err != nil {
+		t.Fatalf("unexpected error compiling schema: %v", err)
+	}
+
+	for _, payload := range []string{`"abc"`, `null`} {
+		if err := schema.Validate([]byte(payload)); err != nil {
+			t.Errorf("expected %s to be valid, got %v", payload, err)
+		}
+	}
+
+	if err := schema.Validate([]byte(`1`)); !errors.Is(err, ErrSchemaViolation) {
+		t.Errorf("expected ErrSchemaViolation for number, got %v", err)
+	}
+}
@@ -0,0 +1,19 @@
+// Package schemas embeds the official OCPP 1.6J JSON schemas and validates raw
+// payloads against them.
+//
+// The schemas are the ones published by the Open Charge Alliance for OCPP 1.6
+// (edition 2, errata 2019-12), one per request ("Authorize") and confirmation
+// ("AuthorizeResponse"). They are checked by a small JSON Schema draft-04 validator
+// built on the standard library only.
+//
+// The validator is deliberately independent from the hand-written validation of
+// the messages packages: it never uses the Go message types. Running both on the
+// same payload (for example on captured traffic in CI) reveals where the Go rules
+// drift from the schemas. Note that the Go rules are intentionally stricter in a few
+// places, such as rejecting empty or non-printable CiString values, which the
+// schemas allow.
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/schemas"
+package schemas
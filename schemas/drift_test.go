@@ -0,0 +1,285 @@
+package schemas_test
+
+import (
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/registry"
+	"github.com/aasanchez/ocpp16messages/schemas"
+)
+
+// driftCase is a payload checked by both the schemas and the Go messages.
+type driftCase struct {
+	action       string
+	confirmation bool
+	payload      string
+	valid        bool
+}
+
+// check runs both validators on the payload and returns their verdicts.
+func (c driftCase) check() (schemaErr, goErr error) {
+	if c.confirmation {
+		_, goErr = registry.DecodeConfirmation(c.action, []byte(c.payload))
+
+		return schemas.ValidateConfirmation(c.action, []byte(c.payload)), goErr
+	}
+
+	_, goErr = registry.DecodeRequest(c.action, []byte(c.payload))
+
+	return schemas.ValidateRequest(c.action, []byte(c.payload)), goErr
+}
+
+// TestGoValidationAgreesWithSchemas fails when the hand-written validation of the
+// messages packages drifts from the official schemas.
+func TestGoValidationAgreesWithSchemas(t *testing.T) {
+	t.Parallel()
+
+	tests := []driftCase{
+		{"Authorize", false, `{"idTag":"ABC123"}`, true},
+		{"Authorize", false, `{}`, false},
+		{"Authorize", false, `{"idTag":"ABC1234567890123456789"}`, false},
+		{"Authorize", false, `{"idTag":42}`, false},
+		{"Authorize", true, `{"idTagInfo":{"status":"Accepted"}}`, true},
+		{"Authorize", true, `{"idTagInfo":{"status":"Blocked","expiryDate":"2025-04-01T10:00:00Z","parentIdTag":"GROUP"}}`, true},
+		{"Authorize", true, `{"idTagInfo":{"status":"Unknown"}}`, false},
+		{"Authorize", true, `{"idTagInfo":{"status":"Accepted","expiryDate":"tomorrow"}}`, false},
+		{"Authorize", true, `{"idTagInfo":{}}`, false},
+		{"Authorize", true, `{}`, false},
+		{"BootNotification", false, `{"chargePointVendor":"VendorX","chargePointModel":"ModelY"}`, true},
+		{"BootNotification", false, `{"chargePointVendor":"VendorX","chargePointModel":"ModelY",` +
+			`"chargePointSerialNumber":"CP-001","firmwareVersion":"1.4.2","meterType":"DIN"}`, true},
+		{"BootNotification", false, `{"chargePointVendor":"VendorX"}`, false},
+		{"BootNotification", false, `{"chargePointVendor":"VendorX","chargePointModel":"ModelY",` +
+			`"meterSerialNumber":"12345678901234567890123456"}`, false},
+		{"BootNotification", true, `{"currentTime":"2025-01-02T03:04:05Z","interval":300,"status":"Accepted"}`, true},
+		{"BootNotification", true, `{"currentTime":"2025-01-02T03:04:05Z","interval":300,"status":"Registered"}`, false},
+		{"BootNotification", true, `{"currentTime":"2025-01-02T03:04:05Z","interval":"300","status":"Pending"}`, false},
+		{"BootNotification", true, `{"currentTime":"2025-01-02T03:04:05Z","status":"Pending"}`, false},
+		{"ChangeAvailability", false, `{"connectorId":0,"type":"Inoperative"}`, true},
+		{"ChangeAvailability", false, `{"connectorId":1,"type":"Offline"}`, false},
+		{"ChangeAvailability", false, `{"type":"Operative"}`, false},
+		{"ChangeAvailability", true, `{"status":"Scheduled"}`, true},
+		{"ChangeAvailability", true, `{"status":"Pending"}`, false},
+		{"ClearCache", false, `{}`, true},
+		{"ClearCache", true, `{"status":"Accepted"}`, true},
+		{"ClearCache", true, `{"status":"Scheduled"}`, false},
+		{"ClearChargingProfile", false, `{}`, true},
+		{"ClearChargingProfile", false, `{"connectorId":1,"chargingProfilePurpose":"TxDefaultProfile","stackLevel":0}`, true},
+		{"ClearChargingProfile", false, `{"chargingProfilePurpose":"TxDefault"}`, false},
+		{"ClearChargingProfile", true, `{"status":"Unknown"}`, true},
+		{"ClearChargingProfile", true, `{"status":"Rejected"}`, false},
+		{"ChangeConfiguration", false, `{"key":"HeartbeatInterval","value":"300"}`, true},
+		{"ChangeConfiguration", false, `{"key":"HeartbeatInterval"}`, false},
+		{"ChangeConfiguration", false, `{"key":"HeartbeatInterval","value":300}`, false},
+		{"ChangeConfiguration", true, `{"status":"RebootRequired"}`, true},
+		{"ChangeConfiguration", true, `{"status":"Unknown"}`, false},
+		{"DataTransfer", false, `{"vendorId":"com.example","messageId":"Price","data":"{\"tariff\":\"Night\"}"}`, true},
+		{"DataTransfer", false, `{"vendorId":"com.example","data":{"tariff":"Night"}}`, false},
+		{"DataTransfer", false, `{"messageId":"Price"}`, false},
+		{"DataTransfer", true, `{"status":"UnknownVendorId"}`, true},
+		{"DataTransfer", true, `{"status":"Unknown"}`, false},
+		{"GetCompositeSchedule", false, `{"connectorId":1,"duration":3600,"chargingRateUnit":"W"}`, true},
+		{"GetCompositeSchedule", false, `{"connectorId":1}`, false},
+		{"GetCompositeSchedule", false, `{"connectorId":1,"duration":3600,"chargingRateUnit":"kW"}`, false},
+		{"GetCompositeSchedule", true, `{"status":"Accepted","connectorId":1,"scheduleStart":"2025-01-02T03:00:00Z",` +
+			`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}`, true},
+		{"GetCompositeSchedule", true, `{"status":"Rejected"}`, true},
+		{"GetCompositeSchedule", true, `{"status":"Accepted","chargingSchedule":{"chargingRateUnit":"A"}}`, false},
+		{"GetConfiguration", false, `{}`, true},
+		{"GetConfiguration", false, `{"key":["HeartbeatInterval","NumberOfConnectors"]}`, true},
+		{"GetConfiguration", false, `{"key":["ABCDEFGHIJABCDEFGHIJABCDEFGHIJABCDEFGHIJABCDEFGHIJX"]}`, false},
+		{"GetConfiguration", true, `{"configurationKey":[{"key":"HeartbeatInterval","readonly":false,"value":"300"}],` +
+			`"unknownKey":["Foo"]}`, true},
+		{"GetConfiguration", true, `{"configurationKey":[{"key":"HeartbeatInterval"}]}`, false},
+		{"GetConfiguration", true, `{"configurationKey":[{"key":"HeartbeatInterval","readonly":"false"}]}`, false},
+		{"GetLocalListVersion", false, `{}`, true},
+		{"GetLocalListVersion", true, `{"listVersion":-1}`, true},
+		{"GetLocalListVersion", true, `{"listVersion":12}`, true},
+		{"GetLocalListVersion", true, `{}`, false},
+		{"MeterValues", false, `{"connectorId":0,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z","sampledValue":` +
+			`[{"value":"1500","context":"Sample.Clock","measurand":"Energy.Active.Import.Register","location":"Inlet","unit":"kWh"}]}]}`, true},
+		{"MeterValues", false, `{"connectorId":1,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z","sampledValue":` +
+			`[{"value":"1500","measurand":"Energy.Active.Import.Registers"}]}]}`, false},
+		{"MeterValues", false, `{"connectorId":1,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z"}]}`, false},
+		{"MeterValues", false, `{"connectorId":1}`, false},
+		{"MeterValues", true, `{}`, true},
+		{"RemoteStartTransaction", false, `{"idTag":"ABC123"}`, true},
+		{"RemoteStartTransaction", false, `{"connectorId":1,"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,` +
+			`"stackLevel":0,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+			`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`, true},
+		{"RemoteStartTransaction", false, `{"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,` +
+			`"stackLevel":0,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+			`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16.05}]}}}`, false},
+		{"RemoteStartTransaction", false, `{"connectorId":1}`, false},
+		{"RemoteStartTransaction", true, `{"status":"Accepted"}`, true},
+		{"RemoteStartTransaction", true, `{"status":"Pending"}`, false},
+		{"RemoteStopTransaction", false, `{"transactionId":42}`, true},
+		{"RemoteStopTransaction", false, `{}`, false},
+		{"RemoteStopTransaction", true, `{"status":"Rejected"}`, true},
+		{"Reset", false, `{"type":"Hard"}`, true},
+		{"Reset", false, `{"type":"Warm"}`, false},
+		{"Reset", false, `{}`, false},
+		{"Reset", true, `{"status":"Rejected"}`, true},
+		{"Reset", true, `{}`, false},
+		{"SetChargingProfile", false, `{"connectorId":0,"csChargingProfiles":{"chargingProfileId":1,"stackLevel":0,` +
+			`"chargingProfilePurpose":"ChargePointMaxProfile","chargingProfileKind":"Recurring","recurrencyKind":"Daily",` +
+			`"chargingSchedule":{"startSchedule":"2025-01-01T00:00:00Z","chargingRateUnit":"W",` +
+			`"chargingSchedulePeriod":[{"startPeriod":0,"limit":22000},{"startPeriod":28800,"limit":11000}]}}}`, true},
+		{"SetChargingProfile", false, `{"connectorId":1,"csChargingProfiles":{"chargingProfileId":1,"transactionId":42,` +
+			`"stackLevel":0,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+			`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`, true},
+		{"SetChargingProfile", false, `{"connectorId":1,"csChargingProfiles":{"chargingProfileId":1,"stackLevel":0,` +
+			`"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Daily",` +
+			`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`, false},
+		{"SetChargingProfile", false, `{"connectorId":1}`, false},
+		{"SetChargingProfile", true, `{"status":"NotSupported"}`, true},
+		{"SetChargingProfile", true, `{"status":"Unknown"}`, false},
+		{"SendLocalList", false, `{"listVersion":1,"updateType":"Full"}`, true},
+		{"SendLocalList", false, `{"listVersion":2,"localAuthorizationList":[{"idTag":"ABC123",` +
+			`"idTagInfo":{"status":"Accepted","expiryDate":"2025-12-31T00:00:00Z"}},{"idTag":"DEF456"}],` +
+			`"updateType":"Differential"}`, true},
+		{"SendLocalList", false, `{"listVersion":2,"updateType":"Partial"}`, false},
+		{"SendLocalList", false, `{"listVersion":2,"localAuthorizationList":[{"idTagInfo":{"status":"Accepted"}}],` +
+			`"updateType":"Differential"}`, false},
+		{"SendLocalList", true, `{"status":"VersionMismatch"}`, true},
+		{"SendLocalList", true, `{"status":"Rejected"}`, false},
+		{"StartTransaction", false, `{"connectorId":1,"idTag":"ABC123","meterStart":0,"timestamp":"2025-01-02T03:04:05Z"}`, true},
+		{"StartTransaction", false, `{"connectorId":1,"idTag":"ABC123","timestamp":"2025-01-02T03:04:05Z"}`, false},
+		{"StartTransaction", true, `{"idTagInfo":{"status":"Accepted"},"transactionId":42}`, true},
+		{"StartTransaction", true, `{"idTagInfo":{"status":"Accepted"}}`, false},
+		{"StopTransaction", false, `{"meterStop":1500,"timestamp":"2025-01-02T03:04:05Z","transactionId":42,"reason":"Local"}`, true},
+		{"StopTransaction", false, `{"meterStop":1500,"timestamp":"2025-01-02T03:04:05Z","transactionId":42,"reason":"Unplugged"}`, false},
+		{"StopTransaction", false, `{"meterStop":1500,"timestamp":"2025-01-02T03:04:05Z","transactionId":42,` +
+			`"transactionData":[{"timestamp":"2025-01-02T03:04:05Z","sampledValue":[{"value":"1","unit":"kWh/h"}]}]}`, false},
+		{"StopTransaction", true, `{}`, true},
+		{"StatusNotification", false, `{"connectorId":1,"errorCode":"NoError","status":"Charging"}`, true},
+		{"StatusNotification", false, `{"connectorId":0,"errorCode":"OtherError","info":"ground fault","status":"Faulted",` +
+			`"timestamp":"2025-01-02T03:04:05Z","vendorId":"com.example","vendorErrorCode":"E42"}`, true},
+		{"StatusNotification", false, `{"connectorId":1,"errorCode":"Overheated","status":"Faulted"}`, false},
+		{"StatusNotification", false, `{"connectorId":1,"errorCode":"NoError","status":"Occupied"}`, false},
+		{"StatusNotification", false, `{"connectorId":1,"status":"Available"}`, false},
+		{"StatusNotification", true, `{}`, true},
+		{"UnlockConnector", false, `{"connectorId":1}`, true},
+		{"UnlockConnector", false, `{"connectorId":"1"}`, false},
+		{"UnlockConnector", true, `{"status":"NotSupported"}`, true},
+		{"UnlockConnector", true, `{"status":"Accepted"}`, false},
+	}
+
+	for _, tc := range tests {
+		schemaErr, goErr := tc.check()
+
+		if (schemaErr == nil) != tc.valid {
+			t.Errorf("%s %s: schema verdict %v, want valid=%v", tc.action, tc.payload, schemaErr, tc.valid)
+		}
+
+		if (goErr == nil) != tc.valid {
+			t.Errorf("%s %s: Go verdict %v, want valid=%v", tc.action, tc.payload, goErr, tc.valid)
+		}
+	}
+}
+
+// TestGoValidationKnownDifferences documents where the Go rules are intentionally
+// stricter or more lenient than the schemas, so that a change on either side is noticed.
+func TestGoValidationKnownDifferences(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		reason string
+		driftCase
+		schemaValid bool
+	}{
+		{"CiString values must not be empty", driftCase{"Authorize", false, `{"idTag":""}`, false}, true},
+		{"CiString values must be printable ASCII", driftCase{"Authorize", false, `{"idTag":"café"}`, false}, true},
+		{"dateTime without offset is read as UTC",
+			driftCase{"Authorize", true, `{"idTagInfo":{"status":"Accepted","expiryDate":"2025-04-01T10:00:00"}}`, true}, false},
+		{"interval must not be negative",
+			driftCase{"BootNotification", true, `{"currentTime":"2025-01-02T03:04:05Z","interval":-1,"status":"Accepted"}`, false}, true},
+		{"transactions take place on a connector greater than 0",
+			driftCase{"StartTransaction", false, `{"connectorId":0,"idTag":"ABC123","meterStart":0,"timestamp":"2025-01-02T03:04:05Z"}`, false}, true},
+		{"meterStart must not be negative",
+			driftCase{"StartTransaction", false, `{"connectorId":1,"idTag":"ABC123","meterStart":-1,"timestamp":"2025-01-02T03:04:05Z"}`, false}, true},
+		{"meter values need at least one meterValue",
+			driftCase{"MeterValues", false, `{"connectorId":1,"meterValue":[]}`, false}, true},
+		{"meter values need at least one sampledValue",
+			driftCase{"MeterValues", false, `{"connectorId":1,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z","sampledValue":[]}]}`, false}, true},
+		{"Raw sampled values must be decimal numbers",
+			driftCase{"MeterValues", false, `{"connectorId":1,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z","sampledValue":` +
+				`[{"value":"12 kWh"}]}]}`, false}, true},
+		{"connectorId must not be negative",
+			driftCase{"MeterValues", false, `{"connectorId":-1,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z","sampledValue":` +
+				`[{"value":"1"}]}]}`, false}, true},
+		{"connector 0 can only be Available, Unavailable or Faulted",
+			driftCase{"StatusNotification", false, `{"connectorId":0,"errorCode":"NoError","status":"Reserved"}`, false}, true},
+		{"status connectorId must not be negative",
+			driftCase{"StatusNotification", false, `{"connectorId":-1,"errorCode":"NoError","status":"Available"}`, false}, true},
+		{"remotely started transactions only accept a TxProfile",
+			driftCase{"RemoteStartTransaction", false, `{"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,` +
+				`"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Relative",` +
+				`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`, false}, true},
+		{"remote start connectorId must be greater than 0",
+			driftCase{"RemoteStartTransaction", false, `{"connectorId":0,"idTag":"ABC123"}`, false}, true},
+		{"charging schedules need at least one period",
+			driftCase{"RemoteStartTransaction", false, `{"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,` +
+				`"stackLevel":0,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+				`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[]}}}`, false}, true},
+		{"charging limits must not be negative",
+			driftCase{"RemoteStartTransaction", false, `{"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,` +
+				`"stackLevel":0,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+				`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":-16}]}}}`, false}, true},
+		{"connectors are unlocked from connector 1 upwards",
+			driftCase{"UnlockConnector", false, `{"connectorId":0}`, false}, true},
+		{"availability connectorId must not be negative",
+			driftCase{"ChangeAvailability", false, `{"connectorId":-1,"type":"Operative"}`, false}, true},
+		{"charging schedule periods start at 0",
+			driftCase{"SetChargingProfile", false, `{"connectorId":0,"csChargingProfiles":{"chargingProfileId":1,` +
+				`"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Relative",` +
+				`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":60,"limit":16}]}}}`, false}, true},
+		{"charging schedule periods have ascending startPeriod",
+			driftCase{"SetChargingProfile", false, `{"connectorId":0,"csChargingProfiles":{"chargingProfileId":1,` +
+				`"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Relative",` +
+				`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16},` +
+				`{"startPeriod":600,"limit":10},{"startPeriod":300,"limit":8}]}}}`, false}, true},
+		{"validFrom must be before validTo",
+			driftCase{"SetChargingProfile", false, `{"connectorId":0,"csChargingProfiles":{"chargingProfileId":1,` +
+				`"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Relative",` +
+				`"validFrom":"2025-02-01T00:00:00Z","validTo":"2025-01-01T00:00:00Z",` +
+				`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`, false}, true},
+		{"recurring profiles need a startSchedule",
+			driftCase{"SetChargingProfile", false, `{"connectorId":0,"csChargingProfiles":{"chargingProfileId":1,` +
+				`"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Recurring",` +
+				`"recurrencyKind":"Daily",` + `"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`, false}, true},
+		{"a TxProfile sent with SetChargingProfile needs a transactionId",
+			driftCase{"SetChargingProfile", false, `{"connectorId":1,"csChargingProfiles":{"chargingProfileId":1,` +
+				`"stackLevel":0,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+				`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`, false}, true},
+		{"a ChargePointMaxProfile is set on connector 0",
+			driftCase{"SetChargingProfile", false, `{"connectorId":1,"csChargingProfiles":{"chargingProfileId":1,` +
+				`"stackLevel":0,"chargingProfilePurpose":"ChargePointMaxProfile","chargingProfileKind":"Relative",` +
+				`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`, false}, true},
+		{"a remotely started TxProfile has no transactionId yet",
+			driftCase{"RemoteStartTransaction", false, `{"idTag":"ABC123","chargingProfile":{"chargingProfileId":1,` +
+				`"transactionId":5,"stackLevel":0,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",` +
+				`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}}`, false}, true},
+		{"composite schedule duration must not be negative",
+			driftCase{"GetCompositeSchedule", false, `{"connectorId":0,"duration":-1}`, false}, true},
+		{"clear profile stackLevel must not be negative",
+			driftCase{"ClearChargingProfile", false, `{"stackLevel":-1}`, false}, true},
+		{"SendLocalList listVersion 0 means an empty list",
+			driftCase{"SendLocalList", false, `{"listVersion":0,"updateType":"Full"}`, false}, true},
+		{"a Full update cannot remove entries",
+			driftCase{"SendLocalList", false, `{"listVersion":1,"localAuthorizationList":[{"idTag":"ABC123"}],` +
+				`"updateType":"Full"}`, false}, true},
+		{"GetLocalListVersion reserves only 0 and -1",
+			driftCase{"GetLocalListVersion", true, `{"listVersion":-2}`, false}, true},
+		{"meterStop must not be negative",
+			driftCase{"StopTransaction", false, `{"meterStop":-1,"timestamp":"2025-01-02T03:04:05Z","transactionId":1}`, false}, true},
+	}
+
+	for _, tc := range tests {
+		schemaErr, goErr := tc.check()
+
+		if (schemaErr == nil) != tc.schemaValid || (goErr == nil) != tc.valid {
+			t.Errorf("%s: schema verdict %v (want valid=%v), Go verdict %v (want valid=%v)",
+				tc.reason, schemaErr, tc.schemaValid, goErr, tc.valid)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package schemas_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aasanchez/ocpp16messages/schemas"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleValidateRequest() {
+	err := schemas.ValidateRequest("Authorize", []byte(`{"idTag":"ABC1234567890123456789"}`))
+
+	var verr *types.ValidationError
+	if errors.As(err, &verr) {
+		fmt.Println(verr.Path, verr.Constraint)
+	}
+
+	fmt.Println(err)
+	// Output:
+	// idTag maxLength=20
+	// idTag: schema violation: length 22 exceeds 20
+}
+
+func ExampleSchema_Validate() {
+	schema, err := schemas.Confirmation("BootNotification")
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(schema.Validate([]byte(`{"currentTime":"2025-01-02T03:04:05Z","interval":300,"status":"Accepted"}`)))
+	// Output:
+	// <nil>
+}
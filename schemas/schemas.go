@@ -0,0 +1,154 @@
+package schemas
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Predefined errors returned by the schemas package.
+var (
+	// ErrUnknownSchema indicates that no embedded schema exists for a name or action.
+	ErrUnknownSchema = errors.New("unknown schema")
+
+	// ErrInvalidSchema indicates that a schema document uses a keyword or value the
+	// validator does not support.
+	ErrInvalidSchema = errors.New("invalid schema")
+
+	// ErrSchemaViolation indicates that a payload does not conform to its schema.
+	ErrSchemaViolation = errors.New("schema violation")
+)
+
+// confirmationSuffix is appended to the action name in the file and title of
+// confirmation schemas, following the naming of the official distribution.
+const confirmationSuffix = "Response"
+
+//go:embed json/*.json
+var files embed.FS
+
+// Schema is a compiled OCPP 1.6J JSON schema. It is safe for concurrent use.
+type Schema struct {
+	name string
+	root *node
+}
+
+// Name returns the name of the schema, which is the base name of its file in the
+// official distribution (e.g. "Authorize" or "AuthorizeResponse").
+func (s *Schema) Name() string {
+	return s.name
+}
+
+// String returns the name of the schema.
+func (s *Schema) String() string {
+	return s.name
+}
+
+// embedded holds every embedded schema, compiled once at package initialization.
+var embedded = mustCompileEmbedded()
+
+// mustCompileEmbedded compiles all embedded schema files. It panics if one of them
+// cannot be compiled, which is caught by the tests of this package.
+func mustCompileEmbedded() map[string]*Schema {
+	entries, err := files.ReadDir("json")
+	if err != nil {
+		panic(err)
+	}
+
+	compiled := make(map[string]*Schema, len(entries))
+
+	for _, entry := range entries {
+		data, err := files.ReadFile(path.Join("json", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+
+		name := strings.TrimSuffix(entry.Name(), ".json")
+
+		schema, err := Compile(name, data)
+		if err != nil {
+			panic(err)
+		}
+
+		compiled[name] = schema
+	}
+
+	return compiled
+}
+
+// Compile compiles a JSON Schema draft-04 document, so that it can be used to
+// validate payloads that are not covered by the embedded schemas, such as vendor
+// specific DataTransfer data.
+//
+// Only the keywords used by the OCPP 1.6J schemas are supported: type, properties,
+// required, additionalProperties (as a boolean), items, minItems, maxItems, enum
+// (of strings), minLength, maxLength, pattern, minimum, maximum, exclusiveMinimum,
+// exclusiveMaximum, multipleOf and format ("date-time" and "uri"). The annotations
+// $schema, id, title and description are ignored. Any other keyword fails with
+// ErrInvalidSchema, so that a schema is never silently validated only in part.
+func Compile(name string, data []byte) (*Schema, error) {
+	root, err := compile(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidSchema, name, err)
+	}
+
+	return &Schema{name: name, root: root}, nil
+}
+
+// Lookup returns the embedded schema with the given name, for example "Authorize"
+// or "AuthorizeResponse". It fails with ErrUnknownSchema if there is none.
+func Lookup(name string) (*Schema, error) {
+	schema, ok := embedded[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSchema, name)
+	}
+
+	return schema, nil
+}
+
+// Request returns the schema of the request message of action (e.g. "Authorize").
+func Request(action string) (*Schema, error) {
+	return Lookup(action)
+}
+
+// Confirmation returns the schema of the confirmation message of action
+// (e.g. "AuthorizeResponse" for "Authorize").
+func Confirmation(action string) (*Schema, error) {
+	return Lookup(action + confirmationSuffix)
+}
+
+// Names returns the names of all embedded schemas in lexical order.
+func Names() []string {
+	names := make([]string, 0, len(embedded))
+	for name := range embedded {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// ValidateRequest validates a raw request payload of action against its schema.
+// See Schema.Validate.
+func ValidateRequest(action string, payload []byte) error {
+	schema, err := Request(action)
+	if err != nil {
+		return err
+	}
+
+	return schema.Validate(payload)
+}
+
+// ValidateConfirmation validates a raw confirmation payload of action against its
+// schema. See Schema.Validate.
+func ValidateConfirmation(action string, payload []byte) error {
+	schema, err := Confirmation(action)
+	if err != nil {
+		return err
+	}
+
+	return schema.Validate(payload)
+}
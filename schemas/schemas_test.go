@@ -0,0 +1,90 @@
+package schemas
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// officialActions lists the 28 actions of OCPP 1.6J.
+var officialActions = []string{
+	"Authorize", "BootNotification", "CancelReservation", "ChangeAvailability",
+	"ChangeConfiguration", "ClearCache", "ClearChargingProfile", "DataTransfer",
+	"DiagnosticsStatusNotification", "FirmwareStatusNotification", "GetCompositeSchedule",
+	"GetConfiguration", "GetDiagnostics", "GetLocalListVersion", "Heartbeat", "MeterValues",
+	"RemoteStartTransaction", "RemoteStopTransaction", "ReserveNow", "Reset", "SendLocalList",
+	"SetChargingProfile", "StartTransaction", "StatusNotification", "StopTransaction",
+	"TriggerMessage", "UnlockConnector", "UpdateFirmware",
+}
+
+func TestEmbeddedSchemasCoverAllActions(t *testing.T) {
+	t.Parallel()
+
+	if got := len(Names()); got != 2*len(officialActions) {
+		t.Errorf("expected %d embedded schemas, got %d", 2*len(officialActions), got)
+	}
+
+	for _, action := range officialActions {
+		req, err := Request(action)
+		if err != nil || req.Name() != action {
+			t.Errorf("unexpected request schema for %s: %v, %v", action, req, err)
+		}
+
+		conf, err := Confirmation(action)
+		if err != nil || conf.Name() != action+"Response" {
+			t.Errorf("unexpected confirmation schema for %s: %v, %v", action, conf, err)
+		}
+	}
+}
+
+func TestEmbeddedSchemasTitlesMatchFileNames(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range Names() {
+		data, err := files.ReadFile("json/" + name + ".json")
+		if err != nil {
+			t.Fatalf("unexpected error reading %s: %v", name, err)
+		}
+
+		title := name
+		if !strings.HasSuffix(name, confirmationSuffix) {
+			title += "Request"
+		}
+
+		if !strings.Contains(string(data), `"title": "`+title+`"`) {
+			t.Errorf("expected %s.json to have title %s", name, title)
+		}
+	}
+}
+
+func TestLookupUnknownSchema(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Lookup("Authorise"); !errors.Is(err, ErrUnknownSchema) {
+		t.Errorf("expected ErrUnknownSchema, got %v", err)
+	}
+
+	if err := ValidateRequest("Authorise", []byte(`{}`)); !errors.Is(err, ErrUnknownSchema) {
+		t.Errorf("expected ErrUnknownSchema from ValidateRequest, got %v", err)
+	}
+
+	if err := ValidateConfirmation("Authorise", []byte(`{}`)); !errors.Is(err, ErrUnknownSchema) {
+		t.Errorf("expected ErrUnknownSchema from ValidateConfirmation, got %v", err)
+	}
+}
+
+func TestValidateRequestAndConfirmation(t *testing.T) {
+	t.Parallel()
+
+	if err := ValidateRequest("Authorize", []byte(`{"idTag":"ABC123"}`)); err != nil {
+		t.Errorf("expected valid Authorize request, got %v", err)
+	}
+
+	if err := ValidateConfirmation("Authorize", []byte(`{"idTag":"ABC123"}`)); !errors.Is(err, ErrSchemaViolation) {
+		t.Errorf("expected ErrSchemaViolation for request payload as confirmation, got %v", err)
+	}
+
+	if err := ValidateConfirmation("Heartbeat", []byte(`{"currentTime":"2025-01-02T03:04:05.000Z"}`)); err != nil {
+		t.Errorf("expected valid Heartbeat confirmation, got %v", err)
+	}
+}
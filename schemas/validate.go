@@ -0,0 +1,287 @@
+package schemas
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// Constraint names reported in types.ValidationError.Constraint, in addition to
+// types.ConstraintRequired, types.ConstraintEnum and types.ConstraintMaxLength.
+// Keywords with a value are reported as "<keyword>=<value>", for example
+// "type=integer" or "multipleOf=0.1".
+const (
+	// ConstraintAdditionalProperties is reported for a property that is not
+	// declared by a schema with "additionalProperties": false.
+	ConstraintAdditionalProperties = "additionalProperties"
+
+	// ConstraintPattern is reported when a string does not match the pattern keyword.
+	ConstraintPattern = "pattern"
+)
+
+// errTrailingData is returned when a payload holds more than one JSON value.
+var errTrailingData = errors.New("unexpected data after top-level value")
+
+// Validate checks payload against the schema and reports every violation.
+//
+// Each violation is a *types.ValidationError wrapping ErrSchemaViolation, with the
+// JSON path of the offending value (array elements are written as "meterValue[0]"),
+// the violated keyword as Constraint and the offending value. Violations are joined
+// with errors.Join; use types.FirstError to get the first one. A payload that is not
+// valid JSON fails with the error of encoding/json. Validate returns nil if the
+// payload conforms to the schema.
+func (s *Schema) Validate(payload []byte) error {
+	value, err := decodePayload(payload)
+	if err != nil {
+		return fmt.Errorf("%s: invalid JSON payload: %w", s.name, err)
+	}
+
+	return types.Join(s.root.validate("", value)...)
+}
+
+// decodePayload decodes a single JSON value, keeping numbers as json.Number so that
+// integers and multipleOf can be checked exactly.
+func decodePayload(payload []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errTrailingData
+	}
+
+	return value, nil
+}
+
+// violation returns the error reported for value at path.
+func violation(path, constraint string, value any, format string, args ...any) error {
+	return &types.ValidationError{
+		Path:       path,
+		Constraint: constraint,
+		Value:      value,
+		Err:        fmt.Errorf("%w: "+format, append([]any{ErrSchemaViolation}, args...)...),
+	}
+}
+
+// childPath returns the path of a property of the value at path.
+func childPath(path, property string) string {
+	if path == "" {
+		return property
+	}
+
+	return path + "." + property
+}
+
+// validate checks value, found at path, against the schema node.
+func (n *node) validate(path string, value any) []error {
+	if err := n.validateType(path, value); err != nil {
+		return []error{err}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		return n.validateObject(path, v)
+	case []any:
+		return n.validateArray(path, v)
+	case string:
+		return n.validateString(path, v)
+	case json.Number:
+		return n.validateNumber(path, v)
+	default:
+		return nil
+	}
+}
+
+func (n *node) validateType(path string, value any) error {
+	if len(n.types) == 0 {
+		return nil
+	}
+
+	actual := typeOf(value)
+	for _, expected := range n.types {
+		if expected == actual || expected == typeNumber && actual == typeInteger {
+			return nil
+		}
+	}
+
+	return violation(path, "type="+n.types[0], value, "expected %s, got %s", n.types[0], actual)
+}
+
+// typeOf returns the most specific draft-04 type of a decoded JSON value.
+func typeOf(value any) string {
+	switch v := value.(type) {
+	case map[string]any:
+		return typeObject
+	case []any:
+		return typeArray
+	case string:
+		return typeString
+	case bool:
+		return typeBoolean
+	case json.Number:
+		if rat, ok := new(big.Rat).SetString(v.String()); ok && rat.IsInt() {
+			return typeInteger
+		}
+
+		return typeNumber
+	default:
+		return typeNull
+	}
+}
+
+func (n *node) validateObject(path string, object map[string]any) []error {
+	var errs []error
+
+	for _, name := range n.required {
+		if _, ok := object[name]; !ok {
+			errs = append(errs, violation(childPath(path, name), types.ConstraintRequired, nil,
+				"missing required property"))
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		property, ok := n.properties[name]
+
+		switch {
+		case ok:
+			errs = append(errs, property.validate(childPath(path, name), object[name])...)
+		case n.additionalProperties != nil && !*n.additionalProperties:
+			errs = append(errs, violation(childPath(path, name), ConstraintAdditionalProperties, object[name],
+				"property is not allowed"))
+		}
+	}
+
+	return errs
+}
+
+func (n *node) validateArray(path string, array []any) []error {
+	var errs []error
+
+	if n.minItems != nil && len(array) < *n.minItems {
+		errs = append(errs, violation(path, "minItems="+strconv.Itoa(*n.minItems), len(array),
+			"%d items is fewer than %d", len(array), *n.minItems))
+	}
+
+	if n.maxItems != nil && len(array) > *n.maxItems {
+		errs = append(errs, violation(path, "maxItems="+strconv.Itoa(*n.maxItems), len(array),
+			"%d items is more than %d", len(array), *n.maxItems))
+	}
+
+	if n.items != nil {
+		for i, item := range array {
+			errs = append(errs, n.items.validate(path+"["+strconv.Itoa(i)+"]", item)...)
+		}
+	}
+
+	return errs
+}
+
+func (n *node) validateString(path, value string) []error {
+	var errs []error
+
+	if len(n.enum) > 0 && !slices.Contains(n.enum, value) {
+		errs = append(errs, violation(path, types.ConstraintEnum, value, "%q is not one of %v", value, n.enum))
+	}
+
+	length := utf8.RuneCountInString(value)
+
+	if n.minLength != nil && length < *n.minLength {
+		errs = append(errs, violation(path, "minLength="+strconv.Itoa(*n.minLength), value,
+			"length %d is shorter than %d", length, *n.minLength))
+	}
+
+	if n.maxLength != nil && length > *n.maxLength {
+		errs = append(errs, violation(path, types.ConstraintMaxLength(*n.maxLength), value,
+			"length %d exceeds %d", length, *n.maxLength))
+	}
+
+	if n.pattern != nil && !n.pattern.MatchString(value) {
+		errs = append(errs, violation(path, ConstraintPattern, value, "%q does not match %s", value, n.pattern))
+	}
+
+	if n.format != "" && !validFormat(n.format, value) {
+		errs = append(errs, violation(path, "format="+n.format, value, "%q is not a valid %s", value, n.format))
+	}
+
+	return errs
+}
+
+// validFormat reports whether value conforms to a supported format.
+func validFormat(format, value string) bool {
+	switch format {
+	case formatDateTime:
+		_, err := time.Parse(time.RFC3339, value)
+
+		return err == nil
+	case formatURI:
+		uri, err := url.Parse(value)
+
+		return err == nil && uri.IsAbs()
+	default:
+		return true
+	}
+}
+
+func (n *node) validateNumber(path string, value json.Number) []error {
+	number, ok := new(big.Rat).SetString(value.String())
+	if !ok {
+		return []error{violation(path, "type="+typeNumber, value, "%s is not a number", value)}
+	}
+
+	var errs []error
+
+	if n.minimum != nil {
+		if cmp := number.Cmp(n.minimum); cmp < 0 || cmp == 0 && n.exclusiveMinimum {
+			errs = append(errs, violation(path, "minimum="+decimal(n.minimum), value,
+				"%s is lower than %s", value, decimal(n.minimum)))
+		}
+	}
+
+	if n.maximum != nil {
+		if cmp := number.Cmp(n.maximum); cmp > 0 || cmp == 0 && n.exclusiveMaximum {
+			errs = append(errs, violation(path, "maximum="+decimal(n.maximum), value,
+				"%s is greater than %s", value, decimal(n.maximum)))
+		}
+	}
+
+	if n.multipleOf != nil && !new(big.Rat).Quo(number, n.multipleOf).IsInt() {
+		errs = append(errs, violation(path, "multipleOf="+decimal(n.multipleOf), value,
+			"%s is not a multiple of %s", value, decimal(n.multipleOf)))
+	}
+
+	return errs
+}
+
+// maxDecimals bounds the digits printed by decimal for non-terminating fractions.
+const maxDecimals = 20
+
+// decimal formats a keyword value as it is written in schemas, such as "0.1".
+func decimal(r *big.Rat) string {
+	digits := 0
+	for scaled := new(big.Rat).Set(r); !scaled.IsInt() && digits < maxDecimals; digits++ {
+		scaled.Mul(scaled, big.NewRat(10, 1))
+	}
+
+	return r.FloatString(digits)
+}
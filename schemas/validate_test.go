@@ -0,0 +1,191 @@
+package schemas
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// violations returns the ValidationErrors reported by err, in order.
+func violations(t *testing.T, err error) []*types.ValidationError {
+	t.Helper()
+
+	var errs []error
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	} else if err != nil {
+		errs = []error{err}
+	}
+
+	result := make([]*types.ValidationError, 0, len(errs))
+
+	for _, e := range errs {
+		var verr *types.ValidationError
+		if !errors.As(e, &verr) {
+			t.Fatalf("expected *types.ValidationError, got %v", e)
+		}
+
+		if !errors.Is(e, ErrSchemaViolation) {
+			t.Errorf("expected %v to wrap ErrSchemaViolation", e)
+		}
+
+		result = append(result, verr)
+	}
+
+	return result
+}
+
+func TestValidateReportsViolations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		action     string
+		payload    string
+		path       string
+		constraint string
+	}{
+		{"Authorize", `{}`, "idTag", types.ConstraintRequired},
+		{"Authorize", `{"idTag":"ABC1234567890123456789"}`, "idTag", "maxLength=20"},
+		{"Authorize", `{"idTag":12}`, "idTag", "type=string"},
+		{"Authorize", `{"idTag":"ABC","extra":true}`, "extra", ConstraintAdditionalProperties},
+		{"Authorize", `[]`, "", "type=object"},
+		{"Reset", `{"type":"Warm"}`, "type", types.ConstraintEnum},
+		{"RemoteStopTransaction", `{"transactionId":1.5}`, "transactionId", "type=integer"},
+		{"StartTransaction", `{"connectorId":1,"idTag":"A","meterStart":0,"timestamp":"today"}`,
+			"timestamp", "format=date-time"},
+		{"GetDiagnostics", `{"location":"/tmp/diagnostics"}`, "location", "format=uri"},
+		{"GetConfiguration", `{"key":["HeartbeatInterval",5]}`, "key[1]", "type=string"},
+		{"MeterValues", `{"connectorId":1,"meterValue":[{"timestamp":"2025-01-02T03:04:05Z","sampledValue":[{}]}]}`,
+			"meterValue[0].sampledValue[0].value", types.ConstraintRequired},
+		{"SetChargingProfile", `{"connectorId":1,"csChargingProfiles":{"chargingProfileId":1,"stackLevel":0,` +
+			`"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Absolute","chargingSchedule":` +
+			`{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16.05}]}}}`,
+			"csChargingProfiles.chargingSchedule.chargingSchedulePeriod[0].limit", "multipleOf=0.1"},
+	}
+
+	for _, tc := range tests {
+		errs := violations(t, ValidateRequest(tc.action, []byte(tc.payload)))
+		if len(errs) != 1 {
+			t.Errorf("%s %s: expected one violation, got %v", tc.action, tc.payload, errs)
+
+			continue
+		}
+
+		if errs[0].Path != tc.path || errs[0].Constraint != tc.constraint {
+			t.Errorf("%s %s: expected %s/%s, got %s/%s",
+				tc.action, tc.payload, tc.path, tc.constraint, errs[0].Path, errs[0].Constraint)
+		}
+	}
+}
+
+func TestValidateAcceptsValidPayloads(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		action  string
+		payload string
+	}{
+		{"ClearCache", `{}`},
+		{"RemoteStopTransaction", `{"transactionId":1.0}`},
+		{"StartTransaction", `{"connectorId":1,"idTag":"A","meterStart":0,"timestamp":"2025-01-02T03:04:05.123+02:00"}`},
+		{"GetDiagnostics", `{"location":"ftp://example.com/uploads"}`},
+		{"SetChargingProfile", `{"connectorId":0,"csChargingProfiles":{"chargingProfileId":1,"stackLevel":0,` +
+			`"chargingProfilePurpose":"ChargePointMaxProfile","chargingProfileKind":"Absolute","chargingSchedule":` +
+			`{"chargingRateUnit":"W","chargingSchedulePeriod":[{"startPeriod":0,"limit":11000.3}],"minChargingRate":0.1}}}`},
+	}
+
+	for _, tc := range tests {
+		if err := ValidateRequest(tc.action, []byte(tc.payload)); err != nil {
+			t.Errorf("%s %s: expected payload to be valid, got %v", tc.action, tc.payload, err)
+		}
+	}
+}
+
+func TestValidateReportsEveryViolation(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"currentTime":"now","interval":"300","status":"Registered","extra":1}`
+
+	errs := violations(t, ValidateConfirmation("BootNotification", []byte(payload)))
+
+	want := []struct{ path, constraint string }{
+		{"currentTime", "format=date-time"},
+		{"extra", ConstraintAdditionalProperties},
+		{"interval", "type=integer"},
+		{"status", types.ConstraintEnum},
+	}
+
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d violations, got %v", len(want), errs)
+	}
+
+	for i, w := range want {
+		if errs[i].Path != w.path || errs[i].Constraint != w.constraint {
+			t.Errorf("violation %d: expected %s/%s, got %s/%s", i, w.path, w.constraint, errs[i].Path, errs[i].Constraint)
+		}
+	}
+
+	if errs[2].Value != "300" {
+		t.Errorf("expected offending value to be reported, got %v", errs[2].Value)
+	}
+}
+
+func TestValidateMalformedPayload(t *testing.T) {
+	t.Parallel()
+
+	var syntaxErr *json.SyntaxError
+	if err := ValidateRequest("Authorize", []byte(`{"idTag":`)); err == nil || errors.Is(err, ErrSchemaViolation) {
+		t.Errorf("expected JSON error, got %v", err)
+	}
+
+	if err := ValidateRequest("Authorize", []byte(`{"idTag":]`)); !errors.As(err, &syntaxErr) {
+		t.Errorf("expected *json.SyntaxError, got %v", err)
+	}
+
+	if err := ValidateRequest("Authorize", []byte(`{"idTag":"A"} {}`)); !errors.Is(err, errTrailingData) {
+		t.Errorf("expected errTrailingData, got %v", err)
+	}
+}
+
+func TestValidateNumericAndLengthKeywords(t *testing.T) {
+	t.Parallel()
+
+	schema, err := Compile("custom", []byte(`{
+		"type": "object",
+		"properties": {
+			"count": {"type": "integer", "minimum": 0, "maximum": 10, "exclusiveMaximum": true},
+			"ratio": {"type": "number", "minimum": 0.5, "exclusiveMinimum": true},
+			"code": {"type": "string", "minLength": 2, "pattern": "^[A-Z]+$"},
+			"tags": {"type": "array", "minItems": 1, "maxItems": 2}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error compiling schema: %v", err)
+	}
+
+	tests := []struct {
+		payload    string
+		constraint string
+	}{
+		{`{"count":-1}`, "minimum=0"},
+		{`{"count":10}`, "maximum=10"},
+		{`{"ratio":0.5}`, "minimum=0.5"},
+		{`{"code":"A"}`, "minLength=2"},
+		{`{"code":"ab"}`, ConstraintPattern},
+		{`{"tags":[]}`, "minItems=1"},
+		{`{"tags":[1,2,3]}`, "maxItems=2"},
+	}
+
+	for _, tc := range tests {
+		errs := violations(t, schema.Validate([]byte(tc.payload)))
+		if len(errs) != 1 || errs[0].Constraint != tc.constraint {
+			t.Errorf("%s: expected %s violation, got %v", tc.payload, tc.constraint, errs)
+		}
+	}
+
+	if err := schema.Validate([]byte(`{"count":9,"ratio":0.51,"code":"AB","tags":["x"],"other":null}`)); err != nil {
+		t.Errorf("expected payload to be valid, got %v", err)
+	}
+}
@@ -0,0 +1,192 @@
+package smartcharging
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/messages/getcompositeschedule"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// ErrInvalidMaxLimit indicates that the maximum limit of a Charge Point is not
+// greater than 0.
+var ErrInvalidMaxLimit = errors.New("invalid maximum limit")
+
+// InstalledProfile is a charging profile installed on a connector of a Charge
+// Point, or on the Charge Point as a whole when ConnectorId is 0.
+type InstalledProfile struct {
+	// ConnectorId is the connector the profile was installed on.
+	ConnectorId int
+
+	// Profile is the installed charging profile.
+	Profile types.ChargingProfileType
+}
+
+// Transaction is a transaction running on a connector.
+type Transaction struct {
+	// Id is the transactionId TxProfiles refer to.
+	Id int
+
+	// Start is the time the transaction started, which Relative profiles are
+	// anchored to.
+	Start time.Time
+}
+
+// ChargePoint holds the state a composite schedule is calculated from.
+type ChargePoint struct {
+	// Connectors is the number of connectors of the Charge Point. Composite
+	// schedules of connectors above it are Rejected.
+	Connectors int
+
+	// Profiles are the charging profiles installed on the Charge Point.
+	Profiles []InstalledProfile
+
+	// Transactions maps the connectors with a running transaction to that
+	// transaction.
+	Transactions map[int]Transaction
+
+	// Unit is the unit of MaxLimit, and the unit of the composite schedule when the
+	// request does not ask for one.
+	Unit types.ChargingRateUnitType
+
+	// MaxLimit is the charging rate the Charge Point delivers when no profile limits
+	// it, in Unit. It must be greater than 0.
+	MaxLimit float64
+
+	// Voltage is the nominal voltage between phase and neutral, used to convert
+	// MaxLimit and the profiles that are not in the unit of the composite schedule.
+	// It is only needed when units are mixed.
+	Voltage float64
+}
+
+// CompositeSchedule calculates the composite schedule of the connector of req,
+// starting at now and lasting req.Duration seconds, and returns it as a
+// GetCompositeSchedule.conf.
+//
+// A request for connector 0 reports the schedule of the grid connection of the
+// Charge Point, which only the ChargePointMaxProfile limits. A request for a
+// connector above Connectors is answered with the Rejected status.
+//
+// Stacks that mix A and W profiles merge into the unit of the schedule. Profiles
+// keep their own unit, and at every point in time the limits in effect are
+// converted exactly with types.ConvertRate and the number of phases of the period
+// in effect. The limits of the emitted schedule are then rounded down to one
+// decimal place with types.RoundRate, as the schedule requires.
+//
+// It returns an error if req is not valid, wrapping ErrInvalidMaxLimit if MaxLimit
+// is not greater than 0, or wrapping types.ErrInvalidVoltage if units are mixed
+// and Voltage is not greater than 0.
+//
+// Example usage:
+//
+//	conf, err := cp.CompositeSchedule(req, time.Now())
+//	if err != nil {
+//	    log.Fatalf("cannot calculate composite schedule: %v", err)
+//	}
+func (cp ChargePoint) CompositeSchedule(
+	req getcompositeschedule.RequestMessage,
+	now time.Time,
+) (getcompositeschedule.ConfirmationMessage, error) {
+	if err := req.Validate(); err != nil {
+		return getcompositeschedule.ConfirmationMessage{}, err
+	}
+
+	if !(cp.MaxLimit > 0) {
+		err := fmt.Errorf("%w: %v is not greater than 0", ErrInvalidMaxLimit, cp.MaxLimit)
+
+		return getcompositeschedule.ConfirmationMessage{}, err
+	}
+
+	if req.ConnectorId > cp.Connectors {
+		return getcompositeschedule.Confirmation(getcompositeschedule.GetCompositeScheduleStatusRejected)
+	}
+
+	unit := cp.Unit
+	if req.ChargingRateUnit != nil {
+		unit = *req.ChargingRateUnit
+	}
+
+	start := now.UTC().Truncate(time.Second)
+
+	c, err := cp.connector(req.ConnectorId, unit, start)
+	if err != nil {
+		return getcompositeschedule.ConfirmationMessage{}, err
+	}
+
+	schedule, err := types.ChargingSchedule(unit, c.periods(start, req.Duration)...)
+	if err != nil {
+		return getcompositeschedule.ConfirmationMessage{}, fmt.Errorf("failed to calculate composite schedule: %w", err)
+	}
+
+	schedule.Duration = &req.Duration
+
+	scheduleStart, err := types.DateTime(start)
+	if err != nil {
+		return getcompositeschedule.ConfirmationMessage{}, fmt.Errorf("failed to calculate composite schedule: %w", err)
+	}
+
+	conf := getcompositeschedule.ConfirmationMessage{
+		Status:           getcompositeschedule.GetCompositeScheduleStatusAccepted,
+		ConnectorId:      &req.ConnectorId,
+		ScheduleStart:    &scheduleStart,
+		ChargingSchedule: &schedule,
+	}
+
+	if err := conf.Validate(); err != nil {
+		return getcompositeschedule.ConfirmationMessage{}, err
+	}
+
+	return conf, nil
+}
+
+// connector gathers the profiles that apply to connectorId, anchoring Relative
+// profiles to its running transaction or, without one, to start.
+func (cp ChargePoint) connector(
+	connectorId int,
+	unit types.ChargingRateUnitType,
+	start time.Time,
+) (connectorProfiles, error) {
+	if _, err := types.ConvertRate(cp.MaxLimit, cp.Unit, unit, types.DefaultNumberPhases, cp.Voltage); err != nil {
+		return connectorProfiles{}, fmt.Errorf("maximum limit: %w", err)
+	}
+
+	transaction, running := cp.Transactions[connectorId]
+
+	c := connectorProfiles{
+		unit:           unit,
+		voltage:        cp.Voltage,
+		maxLimit:       cp.MaxLimit,
+		maxLimitUnit:   cp.Unit,
+		chargePointMax: nil,
+		txDefault:      nil,
+		sharedDefault:  nil,
+		tx:             nil,
+	}
+
+	anchor := start
+	if running && connectorId > 0 {
+		anchor = transaction.Start
+	}
+
+	var errs []error
+
+	for _, installed := range cp.Profiles {
+		profile := installed.Profile
+		target := c.stack(installed.ConnectorId, connectorId, profile, transaction, running)
+
+		if target == nil {
+			continue
+		}
+
+		if _, err := profile.ChargingSchedule.Convert(unit, cp.Voltage); err != nil {
+			errs = append(errs, fmt.Errorf("charging profile %d: %w", profile.ChargingProfileId, err))
+
+			continue
+		}
+
+		*target = append(*target, activeProfile{profile: profile, anchor: anchor})
+	}
+
+	return c, errors.Join(errs...)
+}
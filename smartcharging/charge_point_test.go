@@ -0,0 +1,277 @@
+package smartcharging
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/messages/getcompositeschedule"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+var now = time.Date(2025, 1, 2, 6, 0, 0, 0, time.UTC)
+
+// profile returns a Relative profile with the given purpose, stack level and
+// periods, given as alternating startPeriod and limit values.
+func profile(
+	t *testing.T,
+	purpose types.ChargingProfilePurposeType,
+	stackLevel int,
+	startsAndLimits ...float64,
+) types.ChargingProfileType {
+	t.Helper()
+
+	periods := make([]types.ChargingSchedulePeriodType, 0, len(startsAndLimits)/2)
+
+	for i := 0; i+1 < len(startsAndLimits); i += 2 {
+		period, _ := types.ChargingSchedulePeriod(int(startsAndLimits[i]), startsAndLimits[i+1])
+		periods = append(periods, period)
+	}
+
+	schedule, err := types.ChargingSchedule(types.ChargingRateUnitTypeA, periods...)
+	if err != nil {
+		t.Fatalf("unexpected error creating charging schedule: %v", err)
+	}
+
+	p, err := types.ChargingProfile(stackLevel+1, stackLevel, purpose, types.ChargingProfileKindTypeRelative, schedule)
+	if err != nil {
+		t.Fatalf("unexpected error creating charging profile: %v", err)
+	}
+
+	return p
+}
+
+// dateTime returns t as a DateTimeType.
+func dateTime(t time.Time) *types.DateTimeType {
+	d, _ := types.DateTime(t)
+
+	return &d
+}
+
+// composite calculates the composite schedule of connectorId over duration seconds
+// and returns its periods in the String() form of the schedule.
+func composite(t *testing.T, cp ChargePoint, connectorId, duration int) string {
+	t.Helper()
+
+	req, _ := getcompositeschedule.Request(connectorId, duration)
+
+	conf, err := cp.CompositeSchedule(req, now)
+	if err != nil {
+		t.Fatalf("unexpected error calculating composite schedule: %v", err)
+	}
+
+	if conf.Status != getcompositeschedule.GetCompositeScheduleStatusAccepted || *conf.ConnectorId != connectorId ||
+		!conf.ScheduleStart.Time().Equal(now) || *conf.ChargingSchedule.Duration != duration {
+		t.Errorf("unexpected confirmation: %s", conf)
+	}
+
+	schedule := conf.ChargingSchedule.String()
+
+	return schedule[strings.Index(schedule, "chargingSchedulePeriod="):]
+}
+
+// chargePoint returns a Charge Point with two connectors and a maximum of 32 A
+// without transactions.
+func chargePoint(profiles ...InstalledProfile) ChargePoint {
+	return ChargePoint{
+		Connectors:   2,
+		Profiles:     profiles,
+		Transactions: nil,
+		Unit:         types.ChargingRateUnitTypeA,
+		MaxLimit:     32,
+		Voltage:      0,
+	}
+}
+
+func TestCompositeScheduleStackLevels(t *testing.T) {
+	t.Parallel()
+
+	base := profile(t, types.ChargingProfilePurposeTypeTxDefaultProfile, 0, 0, 24)
+	boost := profile(t, types.ChargingProfilePurposeTypeTxDefaultProfile, 1, 0, 16, 1800, 20)
+	duration := 3600
+	boost.ChargingSchedule.Duration = &duration
+
+	cp := chargePoint(InstalledProfile{ConnectorId: 1, Profile: base}, InstalledProfile{ConnectorId: 1, Profile: boost})
+
+	want := "chargingSchedulePeriod=[{startPeriod=0, limit=16}, {startPeriod=1800, limit=20}, {startPeriod=3600, limit=24}]}"
+	if got := composite(t, cp, 1, 7200); got != want {
+		t.Errorf("unexpected composite schedule:\nwant: %s\ngot : %s", want, got)
+	}
+
+	if got := composite(t, cp, 2, 7200); got != "chargingSchedulePeriod=[{startPeriod=0, limit=32}]}" {
+		t.Errorf("expected connector 2 to be limited by the Charge Point only, got %s", got)
+	}
+}
+
+func TestCompositeScheduleChargePointMaxProfile(t *testing.T) {
+	t.Parallel()
+
+	maxProfile := profile(t, types.ChargingProfilePurposeTypeChargePointMaxProfile, 0, 0, 20)
+	maxProfile.ChargingProfileKind = types.ChargingProfileKindTypeAbsolute
+	maxProfile.ChargingSchedule.StartSchedule = dateTime(now.Add(-time.Hour))
+	maxProfile.ValidTo = dateTime(now.Add(time.Hour))
+
+	def := profile(t, types.ChargingProfilePurposeTypeTxDefaultProfile, 0, 0, 16, 600, 24)
+
+	cp := chargePoint(InstalledProfile{ConnectorId: 0, Profile: maxProfile}, InstalledProfile{ConnectorId: 0, Profile: def})
+
+	want := "chargingSchedulePeriod=[{startPeriod=0, limit=16}, {startPeriod=600, limit=20}, {startPeriod=3600, limit=24}]}"
+	if got := composite(t, cp, 1, 7200); got != want {
+		t.Errorf("unexpected composite schedule:\nwant: %s\ngot : %s", want, got)
+	}
+
+	want = "chargingSchedulePeriod=[{startPeriod=0, limit=20}, {startPeriod=3600, limit=32}]}"
+	if got := composite(t, cp, 0, 7200); got != want {
+		t.Errorf("unexpected grid connection schedule:\nwant: %s\ngot : %s", want, got)
+	}
+}
+
+func TestCompositeScheduleTxProfile(t *testing.T) {
+	t.Parallel()
+
+	shared := profile(t, types.ChargingProfilePurposeTypeTxDefaultProfile, 0, 0, 6)
+	def := profile(t, types.ChargingProfilePurposeTypeTxDefaultProfile, 0, 0, 24)
+	tx := profile(t, types.ChargingProfilePurposeTypeTxProfile, 0, 0, 10, 3600, 16)
+	other := profile(t, types.ChargingProfilePurposeTypeTxProfile, 1, 0, 8)
+	transactionId, otherId := 42, 41
+	tx.TransactionId = &transactionId
+	other.TransactionId = &otherId
+
+	cp := chargePoint(
+		InstalledProfile{ConnectorId: 0, Profile: shared},
+		InstalledProfile{ConnectorId: 1, Profile: def},
+		InstalledProfile{ConnectorId: 1, Profile: tx},
+		InstalledProfile{ConnectorId: 1, Profile: other},
+	)
+
+	want := "chargingSchedulePeriod=[{startPeriod=0, limit=24}]}"
+	if got := composite(t, cp, 1, 7200); got != want {
+		t.Errorf("expected the TxDefaultProfile of the connector without a transaction, got %s", got)
+	}
+
+	if got := composite(t, cp, 2, 7200); got != "chargingSchedulePeriod=[{startPeriod=0, limit=6}]}" {
+		t.Errorf("expected the TxDefaultProfile of connector 0, got %s", got)
+	}
+
+	cp.Transactions = map[int]Transaction{1: {Id: transactionId, Start: now.Add(-30 * time.Minute)}}
+
+	want = "chargingSchedulePeriod=[{startPeriod=0, limit=10}, {startPeriod=1800, limit=16}]}"
+	if got := composite(t, cp, 1, 7200); got != want {
+		t.Errorf("unexpected composite schedule of the transaction:\nwant: %s\ngot : %s", want, got)
+	}
+}
+
+func TestCompositeScheduleRecurring(t *testing.T) {
+	t.Parallel()
+
+	daily := profile(t, types.ChargingProfilePurposeTypeTxDefaultProfile, 0, 0, 10, 28800, 32, 79200, 10)
+	daily.ChargingProfileKind = types.ChargingProfileKindTypeRecurring
+	kind := types.RecurrencyKindTypeDaily
+	daily.RecurrencyKind = &kind
+	daily.ChargingSchedule.StartSchedule = dateTime(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC))
+
+	cp := chargePoint(InstalledProfile{ConnectorId: 0, Profile: daily})
+
+	want := "chargingSchedulePeriod=[{startPeriod=0, limit=10}, {startPeriod=7200, limit=32}, " +
+		"{startPeriod=57600, limit=10}, {startPeriod=93600, limit=32}, {startPeriod=144000, limit=10}]}"
+	if got := composite(t, cp, 1, 2*86400); got != want {
+		t.Errorf("unexpected composite schedule:\nwant: %s\ngot : %s", want, got)
+	}
+
+	daily.ChargingSchedule.StartSchedule = dateTime(now.Add(time.Hour))
+	cp.MaxLimit = 16
+	cp.Profiles = []InstalledProfile{{ConnectorId: 0, Profile: daily}}
+
+	want = "chargingSchedulePeriod=[{startPeriod=0, limit=16}, {startPeriod=3600, limit=10}, {startPeriod=32400, limit=16}]}"
+	if got := composite(t, cp, 1, 36000); got != want {
+		t.Errorf("expected no limit before the first recurrence:\nwant: %s\ngot : %s", want, got)
+	}
+}
+
+func TestCompositeScheduleRejected(t *testing.T) {
+	t.Parallel()
+
+	req, _ := getcompositeschedule.Request(3, 3600)
+
+	conf, err := chargePoint().CompositeSchedule(req, now)
+	if err != nil || conf.Status != getcompositeschedule.GetCompositeScheduleStatusRejected || conf.ChargingSchedule != nil {
+		t.Errorf("expected Rejected confirmation, got %s, %v", conf, err)
+	}
+
+	req.Duration = -1
+	if _, err := chargePoint().CompositeSchedule(req, now); !errors.Is(err, types.ErrInvalidDuration) {
+		t.Errorf("expected ErrInvalidDuration, got %v", err)
+	}
+}
+
+func TestCompositeScheduleInvalidMaxLimit(t *testing.T) {
+	t.Parallel()
+
+	req, _ := getcompositeschedule.Request(0, 3600)
+
+	var zero ChargePoint
+	if _, err := zero.CompositeSchedule(req, now); !errors.Is(err, ErrInvalidMaxLimit) {
+		t.Errorf("expected ErrInvalidMaxLimit for the zero value, got %v", err)
+	}
+
+	cp := chargePoint()
+	cp.MaxLimit = -16
+
+	if _, err := cp.CompositeSchedule(req, now); !errors.Is(err, ErrInvalidMaxLimit) {
+		t.Errorf("expected ErrInvalidMaxLimit for a negative limit, got %v", err)
+	}
+}
+
+func TestCompositeScheduleMixedUnits(t *testing.T) {
+	t.Parallel()
+
+	onePhase := 1
+	def := profile(t, types.ChargingProfilePurposeTypeTxDefaultProfile, 0, 0, 16, 3600, 16)
+	def.ChargingSchedule.ChargingSchedulePeriod[1].NumberPhases = &onePhase
+
+	maxProfile := profile(t, types.ChargingProfilePurposeTypeChargePointMaxProfile, 0, 0, 6900)
+	maxProfile.ChargingSchedule.ChargingRateUnit = types.ChargingRateUnitTypeW
+
+	cp := chargePoint(InstalledProfile{ConnectorId: 1, Profile: def}, InstalledProfile{ConnectorId: 0, Profile: maxProfile})
+
+	req, _ := getcompositeschedule.Request(1, 7200)
+	if _, err := cp.CompositeSchedule(req, now); !errors.Is(err, types.ErrInvalidVoltage) {
+		t.Errorf("expected ErrInvalidVoltage without a voltage, got %v", err)
+	}
+
+	cp.Voltage = 230
+
+	// 6900 W caps 3 phases at 10 A, but a single phase at 30 A.
+	want := "chargingSchedulePeriod=[{startPeriod=0, limit=10}, {startPeriod=3600, limit=16, numberPhases=1}]}"
+	if got := composite(t, cp, 1, 7200); got != want {
+		t.Errorf("unexpected composite schedule in A:\nwant: %s\ngot : %s", want, got)
+	}
+
+	unit := types.ChargingRateUnitTypeW
+	req.ChargingRateUnit = &unit
+
+	conf, err := cp.CompositeSchedule(req, now)
+	if err != nil {
+		t.Fatalf("unexpected error calculating composite schedule: %v", err)
+	}
+
+	want = "{duration=7200, chargingRateUnit=W, chargingSchedulePeriod=[{startPeriod=0, limit=6900}, " +
+		"{startPeriod=3600, limit=3680, numberPhases=1}]}"
+	if conf.ChargingSchedule.String() != want {
+		t.Errorf("unexpected composite schedule in W:\nwant: %s\ngot : %s", want, conf.ChargingSchedule.String())
+	}
+
+	// 7360 W caps 3 phases at 10.66 A, which the schedule emits rounded down.
+	maxProfile.ChargingSchedule.ChargingSchedulePeriod[0].Limit = 7360
+	cp.Profiles[1].Profile = maxProfile
+
+	want = "chargingSchedulePeriod=[{startPeriod=0, limit=10.6}, {startPeriod=3600, limit=16, numberPhases=1}]}"
+	if got := composite(t, cp, 1, 7200); got != want {
+		t.Errorf("expected the emitted limit to be rounded down:\nwant: %s\ngot : %s", want, got)
+	}
+
+	if _, err := chargePoint(cp.Profiles...).CompositeSchedule(req, now); !errors.Is(err, types.ErrInvalidVoltage) {
+		t.Errorf("expected ErrInvalidVoltage without a voltage, got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+// Package smartcharging calculates the composite charging schedule of a Charge
+// Point from its installed charging profiles.
+//
+// A Charge Point combines every charging profile that applies to a connector into
+// a single composite schedule, which it reports in a GetCompositeSchedule.conf:
+//
+//   - Within each purpose, the valid profile with the highest stackLevel wins. When
+//     its schedule has ended, or it is outside its validity period, the profile
+//     below it applies.
+//   - A TxProfile of the running transaction overrides the TxDefaultProfile. A
+//     TxDefaultProfile installed on a connector overrides one installed on
+//     connector 0, which applies to every connector.
+//   - The ChargePointMaxProfile caps the result, since it limits the Charge Point
+//     as a whole.
+//
+// Relative profiles start with the transaction running on the connector, or at the
+// start of the requested schedule when there is none, and Recurring profiles are
+// repeated every day or week from their startSchedule.
+//
+// Profiles may limit the charging rate in Amperes per phase or in Watts. The
+// composite schedule is reported in the requested unit, converting the other
+// limits with a nominal voltage and the number of phases the Charge Point charges
+// on.
+//
+// ChargePoint holds the installed profiles and running transactions, and
+// CompositeSchedule answers a GetCompositeSchedule.req with them. A Central System
+// can use it to predict the behavior of a Charge Point before sending profiles,
+// and a simulator to answer the request.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 3.13: Smart Charging
+//   - OCPP 1.6J, Section 5.7: Get Composite Schedule
+//
+// This package should be imported using:
+//
+//	import "github.com/aasanchez/ocpp16messages/smartcharging"
+package smartcharging
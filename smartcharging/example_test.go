@@ -0,0 +1,54 @@
+package smartcharging_test
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/messages/getcompositeschedule"
+	"github.com/aasanchez/ocpp16messages/smartcharging"
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+func ExampleChargePoint_CompositeSchedule() {
+	now := time.Date(2025, 1, 2, 18, 0, 0, 0, time.UTC)
+
+	// The grid connection allows 20 A, and new transactions start at 32 A, or
+	// 10 A during the first hour.
+	gridPeriod, _ := types.ChargingSchedulePeriod(0, 20)
+	gridSchedule, _ := types.ChargingSchedule(types.ChargingRateUnitTypeA, gridPeriod)
+	grid, _ := types.ChargingProfile(1, 0, types.ChargingProfilePurposeTypeChargePointMaxProfile,
+		types.ChargingProfileKindTypeRelative, gridSchedule)
+
+	first, _ := types.ChargingSchedulePeriod(0, 10)
+	second, _ := types.ChargingSchedulePeriod(3600, 32)
+	defaultSchedule, _ := types.ChargingSchedule(types.ChargingRateUnitTypeA, first, second)
+	txDefault, _ := types.ChargingProfile(2, 0, types.ChargingProfilePurposeTypeTxDefaultProfile,
+		types.ChargingProfileKindTypeRelative, defaultSchedule)
+
+	cp := smartcharging.ChargePoint{
+		Connectors: 2,
+		Profiles: []smartcharging.InstalledProfile{
+			{ConnectorId: 0, Profile: grid},
+			{ConnectorId: 0, Profile: txDefault},
+		},
+		Transactions: map[int]smartcharging.Transaction{1: {Id: 42, Start: now.Add(-30 * time.Minute)}},
+		Unit:         types.ChargingRateUnitTypeA,
+		MaxLimit:     32,
+		Voltage:      230,
+	}
+
+	req, _ := getcompositeschedule.Request(1, 7200)
+
+	conf, err := cp.CompositeSchedule(req, now)
+	if err != nil {
+		log.Fatalf("failed to calculate composite schedule: %v", err)
+	}
+
+	for _, period := range conf.ChargingSchedule.ChargingSchedulePeriod {
+		fmt.Printf("+%ds: %g A\n", period.StartPeriod, period.Limit)
+	}
+	// Output:
+	// +0s: 10 A
+	// +1800s: 20 A
+}
@@ -0,0 +1,345 @@
+package smartcharging
+
+import (
+	"slices"
+	"time"
+
+	"github.com/aasanchez/ocpp16messages/types"
+)
+
+// recurrencePeriods maps each RecurrencyKind to the period after which a Recurring
+// profile repeats.
+var recurrencePeriods = map[types.RecurrencyKindType]time.Duration{
+	types.RecurrencyKindTypeDaily:  24 * time.Hour,
+	types.RecurrencyKindTypeWeekly: 7 * 24 * time.Hour,
+}
+
+// activeProfile is a profile that applies to a connector, together with the time
+// its schedule starts at when it is Relative.
+type activeProfile struct {
+	profile types.ChargingProfileType
+	anchor  time.Time
+}
+
+// connectorProfiles holds the profiles that apply to a connector, stacked by
+// purpose.
+type connectorProfiles struct {
+	unit           types.ChargingRateUnitType
+	voltage        float64
+	maxLimit       float64
+	maxLimitUnit   types.ChargingRateUnitType
+	chargePointMax []activeProfile
+	txDefault      []activeProfile
+	sharedDefault  []activeProfile
+	tx             []activeProfile
+}
+
+// stack returns the stack a profile installed on installedOn belongs to when the
+// composite schedule of connectorId is calculated, or nil if the profile does not
+// apply to that connector.
+func (c *connectorProfiles) stack(
+	installedOn, connectorId int,
+	profile types.ChargingProfileType,
+	transaction Transaction,
+	running bool,
+) *[]activeProfile {
+	switch profile.ChargingProfilePurpose {
+	case types.ChargingProfilePurposeTypeChargePointMaxProfile:
+		return &c.chargePointMax
+	case types.ChargingProfilePurposeTypeTxDefaultProfile:
+		switch {
+		case connectorId == 0:
+			return nil
+		case installedOn == connectorId:
+			return &c.txDefault
+		case installedOn == 0:
+			return &c.sharedDefault
+		}
+	case types.ChargingProfilePurposeTypeTxProfile:
+		if connectorId > 0 && installedOn == connectorId && running &&
+			(profile.TransactionId == nil || *profile.TransactionId == transaction.Id) {
+			return &c.tx
+		}
+	}
+
+	return nil
+}
+
+// periods returns the periods of the composite schedule starting at start and
+// lasting duration seconds. A new period starts whenever the limit or the number
+// of phases changes.
+func (c connectorProfiles) periods(start time.Time, duration int) []types.ChargingSchedulePeriodType {
+	end := start.Add(seconds(duration))
+	offsets := []int{0}
+
+	for _, stack := range [][]activeProfile{c.chargePointMax, c.txDefault, c.sharedDefault, c.tx} {
+		for _, active := range stack {
+			for _, boundary := range active.boundaries(start, end) {
+				if offset := ceilSeconds(boundary.Sub(start)); offset > 0 && offset < duration {
+					offsets = append(offsets, offset)
+				}
+			}
+		}
+	}
+
+	slices.Sort(offsets)
+
+	var periods []types.ChargingSchedulePeriodType
+
+	for _, offset := range slices.Compact(offsets) {
+		period := c.limitAt(start.Add(seconds(offset)))
+		period.StartPeriod = offset
+
+		if n := len(periods); n > 0 && periods[n-1].Limit == period.Limit &&
+			samePhases(periods[n-1].NumberPhases, period.NumberPhases) {
+			continue
+		}
+
+		periods = append(periods, period)
+	}
+
+	return periods
+}
+
+// limitAt returns the period of the composite schedule in effect at t: the
+// TxProfile, or else the TxDefaultProfile, capped by the ChargePointMaxProfile and
+// by the maximum limit of the Charge Point.
+//
+// Limits are compared in the unit of the schedule, converted exactly with the
+// number of phases the period in effect charges on. Only the limit of the returned
+// period is rounded down with types.RoundRate, which keeps a converted limit from
+// allowing more than the profile it comes from.
+func (c connectorProfiles) limitAt(t time.Time) types.ChargingSchedulePeriodType {
+	chosen, ok := highest(c.tx, t)
+	if !ok {
+		chosen, ok = highest(c.txDefault, t)
+	}
+
+	if !ok {
+		chosen, ok = highest(c.sharedDefault, t)
+	}
+
+	chargePointMax, capped := highest(c.chargePointMax, t)
+
+	var numberPhases *int
+
+	switch {
+	case ok:
+		numberPhases = chosen.period.NumberPhases
+	case capped:
+		numberPhases = chargePointMax.period.NumberPhases
+	}
+
+	phases := types.DefaultNumberPhases
+	if numberPhases != nil {
+		phases = *numberPhases
+	}
+
+	limit := c.convert(c.maxLimit, c.maxLimitUnit, phases)
+
+	if ok {
+		limit = min(limit, c.convert(chosen.period.Limit, chosen.unit, phases))
+	}
+
+	if capped {
+		limit = min(limit, c.convert(chargePointMax.period.Limit, chargePointMax.unit, phases))
+	}
+
+	return types.ChargingSchedulePeriodType{StartPeriod: 0, Limit: types.RoundRate(limit), NumberPhases: numberPhases}
+}
+
+// convert converts a limit in unit into the unit of the schedule. The units and
+// the voltage are checked when the profiles are gathered, so the conversion only
+// fails for an invalid number of phases, in which case the limit is kept.
+func (c connectorProfiles) convert(limit float64, unit types.ChargingRateUnitType, phases int) float64 {
+	converted, err := types.ConvertRate(limit, unit, c.unit, phases, c.voltage)
+	if err != nil {
+		return limit
+	}
+
+	return converted
+}
+
+// limitInEffect is the period of a profile in effect at a point in time, together
+// with the unit of its limit.
+type limitInEffect struct {
+	period types.ChargingSchedulePeriodType
+	unit   types.ChargingRateUnitType
+}
+
+// highest returns the period in effect at t of the profile with the highest
+// stackLevel among those of stack that are in effect at t.
+func highest(stack []activeProfile, t time.Time) (limitInEffect, bool) {
+	var (
+		found      limitInEffect
+		stackLevel int
+		ok         bool
+	)
+
+	for _, active := range stack {
+		period, inEffect := active.periodAt(t)
+		if inEffect && (!ok || active.profile.StackLevel > stackLevel) {
+			found = limitInEffect{period: period, unit: active.profile.ChargingSchedule.ChargingRateUnit}
+			stackLevel, ok = active.profile.StackLevel, true
+		}
+	}
+
+	return found, ok
+}
+
+// periodAt returns the period of the profile in effect at t. It reports false when
+// t is outside the validity period of the profile, before the start of its
+// schedule or after its duration.
+func (a activeProfile) periodAt(t time.Time) (types.ChargingSchedulePeriodType, bool) {
+	profile := a.profile
+	schedule := profile.ChargingSchedule
+
+	if profile.ValidFrom != nil && t.Before(profile.ValidFrom.Time()) ||
+		profile.ValidTo != nil && !t.Before(profile.ValidTo.Time()) {
+		return types.ChargingSchedulePeriodType{}, false
+	}
+
+	anchor, ok := a.anchorAt(t)
+	if !ok {
+		return types.ChargingSchedulePeriodType{}, false
+	}
+
+	offset := t.Sub(anchor)
+	if offset < 0 || schedule.Duration != nil && offset >= seconds(*schedule.Duration) {
+		return types.ChargingSchedulePeriodType{}, false
+	}
+
+	var (
+		found   types.ChargingSchedulePeriodType
+		started bool
+	)
+
+	for _, period := range schedule.ChargingSchedulePeriod {
+		if seconds(period.StartPeriod) <= offset {
+			found, started = period, true
+		}
+	}
+
+	return found, started
+}
+
+// anchorAt returns the start of the schedule of the profile in effect at t. For a
+// Recurring profile, it is the last recurrence of its startSchedule before t.
+func (a activeProfile) anchorAt(t time.Time) (time.Time, bool) {
+	schedule := a.profile.ChargingSchedule
+
+	switch a.profile.ChargingProfileKind {
+	case types.ChargingProfileKindTypeRecurring:
+		every, ok := a.recurrence()
+		if !ok {
+			return time.Time{}, false
+		}
+
+		start := schedule.StartSchedule.Time()
+		if t.Before(start) {
+			return time.Time{}, false
+		}
+
+		return start.Add(t.Sub(start) / every * every), true
+	case types.ChargingProfileKindTypeAbsolute:
+		if schedule.StartSchedule != nil {
+			return schedule.StartSchedule.Time(), true
+		}
+	case types.ChargingProfileKindTypeRelative:
+	}
+
+	return a.anchor, true
+}
+
+// boundaries returns the times between start and end at which the profile may
+// change the composite schedule: the start of its schedule and of each period, the
+// end of its duration, and the bounds of its validity period.
+func (a activeProfile) boundaries(start, end time.Time) []time.Time {
+	profile := a.profile
+	schedule := profile.ChargingSchedule
+
+	var bounds []time.Time
+
+	for _, anchor := range a.anchors(start, end) {
+		bounds = append(bounds, anchor)
+
+		for _, period := range schedule.ChargingSchedulePeriod {
+			bounds = append(bounds, anchor.Add(seconds(period.StartPeriod)))
+		}
+
+		if schedule.Duration != nil {
+			bounds = append(bounds, anchor.Add(seconds(*schedule.Duration)))
+		}
+	}
+
+	if profile.ValidFrom != nil {
+		bounds = append(bounds, profile.ValidFrom.Time())
+	}
+
+	if profile.ValidTo != nil {
+		bounds = append(bounds, profile.ValidTo.Time())
+	}
+
+	return bounds
+}
+
+// anchors returns the starts of the schedule of the profile that affect the time
+// between start and end: a single one, or every recurrence of a Recurring profile
+// from the one in effect at start.
+func (a activeProfile) anchors(start, end time.Time) []time.Time {
+	if a.profile.ChargingProfileKind != types.ChargingProfileKindTypeRecurring {
+		anchor, _ := a.anchorAt(start)
+
+		return []time.Time{anchor}
+	}
+
+	every, ok := a.recurrence()
+	if !ok {
+		return nil
+	}
+
+	first, ok := a.anchorAt(start)
+	if !ok {
+		first = a.profile.ChargingSchedule.StartSchedule.Time()
+	}
+
+	var anchors []time.Time
+
+	for anchor := first; anchor.Before(end); anchor = anchor.Add(every) {
+		anchors = append(anchors, anchor)
+	}
+
+	return anchors
+}
+
+// recurrence returns the period after which a Recurring profile repeats. It
+// reports false for an incomplete profile without recurrencyKind or
+// startSchedule.
+func (a activeProfile) recurrence() (time.Duration, bool) {
+	if a.profile.RecurrencyKind == nil || a.profile.ChargingSchedule.StartSchedule == nil {
+		return 0, false
+	}
+
+	every, ok := recurrencePeriods[*a.profile.RecurrencyKind]
+
+	return every, ok
+}
+
+// seconds converts a number of seconds into a time.Duration.
+func seconds(n int) time.Duration {
+	return time.Duration(n) * time.Second
+}
+
+// ceilSeconds converts d into whole seconds, rounding up.
+func ceilSeconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
+
+// samePhases reports whether two optional numbers of phases are equal.
+func samePhases(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
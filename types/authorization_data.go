@@ -0,0 +1,109 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// AuthorizationDataType is an entry of a Local Authorization List: an idTag together
+// with its authorization status, as sent in a SendLocalList.req.
+//
+// IdTagInfo is nil when it is not set. In a differential update, an entry without
+// IdTagInfo removes the idTag from the list.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.1: AuthorizationData
+type AuthorizationDataType struct {
+	// IdTag is the identifier the entry applies to.
+	IdTag IdTokenType
+
+	// IdTagInfo is the authorization status of IdTag.
+	IdTagInfo *IdTagInfoType
+}
+
+// AuthorizationData constructs a new AuthorizationDataType for the given idTag,
+// without IdTagInfo.
+//
+// IdTagInfo can be set on the returned value afterwards. It returns an error if
+// idTag is not a valid IdToken.
+func AuthorizationData(idTag string) (AuthorizationDataType, error) {
+	token, err := IdToken(idTag)
+	if err != nil {
+		return AuthorizationDataType{}, WithPath("idTag", err)
+	}
+
+	return AuthorizationDataType{IdTag: token, IdTagInfo: nil}, nil
+}
+
+// Validate checks the AuthorizationDataType and returns the first failure as a
+// *ValidationError with its field path.
+func (a AuthorizationDataType) Validate() error {
+	return FirstError(a.ValidateAll())
+}
+
+// ValidateAll checks every field of the AuthorizationDataType and returns all
+// failures joined with errors.Join, each as a *ValidationError with its field path.
+func (a AuthorizationDataType) ValidateAll() error {
+	return Join(Field("idTag", a.IdTag), OptionalField("idTagInfo", a.IdTagInfo))
+}
+
+// String returns a human-readable representation of the AuthorizationDataType.
+func (a AuthorizationDataType) String() string {
+	str := "{idTag=" + a.IdTag.String()
+
+	if a.IdTagInfo != nil {
+		str += ", idTagInfo=" + a.IdTagInfo.String()
+	}
+
+	return str + "}"
+}
+
+// authorizationDataPayload is the OCPP 1.6J wire representation of
+// AuthorizationDataType.
+type authorizationDataPayload struct {
+	IdTag     *string         `json:"idTag"`
+	IdTagInfo json.RawMessage `json:"idTagInfo,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, producing a `localAuthorizationList` entry
+// with the OCPP 1.6J field names. An unset idTagInfo is omitted. The value is
+// validated first, so an invalid AuthorizationDataType is never encoded.
+func (a AuthorizationDataType) MarshalJSON() ([]byte, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	idTag := a.IdTag.String()
+
+	info, err := EncodeOptional(a.IdTagInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(authorizationDataPayload{IdTag: &idTag, IdTagInfo: info})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a `localAuthorizationList`
+// entry and reporting all of its failures together. A JSON null leaves the value
+// unchanged.
+func (a *AuthorizationDataType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return nil
+	}
+
+	var payload authorizationDataPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	idTag, idTagErr := Required("idTag", payload.IdTag, IdToken)
+	info, infoErr := DecodeOptional[IdTagInfoType]("idTagInfo", payload.IdTagInfo)
+
+	if err := Join(idTagErr, infoErr); err != nil {
+		return err
+	}
+
+	*a = AuthorizationDataType{IdTag: idTag, IdTagInfo: info}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestAuthorizationDataValid(t *testing.T) {
+	t.Parallel()
+
+	entry, err := AuthorizationData("ABC123")
+	if err != nil {
+		t.Fatalf("unexpected error creating AuthorizationData: %v", err)
+	}
+
+	if entry.String() != "{idTag=ABC123}" {
+		t.Errorf("unexpected String() output: %s", entry.String())
+	}
+
+	info, _ := IdTagInfo(Blocked)
+	entry.IdTagInfo = &info
+
+	if err := entry.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+}
+
+func TestAuthorizationDataInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := AuthorizationData("")
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Path != "idTag" || !errors.Is(err, ErrEmptyValueNotAllowed) {
+		t.Errorf("expected failure at idTag, got %v", err)
+	}
+
+	info := IdTagInfoType{Status: "Unknown", ExpiryDate: nil, ParentIdTag: nil}
+	entry := AuthorizationDataType{IdTag: IdTokenType{}, IdTagInfo: &info}
+
+	err = entry.ValidateAll()
+	if !errors.Is(err, ErrEmptyValueNotAllowed) || !errors.Is(err, ErrInvalidAuthorizationStatus) {
+		t.Errorf("expected idTag and status failures, got %v", err)
+	}
+
+	if _, err := json.Marshal(entry); err == nil {
+		t.Error("expected error marshaling invalid AuthorizationData, got nil")
+	}
+}
+
+func TestAuthorizationDataJSON(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{
+		`{"idTag":"ABC123"}`,
+		`{"idTag":"ABC123","idTagInfo":{"status":"Accepted","expiryDate":"2025-04-01T10:00:00.000Z"}}`,
+	} {
+		var entry AuthorizationDataType
+		if err := json.Unmarshal([]byte(data), &entry); err != nil {
+			t.Fatalf("%s: unexpected error unmarshaling AuthorizationData: %v", data, err)
+		}
+
+		out, err := json.Marshal(entry)
+		if err != nil || string(out) != data {
+			t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s, %v", data, out, err)
+		}
+	}
+
+	tests := []struct {
+		data string
+		path string
+	}{
+		{`{}`, "idTag"},
+		{`{"idTag":"ABC123","idTagInfo":{}}`, "idTagInfo.status"},
+		{`{"idTag":"ABC123","idTagInfo":{"status":"Unknown"}}`, "idTagInfo.status"},
+	}
+
+	for _, tc := range tests {
+		var entry AuthorizationDataType
+
+		err := json.Unmarshal([]byte(tc.data), &entry)
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Path != tc.path {
+			t.Errorf("%s: expected failure at %s, got %v", tc.data, tc.path, err)
+		}
+	}
+
+	var entry AuthorizationDataType
+	if err := json.Unmarshal([]byte(`null`), &entry); err != nil {
+		t.Errorf("expected null to be a no-op, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"idTag":1}`), &entry); err == nil {
+		t.Error("expected error for malformed AuthorizationData, got nil")
+	}
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // AuthorizationStatus defines the set of possible outcomes from an authorization attempt
 // of an EV driver's identifier (idTag) in an OCPP 1.6J-based charging infrastructure.
 //
@@ -63,3 +68,46 @@ func (s AuthorizationStatus) IsValid() bool {
 		return false
 	}
 }
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidAuthorizationStatus if the status is not a recognized value.
+func (s AuthorizationStatus) MarshalText() ([]byte, error) {
+	if !s.IsValid() {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAuthorizationStatus, s)
+	}
+
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidAuthorizationStatus if the input is not a recognized value.
+func (s *AuthorizationStatus) UnmarshalText(text []byte) error {
+	status := AuthorizationStatus(text)
+	if !status.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidAuthorizationStatus, status)
+	}
+
+	*s = status
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the status as a JSON string.
+func (s AuthorizationStatus) MarshalJSON() ([]byte, error) {
+	text, err := s.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the status unchanged.
+func (s *AuthorizationStatus) UnmarshalJSON(data []byte) error {
+	value, ok, err := decodeJSONString(data)
+	if err != nil || !ok {
+		return err
+	}
+
+	return s.UnmarshalText([]byte(value))
+}
@@ -1,6 +1,10 @@
 package types
 
-import "testing"
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
 
 // Error message constants.
 const (
@@ -127,3 +131,64 @@ func TestAuthorizationStatusConcurrentTxConstant(t *testing.T) {
 		t.Errorf(errExpectedOutput, ConcurrentTx)
 	}
 }
+
+func TestAuthorizationStatusJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(Blocked)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if string(data) != `"Blocked"` {
+		t.Errorf(errExpectedOutput, data)
+	}
+
+	var status AuthorizationStatus
+	if err := json.Unmarshal(data, &status); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if status != Blocked {
+		t.Errorf(errExpectedOutput, status)
+	}
+}
+
+func TestAuthorizationStatusUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var status AuthorizationStatus
+	if err := json.Unmarshal([]byte(`"accepted"`), &status); !errors.Is(err, ErrInvalidAuthorizationStatus) {
+		t.Errorf("expected ErrInvalidAuthorizationStatus, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`1`), &status); err == nil {
+		t.Error("expected error for non-string status, got nil")
+	}
+}
+
+func TestAuthorizationStatusMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := json.Marshal(AuthorizationStatus("Unknown")); !errors.Is(err, ErrInvalidAuthorizationStatus) {
+		t.Errorf("expected ErrInvalidAuthorizationStatus, got %v", err)
+	}
+}
+
+func TestAuthorizationStatusText(t *testing.T) {
+	t.Parallel()
+
+	var status AuthorizationStatus
+	if err := status.UnmarshalText([]byte("Expired")); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	text, err := status.MarshalText()
+	if err != nil || string(text) != "Expired" {
+		t.Errorf("unexpected MarshalText result: %s, %v", text, err)
+	}
+
+	if err := status.UnmarshalText([]byte("")); !errors.Is(err, ErrInvalidAuthorizationStatus) {
+		t.Errorf("expected ErrInvalidAuthorizationStatus, got %v", err)
+	}
+}
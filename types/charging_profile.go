@@ -0,0 +1,350 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Constraint names reported for the structural rules of a ChargingProfileType.
+const (
+	// ConstraintValidityPeriod is reported when the validTo of a charging profile is
+	// not after its validFrom.
+	ConstraintValidityPeriod = "validFromBeforeValidTo"
+
+	// ConstraintRecurring is reported when a Recurring charging profile has no
+	// recurrencyKind or no chargingSchedule.startSchedule.
+	ConstraintRecurring = "recurring"
+
+	// ConstraintTxProfileOnly is reported when a charging profile other than a
+	// TxProfile carries a transactionId.
+	ConstraintTxProfileOnly = "txProfileOnly"
+)
+
+// Errors reported by ChargingProfileType.
+var (
+	// ErrInvalidStackLevel indicates that the stackLevel of a charging profile is
+	// negative.
+	ErrInvalidStackLevel = errors.New("invalid stackLevel")
+
+	// ErrInvalidValidityPeriod indicates that the validTo of a charging profile is
+	// not after its validFrom.
+	ErrInvalidValidityPeriod = errors.New("validFrom must be before validTo")
+
+	// ErrIncompleteRecurringProfile indicates that a Recurring charging profile has
+	// no recurrencyKind or no startSchedule to repeat from.
+	ErrIncompleteRecurringProfile = errors.New("recurring profile needs recurrencyKind and startSchedule")
+
+	// ErrTransactionIdNotAllowed indicates that a charging profile carries a
+	// transactionId where none is allowed.
+	ErrTransactionIdNotAllowed = errors.New("transactionId not allowed")
+)
+
+// ChargingProfileType is a charging profile: a charging schedule together with its
+// purpose, the way it is anchored in time and its priority among other profiles.
+//
+// It is carried in SetChargingProfile.req and, as a TxProfile, in
+// RemoteStartTransaction.req. Optional fields are nil when they are not set.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.8: ChargingProfile
+type ChargingProfileType struct {
+	// ChargingProfileId uniquely identifies the profile.
+	ChargingProfileId int
+
+	// TransactionId is the transaction the profile applies to. It is only allowed
+	// in TxProfiles.
+	TransactionId *int
+
+	// StackLevel is the priority of the profile: profiles with a higher stack level
+	// take precedence over those with a lower one for the same purpose.
+	StackLevel int
+
+	// ChargingProfilePurpose defines the purpose of the profile.
+	ChargingProfilePurpose ChargingProfilePurposeType
+
+	// ChargingProfileKind tells how the schedule is anchored in time.
+	ChargingProfileKind ChargingProfileKindType
+
+	// RecurrencyKind is the period after which a Recurring profile repeats. It is
+	// required for Recurring profiles, whose schedule must also have a
+	// StartSchedule.
+	RecurrencyKind *RecurrencyKindType
+
+	// ValidFrom is the time from which the profile is valid. When nil, the profile
+	// is valid as soon as it is received.
+	ValidFrom *DateTimeType
+
+	// ValidTo is the time until which the profile is valid. When nil, the profile
+	// is valid until it is replaced. It must be after ValidFrom.
+	ValidTo *DateTimeType
+
+	// ChargingSchedule holds the limits of the profile.
+	ChargingSchedule ChargingScheduleType
+}
+
+// ChargingProfile constructs a new ChargingProfileType from its required fields.
+//
+// Optional fields can be set on the returned profile afterwards. It returns an
+// error if a field is invalid.
+//
+// Example usage:
+//
+//	period, _ := types.ChargingSchedulePeriod(0, 16)
+//	schedule, _ := types.ChargingSchedule(types.ChargingRateUnitTypeA, period)
+//	profile, err := types.ChargingProfile(1, 0, types.ChargingProfilePurposeTypeTxProfile,
+//	    types.ChargingProfileKindTypeRelative, schedule)
+//	if err != nil {
+//	    log.Fatalf("invalid charging profile: %v", err)
+//	}
+func ChargingProfile(
+	chargingProfileId, stackLevel int,
+	purpose ChargingProfilePurposeType,
+	kind ChargingProfileKindType,
+	schedule ChargingScheduleType,
+) (ChargingProfileType, error) {
+	profile := ChargingProfileType{
+		ChargingProfileId:      chargingProfileId,
+		TransactionId:          nil,
+		StackLevel:             stackLevel,
+		ChargingProfilePurpose: purpose,
+		ChargingProfileKind:    kind,
+		RecurrencyKind:         nil,
+		ValidFrom:              nil,
+		ValidTo:                nil,
+		ChargingSchedule:       schedule,
+	}
+
+	if err := profile.Validate(); err != nil {
+		return ChargingProfileType{}, err
+	}
+
+	return profile, nil
+}
+
+// Validate checks the ChargingProfileType and returns the first failure as a
+// *ValidationError with its field path.
+func (p ChargingProfileType) Validate() error {
+	return FirstError(p.ValidateAll())
+}
+
+// ValidateAll checks every field of the ChargingProfileType and returns all failures
+// joined with errors.Join, each as a *ValidationError with its field path, for
+// example "chargingSchedule.chargingSchedulePeriod[0].limit".
+func (p ChargingProfileType) ValidateAll() error {
+	errs := []error{stackLevelError(p.StackLevel)}
+
+	if !p.ChargingProfilePurpose.IsValid() {
+		errs = append(errs, EnumError("chargingProfilePurpose", p.ChargingProfilePurpose,
+			ErrInvalidChargingProfilePurposeType))
+	}
+
+	if !p.ChargingProfileKind.IsValid() {
+		errs = append(errs, EnumError("chargingProfileKind", p.ChargingProfileKind, ErrInvalidChargingProfileKindType))
+	}
+
+	if p.RecurrencyKind != nil && !p.RecurrencyKind.IsValid() {
+		errs = append(errs, EnumError("recurrencyKind", *p.RecurrencyKind, ErrInvalidRecurrencyKindType))
+	}
+
+	errs = append(errs,
+		OptionalField("validFrom", p.ValidFrom),
+		OptionalField("validTo", p.ValidTo),
+		Field("chargingSchedule", p.ChargingSchedule),
+		p.structureError(),
+	)
+
+	return Join(errs...)
+}
+
+// structureError checks the rules that relate the fields of the
+// ChargingProfileType to each other: transactionId is only allowed in a TxProfile,
+// validFrom is before validTo, and a Recurring profile has a recurrencyKind and a
+// startSchedule.
+func (p ChargingProfileType) structureError() error {
+	var errs []error
+
+	purpose := p.ChargingProfilePurpose
+	if p.TransactionId != nil && purpose.IsValid() && purpose != ChargingProfilePurposeTypeTxProfile {
+		errs = append(errs, &ValidationError{
+			Path:       "transactionId",
+			Constraint: ConstraintTxProfileOnly,
+			Value:      *p.TransactionId,
+			Err:        fmt.Errorf("%w: only a TxProfile applies to a transaction, not a %s", ErrTransactionIdNotAllowed, purpose),
+		})
+	}
+
+	if p.ValidFrom != nil && p.ValidTo != nil && !p.ValidFrom.Time().Before(p.ValidTo.Time()) {
+		errs = append(errs, &ValidationError{
+			Path:       "validTo",
+			Constraint: ConstraintValidityPeriod,
+			Value:      p.ValidTo.String(),
+			Err:        fmt.Errorf("%w: %s is not after %s", ErrInvalidValidityPeriod, p.ValidTo, p.ValidFrom),
+		})
+	}
+
+	if p.ChargingProfileKind != ChargingProfileKindTypeRecurring {
+		return Join(errs...)
+	}
+
+	if p.RecurrencyKind == nil {
+		errs = append(errs, recurringError("recurrencyKind"))
+	}
+
+	if p.ChargingSchedule.StartSchedule == nil {
+		errs = append(errs, recurringError("chargingSchedule.startSchedule"))
+	}
+
+	return Join(errs...)
+}
+
+// recurringError reports the field at path missing from a Recurring profile.
+func recurringError(path string) error {
+	return &ValidationError{
+		Path:       path,
+		Constraint: ConstraintRecurring,
+		Value:      nil,
+		Err:        fmt.Errorf("%w: %s is missing", ErrIncompleteRecurringProfile, path),
+	}
+}
+
+// stackLevelError reports a negative stackLevel.
+func stackLevelError(stackLevel int) error {
+	if stackLevel < 0 {
+		return MinimumError("stackLevel", stackLevel, 0, ErrInvalidStackLevel)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the ChargingProfileType.
+//
+// Optional fields are only included when set.
+func (p ChargingProfileType) String() string {
+	fields := []string{"chargingProfileId=" + strconv.Itoa(p.ChargingProfileId)}
+
+	if p.TransactionId != nil {
+		fields = append(fields, "transactionId="+strconv.Itoa(*p.TransactionId))
+	}
+
+	fields = append(fields,
+		"stackLevel="+strconv.Itoa(p.StackLevel),
+		"chargingProfilePurpose="+p.ChargingProfilePurpose.String(),
+		"chargingProfileKind="+p.ChargingProfileKind.String(),
+	)
+
+	if p.RecurrencyKind != nil {
+		fields = append(fields, "recurrencyKind="+p.RecurrencyKind.String())
+	}
+
+	if p.ValidFrom != nil {
+		fields = append(fields, "validFrom="+p.ValidFrom.String())
+	}
+
+	if p.ValidTo != nil {
+		fields = append(fields, "validTo="+p.ValidTo.String())
+	}
+
+	fields = append(fields, "chargingSchedule="+p.ChargingSchedule.String())
+
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// chargingProfilePayload is the OCPP 1.6J wire representation of
+// ChargingProfileType.
+type chargingProfilePayload struct {
+	ChargingProfileId      *int            `json:"chargingProfileId"`
+	TransactionId          *int            `json:"transactionId,omitempty"`
+	StackLevel             *int            `json:"stackLevel"`
+	ChargingProfilePurpose *string         `json:"chargingProfilePurpose"`
+	ChargingProfileKind    *string         `json:"chargingProfileKind"`
+	RecurrencyKind         *string         `json:"recurrencyKind,omitempty"`
+	ValidFrom              *string         `json:"validFrom,omitempty"`
+	ValidTo                *string         `json:"validTo,omitempty"`
+	ChargingSchedule       json.RawMessage `json:"chargingSchedule"`
+}
+
+// MarshalJSON implements json.Marshaler, producing a `chargingProfile` object with
+// the OCPP 1.6J field names. Unset optional fields are omitted. The value is
+// validated first, so an invalid ChargingProfileType is never encoded.
+func (p ChargingProfileType) MarshalJSON() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	purpose := p.ChargingProfilePurpose.String()
+	kind := p.ChargingProfileKind.String()
+
+	schedule, err := json.Marshal(p.ChargingSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(chargingProfilePayload{
+		ChargingProfileId:      &p.ChargingProfileId,
+		TransactionId:          p.TransactionId,
+		StackLevel:             &p.StackLevel,
+		ChargingProfilePurpose: &purpose,
+		ChargingProfileKind:    &kind,
+		RecurrencyKind:         OptionalString(p.RecurrencyKind),
+		ValidFrom:              OptionalString(p.ValidFrom),
+		ValidTo:                OptionalString(p.ValidTo),
+		ChargingSchedule:       schedule,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a `chargingProfile` object and
+// reporting all of its failures together, including those of its schedule.
+// A JSON null leaves the value unchanged.
+func (p *ChargingProfileType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return nil
+	}
+
+	var payload chargingProfilePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	id, idErr := RequiredValue("chargingProfileId", payload.ChargingProfileId)
+	stackLevel, stackLevelErr := RequiredValue("stackLevel", payload.StackLevel)
+	purpose, purposeErr := Required("chargingProfilePurpose", payload.ChargingProfilePurpose,
+		ParseChargingProfilePurposeType)
+	kind, kindErr := Required("chargingProfileKind", payload.ChargingProfileKind, ParseChargingProfileKindType)
+	recurrencyKind, recurrencyKindErr := Optional("recurrencyKind", payload.RecurrencyKind, ParseRecurrencyKindType)
+	validFrom, validFromErr := Optional("validFrom", payload.ValidFrom, ParseDateTime)
+	validTo, validToErr := Optional("validTo", payload.ValidTo, ParseDateTime)
+	schedule, scheduleErr := DecodeRequired[ChargingScheduleType]("chargingSchedule", payload.ChargingSchedule)
+
+	if stackLevelErr == nil {
+		stackLevelErr = stackLevelError(stackLevel)
+	}
+
+	err := Join(idErr, stackLevelErr, purposeErr, kindErr, recurrencyKindErr, validFromErr, validToErr, scheduleErr)
+	if err != nil {
+		return err
+	}
+
+	decoded := ChargingProfileType{
+		ChargingProfileId:      id,
+		TransactionId:          payload.TransactionId,
+		StackLevel:             stackLevel,
+		ChargingProfilePurpose: purpose,
+		ChargingProfileKind:    kind,
+		RecurrencyKind:         recurrencyKind,
+		ValidFrom:              validFrom,
+		ValidTo:                validTo,
+		ChargingSchedule:       schedule,
+	}
+
+	if err := decoded.structureError(); err != nil {
+		return err
+	}
+
+	*p = decoded
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidChargingProfileKindType indicates that a ChargingProfileKindType is not
+// one of the values defined by OCPP 1.6J.
+var ErrInvalidChargingProfileKindType = errors.New("invalid charging profile kind type")
+
+// ChargingProfileKindType tells how the schedule of a charging profile is anchored in
+// time, reported in its `chargingProfileKind` field.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.9: ChargingProfileKindType
+type ChargingProfileKindType string
+
+const (
+	// ChargingProfileKindTypeAbsolute schedules periods relative to the startSchedule
+	// of the charging schedule.
+	ChargingProfileKindTypeAbsolute ChargingProfileKindType = "Absolute"
+
+	// ChargingProfileKindTypeRecurring repeats the schedule daily or weekly from its
+	// startSchedule.
+	ChargingProfileKindTypeRecurring ChargingProfileKindType = "Recurring"
+
+	// ChargingProfileKindTypeRelative schedules periods relative to the start of the
+	// transaction.
+	ChargingProfileKindTypeRelative ChargingProfileKindType = "Relative"
+)
+
+// IsValid returns true if the ChargingProfileKindType is one of the values
+// defined by OCPP 1.6J.
+func (c ChargingProfileKindType) IsValid() bool {
+	switch c {
+	case ChargingProfileKindTypeAbsolute, ChargingProfileKindTypeRecurring, ChargingProfileKindTypeRelative:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ChargingProfileKindType.
+func (c ChargingProfileKindType) String() string {
+	return string(c)
+}
+
+// ParseChargingProfileKindType converts a wire value into a ChargingProfileKindType. Unknown values are
+// rejected with a *ValidationError wrapping ErrInvalidChargingProfileKindType.
+func ParseChargingProfileKindType(value string) (ChargingProfileKindType, error) {
+	return ParseEnum[ChargingProfileKindType](value, ErrInvalidChargingProfileKindType)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidChargingProfileKindType if the value is not recognized.
+func (c ChargingProfileKindType) MarshalText() ([]byte, error) {
+	return MarshalEnum(c, ErrInvalidChargingProfileKindType)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidChargingProfileKindType if the input is not recognized.
+func (c *ChargingProfileKindType) UnmarshalText(text []byte) error {
+	parsed, err := ParseChargingProfileKindType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (c ChargingProfileKindType) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (c *ChargingProfileKindType) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, c, ParseChargingProfileKindType)
+}
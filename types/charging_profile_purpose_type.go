@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidChargingProfilePurposeType indicates that a ChargingProfilePurposeType is not
+// one of the values defined by OCPP 1.6J.
+var ErrInvalidChargingProfilePurposeType = errors.New("invalid charging profile purpose type")
+
+// ChargingProfilePurposeType is the purpose of a charging profile, reported in its
+// `chargingProfilePurpose` field.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.10: ChargingProfilePurposeType
+type ChargingProfilePurposeType string
+
+const (
+	// ChargingProfilePurposeTypeChargePointMaxProfile configures the maximum power or current
+	// available for the whole Charge Point.
+	ChargingProfilePurposeTypeChargePointMaxProfile ChargingProfilePurposeType = "ChargePointMaxProfile"
+
+	// ChargingProfilePurposeTypeTxDefaultProfile is the default profile for new transactions.
+	ChargingProfilePurposeTypeTxDefaultProfile ChargingProfilePurposeType = "TxDefaultProfile"
+
+	// ChargingProfilePurposeTypeTxProfile applies to a single transaction, replacing the
+	// TxDefaultProfile for its duration.
+	ChargingProfilePurposeTypeTxProfile ChargingProfilePurposeType = "TxProfile"
+)
+
+// IsValid returns true if the ChargingProfilePurposeType is one of the values
+// defined by OCPP 1.6J.
+func (c ChargingProfilePurposeType) IsValid() bool {
+	switch c {
+	case ChargingProfilePurposeTypeChargePointMaxProfile, ChargingProfilePurposeTypeTxDefaultProfile, ChargingProfilePurposeTypeTxProfile:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ChargingProfilePurposeType.
+func (c ChargingProfilePurposeType) String() string {
+	return string(c)
+}
+
+// ParseChargingProfilePurposeType converts a wire value into a ChargingProfilePurposeType. Unknown values are
+// rejected with a *ValidationError wrapping ErrInvalidChargingProfilePurposeType.
+func ParseChargingProfilePurposeType(value string) (ChargingProfilePurposeType, error) {
+	return ParseEnum[ChargingProfilePurposeType](value, ErrInvalidChargingProfilePurposeType)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidChargingProfilePurposeType if the value is not recognized.
+func (c ChargingProfilePurposeType) MarshalText() ([]byte, error) {
+	return MarshalEnum(c, ErrInvalidChargingProfilePurposeType)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidChargingProfilePurposeType if the input is not recognized.
+func (c *ChargingProfilePurposeType) UnmarshalText(text []byte) error {
+	parsed, err := ParseChargingProfilePurposeType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (c ChargingProfilePurposeType) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (c *ChargingProfilePurposeType) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, c, ParseChargingProfilePurposeType)
+}
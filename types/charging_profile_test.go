@@ -0,0 +1,206 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testSchedule returns a valid single-period schedule of 16 A.
+func testSchedule(t *testing.T) ChargingScheduleType {
+	t.Helper()
+
+	period, _ := ChargingSchedulePeriod(0, 16)
+
+	schedule, err := ChargingSchedule(ChargingRateUnitTypeA, period)
+	if err != nil {
+		t.Fatalf("unexpected error creating ChargingSchedule: %v", err)
+	}
+
+	return schedule
+}
+
+func TestChargingProfileValid(t *testing.T) {
+	t.Parallel()
+
+	profile, err := ChargingProfile(7, 1, ChargingProfilePurposeTypeTxProfile, ChargingProfileKindTypeRelative,
+		testSchedule(t))
+	if err != nil {
+		t.Fatalf("unexpected error creating ChargingProfile: %v", err)
+	}
+
+	transactionId := 42
+	profile.TransactionId = &transactionId
+
+	want := "{chargingProfileId=7, transactionId=42, stackLevel=1, chargingProfilePurpose=TxProfile, " +
+		"chargingProfileKind=Relative, chargingSchedule={chargingRateUnit=A, chargingSchedulePeriod=[{startPeriod=0, limit=16}]}}"
+	if profile.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, profile.String())
+	}
+}
+
+func TestChargingProfileValidateAll(t *testing.T) {
+	t.Parallel()
+
+	recurrency := RecurrencyKindType("Monthly")
+	profile := ChargingProfileType{
+		ChargingProfileId:      1,
+		TransactionId:          nil,
+		StackLevel:             -1,
+		ChargingProfilePurpose: "",
+		ChargingProfileKind:    "Once",
+		RecurrencyKind:         &recurrency,
+		ValidFrom:              nil,
+		ValidTo:                &DateTimeType{},
+		ChargingSchedule:       ChargingScheduleType{},
+	}
+
+	err := profile.ValidateAll()
+	for _, sentinel := range []error{
+		ErrInvalidStackLevel, ErrInvalidChargingProfilePurposeType, ErrInvalidChargingProfileKindType,
+		ErrInvalidRecurrencyKindType, ErrInvalidDateTime, ErrInvalidChargingRateUnitType, ErrEmptyValueNotAllowed,
+	} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	if !strings.Contains(err.Error(), "chargingSchedule.chargingRateUnit: ") {
+		t.Errorf("expected schedule failures to be reported at their full path, got %v", err)
+	}
+}
+
+func TestChargingProfileJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"chargingProfileId":3,"stackLevel":2,"chargingProfilePurpose":"TxDefaultProfile",` +
+		`"chargingProfileKind":"Recurring","recurrencyKind":"Daily","validFrom":"2025-01-01T00:00:00.000Z",` +
+		`"validTo":"2026-01-01T00:00:00.000Z","chargingSchedule":{"startSchedule":"2025-01-01T00:00:00.000Z",` +
+		`"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":32},{"startPeriod":25200,"limit":10}]}}`
+
+	var profile ChargingProfileType
+	if err := json.Unmarshal([]byte(data), &profile); err != nil {
+		t.Fatalf("unexpected error unmarshaling ChargingProfile: %v", err)
+	}
+
+	out, err := json.Marshal(profile)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling ChargingProfile: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestChargingProfileUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var profile ChargingProfileType
+
+	err := json.Unmarshal([]byte(`{"stackLevel":-1,"chargingProfilePurpose":"TxProfile","chargingProfileKind":"Relative",`+
+		`"chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":1.25}]}}`), &profile)
+	for _, sentinel := range []error{ErrMissingRequiredField, ErrInvalidStackLevel, ErrInvalidLimit} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	if !strings.Contains(err.Error(), "chargingSchedule.chargingSchedulePeriod[0].limit: ") {
+		t.Errorf("expected the limit failure at its full path, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &profile); err != nil {
+		t.Errorf("expected null to be a no-op, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`[]`), &profile); err == nil {
+		t.Error("expected error for malformed ChargingProfile, got nil")
+	}
+
+	if _, err := json.Marshal(ChargingProfileType{}); err == nil {
+		t.Error("expected error marshaling invalid ChargingProfile, got nil")
+	}
+}
+
+func TestChargingProfileStructure(t *testing.T) {
+	t.Parallel()
+
+	from, _ := ParseDateTime("2025-06-01T00:00:00Z")
+	to, _ := ParseDateTime("2025-05-01T00:00:00Z")
+	transactionId := 42
+
+	_, err := ChargingProfile(1, 0, ChargingProfilePurposeTypeTxDefaultProfile, ChargingProfileKindTypeRecurring,
+		testSchedule(t))
+	if !errors.Is(err, ErrIncompleteRecurringProfile) {
+		t.Errorf("expected ErrIncompleteRecurringProfile, got %v", err)
+	}
+
+	profile := ChargingProfileType{
+		ChargingProfileId:      1,
+		TransactionId:          &transactionId,
+		StackLevel:             0,
+		ChargingProfilePurpose: ChargingProfilePurposeTypeTxDefaultProfile,
+		ChargingProfileKind:    ChargingProfileKindTypeRecurring,
+		RecurrencyKind:         nil,
+		ValidFrom:              &from,
+		ValidTo:                &to,
+		ChargingSchedule:       testSchedule(t),
+	}
+
+	want := map[string]string{
+		"transactionId":                  ConstraintTxProfileOnly,
+		"validTo":                        ConstraintValidityPeriod,
+		"recurrencyKind":                 ConstraintRecurring,
+		"chargingSchedule.startSchedule": ConstraintRecurring,
+	}
+
+	errs := splitJoined(profile.ValidateAll())
+	for _, e := range errs {
+		var verr *ValidationError
+		if errors.As(e, &verr) && want[verr.Path] == verr.Constraint {
+			delete(want, verr.Path)
+		}
+	}
+
+	if len(want) != 0 || len(errs) != 4 {
+		t.Errorf("missing failures %v in %v", want, errs)
+	}
+
+	daily := RecurrencyKindTypeDaily
+	profile.TransactionId = nil
+	profile.ValidTo = nil
+	profile.RecurrencyKind = &daily
+	profile.ChargingSchedule.StartSchedule = &from
+
+	if err := profile.Validate(); err != nil {
+		t.Errorf("expected a complete Recurring profile to be valid, got %v", err)
+	}
+}
+
+func TestChargingProfileUnmarshalJSONStructure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		data     string
+		sentinel error
+	}{
+		{`{"chargingProfileId":1,"transactionId":5,"stackLevel":0,"chargingProfilePurpose":"ChargePointMaxProfile",` +
+			`"chargingProfileKind":"Relative","chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":` +
+			`[{"startPeriod":0,"limit":16}]}}`, ErrTransactionIdNotAllowed},
+		{`{"chargingProfileId":1,"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Absolute",` +
+			`"validFrom":"2025-01-01T00:00:00Z","validTo":"2025-01-01T00:00:00Z","chargingSchedule":{"chargingRateUnit":"A",` +
+			`"chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}}`, ErrInvalidValidityPeriod},
+		{`{"chargingProfileId":1,"stackLevel":0,"chargingProfilePurpose":"TxDefaultProfile","chargingProfileKind":"Recurring",` +
+			`"recurrencyKind":"Weekly","chargingSchedule":{"chargingRateUnit":"A","chargingSchedulePeriod":` +
+			`[{"startPeriod":0,"limit":16}]}}`, ErrIncompleteRecurringProfile},
+	}
+
+	for _, tc := range tests {
+		var profile ChargingProfileType
+		if err := json.Unmarshal([]byte(tc.data), &profile); !errors.Is(err, tc.sentinel) {
+			t.Errorf("expected %v, got %v", tc.sentinel, err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// DefaultNumberPhases is the number of phases the specification assumes for a
+// charging schedule period without numberPhases.
+const DefaultNumberPhases = maxNumberPhases
+
+// ErrInvalidVoltage indicates that the nominal voltage used to convert a charging
+// rate between Amperes and Watts is not greater than 0.
+var ErrInvalidVoltage = errors.New("invalid voltage")
+
+// Phases returns the number of phases of the period: its NumberPhases, or
+// DefaultNumberPhases when it is not set.
+func (p ChargingSchedulePeriodType) Phases() int {
+	if p.NumberPhases == nil {
+		return DefaultNumberPhases
+	}
+
+	return *p.NumberPhases
+}
+
+// ConvertRate converts a charging rate from one unit to another.
+//
+// A rate in A becomes a rate in W of rate × voltage × phases, and back, where
+// voltage is the nominal voltage between phase and neutral. The converted rate is
+// exact, so it may have more decimal places than a charging schedule allows; see
+// RoundRate. A rate already in the target unit is returned unchanged and voltage
+// is not used.
+//
+// It returns an error if a unit is not valid, if phases is not 1, 2 or 3, or
+// wrapping ErrInvalidVoltage if voltage is needed and not greater than 0.
+func ConvertRate(rate float64, from, to ChargingRateUnitType, phases int, voltage float64) (float64, error) {
+	if !from.IsValid() {
+		return 0, EnumError("chargingRateUnit", from, ErrInvalidChargingRateUnitType)
+	}
+
+	if !to.IsValid() {
+		return 0, EnumError("chargingRateUnit", to, ErrInvalidChargingRateUnitType)
+	}
+
+	if from == to {
+		return rate, nil
+	}
+
+	if err := numberPhasesError(phases); err != nil {
+		return 0, err
+	}
+
+	if !(voltage > 0) {
+		return 0, fmt.Errorf("%w: %v is not greater than 0", ErrInvalidVoltage, voltage)
+	}
+
+	factor := voltage * float64(phases)
+
+	if to == ChargingRateUnitTypeA {
+		return rate / factor, nil
+	}
+
+	return rate * factor, nil
+}
+
+// RoundRate rounds a charging rate down to the one decimal place a charging
+// schedule allows, so that the rounded rate never allows more than rate.
+func RoundRate(rate float64) float64 {
+	// The small tolerance keeps floating-point noise from rounding an exact result
+	// down.
+	return math.Floor(rate*10+1e-6) / 10
+}
+
+// Convert returns a copy of the schedule with its limits expressed in unit.
+//
+// Each limit is converted with ConvertRate and the number of phases of its period,
+// as reported by Phases; MinChargingRate is converted with the phases of the first
+// period. The converted rates are rounded down with RoundRate, so the schedule
+// stays valid and never allows more than the original one. A schedule already in
+// unit is returned unchanged and voltage is not used.
+//
+// It returns an error if unit or the unit of the schedule is not valid, or
+// wrapping ErrInvalidVoltage if voltage is needed and not greater than 0.
+//
+// Example usage:
+//
+//	watts, err := schedule.Convert(types.ChargingRateUnitTypeW, 230)
+//	if err != nil {
+//	    log.Fatalf("cannot convert charging schedule: %v", err)
+//	}
+func (s ChargingScheduleType) Convert(unit ChargingRateUnitType, voltage float64) (ChargingScheduleType, error) {
+	if _, err := ConvertRate(0, s.ChargingRateUnit, unit, DefaultNumberPhases, voltage); err != nil {
+		return ChargingScheduleType{}, err
+	}
+
+	converted := s
+	converted.ChargingRateUnit = unit
+	converted.ChargingSchedulePeriod = slices.Clone(s.ChargingSchedulePeriod)
+
+	var errs []error
+
+	for i := range converted.ChargingSchedulePeriod {
+		period := &converted.ChargingSchedulePeriod[i]
+
+		limit, err := ConvertRate(period.Limit, s.ChargingRateUnit, unit, period.Phases(), voltage)
+		errs = append(errs, WithPath(ElementPath("chargingSchedulePeriod", i), err))
+		period.Limit = RoundRate(limit)
+	}
+
+	if s.MinChargingRate != nil {
+		phases := DefaultNumberPhases
+		if len(s.ChargingSchedulePeriod) > 0 {
+			phases = s.ChargingSchedulePeriod[0].Phases()
+		}
+
+		// An invalid numberPhases of the first period is reported with its limit.
+		rate, _ := ConvertRate(*s.MinChargingRate, s.ChargingRateUnit, unit, phases, voltage)
+		rate = RoundRate(rate)
+		converted.MinChargingRate = &rate
+	}
+
+	if err := Join(errs...); err != nil {
+		return ChargingScheduleType{}, err
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestChargingSchedulePeriodPhases(t *testing.T) {
+	t.Parallel()
+
+	period, _ := ChargingSchedulePeriod(0, 16)
+	if period.Phases() != DefaultNumberPhases {
+		t.Errorf("expected %d phases by default, got %d", DefaultNumberPhases, period.Phases())
+	}
+
+	phases := 1
+	period.NumberPhases = &phases
+
+	if period.Phases() != 1 {
+		t.Errorf("expected 1 phase, got %d", period.Phases())
+	}
+}
+
+func TestChargingScheduleConvert(t *testing.T) {
+	t.Parallel()
+
+	onePhase := 1
+	first, _ := ChargingSchedulePeriod(0, 16)
+	second, _ := ChargingSchedulePeriod(600, 32.5)
+	second.NumberPhases = &onePhase
+	schedule, _ := ChargingSchedule(ChargingRateUnitTypeA, first, second)
+	minChargingRate := 6.0
+	schedule.MinChargingRate = &minChargingRate
+
+	watts, err := schedule.Convert(ChargingRateUnitTypeW, 230)
+	if err != nil {
+		t.Fatalf("unexpected error converting schedule: %v", err)
+	}
+
+	want := "{chargingRateUnit=W, chargingSchedulePeriod=[{startPeriod=0, limit=11040}, " +
+		"{startPeriod=600, limit=7475, numberPhases=1}], minChargingRate=4140}"
+	if watts.String() != want {
+		t.Errorf("unexpected converted schedule:\nwant: %s\ngot : %s", want, watts.String())
+	}
+
+	if schedule.ChargingRateUnit != ChargingRateUnitTypeA || schedule.ChargingSchedulePeriod[0].Limit != 16 {
+		t.Errorf("expected the original schedule to be unchanged, got %s", schedule)
+	}
+
+	amperes, err := watts.Convert(ChargingRateUnitTypeA, 230)
+	if err != nil || amperes.String() != schedule.String() {
+		t.Errorf("expected the round trip to be lossless, got %s, %v", amperes, err)
+	}
+
+	if err := watts.Validate(); err != nil {
+		t.Errorf("expected the converted schedule to be valid, got %v", err)
+	}
+}
+
+func TestChargingScheduleConvertRoundsDown(t *testing.T) {
+	t.Parallel()
+
+	period, _ := ChargingSchedulePeriod(0, 11000)
+	schedule, _ := ChargingSchedule(ChargingRateUnitTypeW, period)
+
+	amperes, err := schedule.Convert(ChargingRateUnitTypeA, 230)
+	if err != nil || amperes.ChargingSchedulePeriod[0].Limit != 15.9 {
+		t.Errorf("expected 11000 W to become 15.9 A, got %s, %v", amperes, err)
+	}
+
+	if err := amperes.Validate(); err != nil {
+		t.Errorf("expected the converted schedule to be valid, got %v", err)
+	}
+}
+
+func TestChargingScheduleConvertInvalid(t *testing.T) {
+	t.Parallel()
+
+	period, _ := ChargingSchedulePeriod(0, 16)
+	schedule, _ := ChargingSchedule(ChargingRateUnitTypeA, period)
+
+	same, err := schedule.Convert(ChargingRateUnitTypeA, 0)
+	if err != nil || same.String() != schedule.String() {
+		t.Errorf("expected a schedule in the same unit to be unchanged, got %s, %v", same, err)
+	}
+
+	for _, voltage := range []float64{0, -230} {
+		if _, err := schedule.Convert(ChargingRateUnitTypeW, voltage); !errors.Is(err, ErrInvalidVoltage) {
+			t.Errorf("%v: expected ErrInvalidVoltage, got %v", voltage, err)
+		}
+	}
+
+	if _, err := schedule.Convert("kW", 230); !errors.Is(err, ErrInvalidChargingRateUnitType) {
+		t.Errorf("expected ErrInvalidChargingRateUnitType, got %v", err)
+	}
+
+	phases := 4
+	schedule.ChargingSchedulePeriod[0].NumberPhases = &phases
+
+	var verr *ValidationError
+	if _, err := schedule.Convert(ChargingRateUnitTypeW, 230); !errors.As(err, &verr) ||
+		verr.Path != "chargingSchedulePeriod[0].numberPhases" {
+		t.Errorf("expected numberPhases failure, got %v", err)
+	}
+
+	schedule.ChargingRateUnit = "kW"
+	if _, err := schedule.Convert(ChargingRateUnitTypeW, 230); !errors.Is(err, ErrInvalidChargingRateUnitType) {
+		t.Errorf("expected ErrInvalidChargingRateUnitType, got %v", err)
+	}
+}
+
+func TestConvertRate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rate     float64
+		from, to ChargingRateUnitType
+		phases   int
+		want     float64
+	}{
+		{16, ChargingRateUnitTypeA, ChargingRateUnitTypeW, 3, 11040},
+		{16, ChargingRateUnitTypeA, ChargingRateUnitTypeW, 1, 3680},
+		{7400, ChargingRateUnitTypeW, ChargingRateUnitTypeA, 1, 7400.0 / 230},
+		{7360, ChargingRateUnitTypeW, ChargingRateUnitTypeA, 3, 7360.0 / 690},
+		{7400, ChargingRateUnitTypeW, ChargingRateUnitTypeW, 1, 7400},
+	}
+
+	for _, tc := range tests {
+		if got, err := ConvertRate(tc.rate, tc.from, tc.to, tc.phases, 230); err != nil || got != tc.want {
+			t.Errorf("%v %s on %d phases: want %v %s, got %v, %v", tc.rate, tc.from, tc.phases, tc.want, tc.to, got, err)
+		}
+	}
+
+	if _, err := ConvertRate(16, ChargingRateUnitTypeA, ChargingRateUnitTypeW, 0, 230); !errors.Is(err, ErrInvalidNumberPhases) {
+		t.Errorf("expected ErrInvalidNumberPhases, got %v", err)
+	}
+}
+
+func TestRoundRate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct{ rate, want float64 }{
+		{16, 16},
+		{10.6666, 10.6},
+		{32.19, 32.1},
+		{7475.0 / 230, 32.5},
+		{0.3 * 3, 0.9},
+	}
+
+	for _, tc := range tests {
+		if got := RoundRate(tc.rate); got != tc.want {
+			t.Errorf("%v: expected %v, got %v", tc.rate, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidChargingRateUnitType indicates that a ChargingRateUnitType is not
+// one of the values defined by OCPP 1.6J.
+var ErrInvalidChargingRateUnitType = errors.New("invalid charging rate unit type")
+
+// ChargingRateUnitType is the unit of the limits of a charging schedule, reported in
+// its `chargingRateUnit` field.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.12: ChargingRateUnitType
+type ChargingRateUnitType string
+
+const (
+	// ChargingRateUnitTypeA expresses limits in Amperes per phase.
+	ChargingRateUnitTypeA ChargingRateUnitType = "A"
+
+	// ChargingRateUnitTypeW expresses limits in Watts.
+	ChargingRateUnitTypeW ChargingRateUnitType = "W"
+)
+
+// IsValid returns true if the ChargingRateUnitType is one of the values
+// defined by OCPP 1.6J.
+func (c ChargingRateUnitType) IsValid() bool {
+	switch c {
+	case ChargingRateUnitTypeA, ChargingRateUnitTypeW:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ChargingRateUnitType.
+func (c ChargingRateUnitType) String() string {
+	return string(c)
+}
+
+// ParseChargingRateUnitType converts a wire value into a ChargingRateUnitType. Unknown values are
+// rejected with a *ValidationError wrapping ErrInvalidChargingRateUnitType.
+func ParseChargingRateUnitType(value string) (ChargingRateUnitType, error) {
+	return ParseEnum[ChargingRateUnitType](value, ErrInvalidChargingRateUnitType)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidChargingRateUnitType if the value is not recognized.
+func (c ChargingRateUnitType) MarshalText() ([]byte, error) {
+	return MarshalEnum(c, ErrInvalidChargingRateUnitType)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidChargingRateUnitType if the input is not recognized.
+func (c *ChargingRateUnitType) UnmarshalText(text []byte) error {
+	parsed, err := ParseChargingRateUnitType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (c ChargingRateUnitType) MarshalJSON() ([]byte, error) {
+	text, err := c.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (c *ChargingRateUnitType) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, c, ParseChargingRateUnitType)
+}
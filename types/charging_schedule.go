@@ -0,0 +1,284 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Constraint names reported for the order of the periods of a ChargingScheduleType.
+const (
+	// ConstraintFirstPeriod is reported when the first period of a charging
+	// schedule does not start at 0.
+	ConstraintFirstPeriod = "firstPeriodAtZero"
+
+	// ConstraintAscendingPeriods is reported when the startPeriod of a period is
+	// not greater than that of the period before it.
+	ConstraintAscendingPeriods = "ascendingStartPeriod"
+)
+
+// Errors reported by ChargingScheduleType.
+var (
+	// ErrInvalidDuration indicates that the duration of a charging schedule is
+	// negative.
+	ErrInvalidDuration = errors.New("invalid duration")
+
+	// ErrInvalidMinChargingRate indicates that the minChargingRate of a charging
+	// schedule is negative or has more than one decimal place.
+	ErrInvalidMinChargingRate = errors.New("invalid minChargingRate")
+)
+
+// ChargingScheduleType is the schedule of a charging profile: a list of periods with
+// the charging rate limit that applies during each of them.
+//
+// Duration, StartSchedule and MinChargingRate are nil when they are not set. Without
+// a duration, the last period continues indefinitely or until the end of the
+// transaction.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.13: ChargingSchedule
+type ChargingScheduleType struct {
+	// Duration is the length of the schedule in seconds.
+	Duration *int
+
+	// StartSchedule is the start of the schedule. It is required for Absolute and
+	// Recurring profiles, and unset for Relative profiles.
+	StartSchedule *DateTimeType
+
+	// ChargingRateUnit is the unit of the limits of the periods.
+	ChargingRateUnit ChargingRateUnitType
+
+	// ChargingSchedulePeriod holds the periods of the schedule. At least one is
+	// required; the first starts at 0 and the others follow in strictly ascending
+	// order of startPeriod.
+	ChargingSchedulePeriod []ChargingSchedulePeriodType
+
+	// MinChargingRate is the minimum charging rate supported by the EV, in
+	// ChargingRateUnit.
+	MinChargingRate *float64
+}
+
+// ChargingSchedule constructs a new ChargingScheduleType with the given unit and
+// periods, and no optional fields.
+//
+// It returns an error if the unit is not valid, if no period is given, if a period
+// is invalid or if the periods are not in order.
+//
+// Example usage:
+//
+//	period, _ := types.ChargingSchedulePeriod(0, 16)
+//	schedule, err := types.ChargingSchedule(types.ChargingRateUnitTypeA, period)
+//	if err != nil {
+//	    log.Fatalf("invalid charging schedule: %v", err)
+//	}
+func ChargingSchedule(unit ChargingRateUnitType, periods ...ChargingSchedulePeriodType) (ChargingScheduleType, error) {
+	schedule := ChargingScheduleType{
+		Duration:               nil,
+		StartSchedule:          nil,
+		ChargingRateUnit:       unit,
+		ChargingSchedulePeriod: periods,
+		MinChargingRate:        nil,
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return ChargingScheduleType{}, err
+	}
+
+	return schedule, nil
+}
+
+// Validate checks the ChargingScheduleType and returns the first failure as a
+// *ValidationError with its field path.
+func (s ChargingScheduleType) Validate() error {
+	return FirstError(s.ValidateAll())
+}
+
+// ValidateAll checks every field of the ChargingScheduleType and returns all failures
+// joined with errors.Join, each as a *ValidationError with its field path, for
+// example "chargingSchedulePeriod[1].limit".
+func (s ChargingScheduleType) ValidateAll() error {
+	var errs []error
+
+	if s.Duration != nil {
+		errs = append(errs, durationError(*s.Duration))
+	}
+
+	errs = append(errs, OptionalField("startSchedule", s.StartSchedule))
+
+	if !s.ChargingRateUnit.IsValid() {
+		errs = append(errs, EnumError("chargingRateUnit", s.ChargingRateUnit, ErrInvalidChargingRateUnitType))
+	}
+
+	errs = append(errs,
+		periodCountError(len(s.ChargingSchedulePeriod)),
+		ListField("chargingSchedulePeriod", s.ChargingSchedulePeriod),
+		periodOrderError(s.ChargingSchedulePeriod),
+	)
+
+	if s.MinChargingRate != nil {
+		errs = append(errs, chargingRateError("minChargingRate", *s.MinChargingRate, ErrInvalidMinChargingRate))
+	}
+
+	return Join(errs...)
+}
+
+// durationError reports a negative duration.
+func durationError(duration int) error {
+	if duration < 0 {
+		return MinimumError("duration", duration, 0, ErrInvalidDuration)
+	}
+
+	return nil
+}
+
+// periodCountError reports a ChargingSchedule without periods.
+func periodCountError(count int) error {
+	if count < 1 {
+		return MinItemsError("chargingSchedulePeriod", count, 1, ErrEmptyValueNotAllowed)
+	}
+
+	return nil
+}
+
+// periodOrderError reports a first period that does not start at 0 and every period
+// that does not start after the one before it. Negative start periods are reported
+// by the periods themselves.
+func periodOrderError(periods []ChargingSchedulePeriodType) error {
+	errs := make([]error, 0, len(periods))
+
+	for i, period := range periods {
+		path := ElementPath("chargingSchedulePeriod", i) + ".startPeriod"
+
+		switch {
+		case period.StartPeriod < 0:
+			continue
+		case i == 0 && period.StartPeriod != 0:
+			errs = append(errs, &ValidationError{
+				Path:       path,
+				Constraint: ConstraintFirstPeriod,
+				Value:      period.StartPeriod,
+				Err:        fmt.Errorf("%w: the first period starts at %d instead of 0", ErrInvalidStartPeriod, period.StartPeriod),
+			})
+		case i > 0 && period.StartPeriod <= periods[i-1].StartPeriod:
+			errs = append(errs, &ValidationError{
+				Path:       path,
+				Constraint: ConstraintAscendingPeriods,
+				Value:      period.StartPeriod,
+				Err: fmt.Errorf("%w: %d does not follow %d", ErrInvalidStartPeriod, period.StartPeriod,
+					periods[i-1].StartPeriod),
+			})
+		}
+	}
+
+	return Join(errs...)
+}
+
+// String returns a human-readable representation of the ChargingScheduleType.
+//
+// Optional fields are only included when set.
+func (s ChargingScheduleType) String() string {
+	var fields []string
+
+	if s.Duration != nil {
+		fields = append(fields, "duration="+strconv.Itoa(*s.Duration))
+	}
+
+	if s.StartSchedule != nil {
+		fields = append(fields, "startSchedule="+s.StartSchedule.String())
+	}
+
+	fields = append(fields,
+		"chargingRateUnit="+s.ChargingRateUnit.String(),
+		"chargingSchedulePeriod="+FormatList(s.ChargingSchedulePeriod),
+	)
+
+	if s.MinChargingRate != nil {
+		fields = append(fields, "minChargingRate="+formatRate(*s.MinChargingRate))
+	}
+
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// chargingSchedulePayload is the OCPP 1.6J wire representation of
+// ChargingScheduleType.
+type chargingSchedulePayload struct {
+	Duration               *int              `json:"duration,omitempty"`
+	StartSchedule          *string           `json:"startSchedule,omitempty"`
+	ChargingRateUnit       *string           `json:"chargingRateUnit"`
+	ChargingSchedulePeriod []json.RawMessage `json:"chargingSchedulePeriod"`
+	MinChargingRate        *float64          `json:"minChargingRate,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, producing a `chargingSchedule` object with
+// the OCPP 1.6J field names. Unset optional fields are omitted. The value is
+// validated first, so an invalid ChargingScheduleType is never encoded.
+func (s ChargingScheduleType) MarshalJSON() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	unit := s.ChargingRateUnit.String()
+
+	periods, err := EncodeList(s.ChargingSchedulePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(chargingSchedulePayload{
+		Duration:               s.Duration,
+		StartSchedule:          OptionalString(s.StartSchedule),
+		ChargingRateUnit:       &unit,
+		ChargingSchedulePeriod: periods,
+		MinChargingRate:        s.MinChargingRate,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a `chargingSchedule` object
+// and reporting all of its failures together, including those of every period.
+// A JSON null leaves the value unchanged.
+func (s *ChargingScheduleType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return nil
+	}
+
+	var payload chargingSchedulePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	var durationErr, minChargingRateErr error
+
+	if payload.Duration != nil {
+		durationErr = durationError(*payload.Duration)
+	}
+
+	startSchedule, startScheduleErr := Optional("startSchedule", payload.StartSchedule, ParseDateTime)
+	unit, unitErr := Required("chargingRateUnit", payload.ChargingRateUnit, ParseChargingRateUnitType)
+	periods, periodsErr := DecodeRequiredList[ChargingSchedulePeriodType](
+		"chargingSchedulePeriod", payload.ChargingSchedulePeriod)
+
+	if payload.ChargingSchedulePeriod != nil && periodsErr == nil {
+		periodsErr = Join(periodCountError(len(periods)), periodOrderError(periods))
+	}
+
+	if payload.MinChargingRate != nil {
+		minChargingRateErr = chargingRateError("minChargingRate", *payload.MinChargingRate, ErrInvalidMinChargingRate)
+	}
+
+	if err := Join(durationErr, startScheduleErr, unitErr, periodsErr, minChargingRateErr); err != nil {
+		return err
+	}
+
+	*s = ChargingScheduleType{
+		Duration:               payload.Duration,
+		StartSchedule:          startSchedule,
+		ChargingRateUnit:       unit,
+		ChargingSchedulePeriod: periods,
+		MinChargingRate:        payload.MinChargingRate,
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Errors reported by ChargingSchedulePeriodType.
+var (
+	// ErrInvalidStartPeriod indicates that the startPeriod of a charging schedule
+	// period is negative.
+	ErrInvalidStartPeriod = errors.New("invalid startPeriod")
+
+	// ErrInvalidLimit indicates that the limit of a charging schedule period is
+	// negative or has more than one decimal place.
+	ErrInvalidLimit = errors.New("invalid limit")
+
+	// ErrInvalidNumberPhases indicates that the numberPhases of a charging schedule
+	// period is not 1, 2 or 3.
+	ErrInvalidNumberPhases = errors.New("invalid numberPhases")
+)
+
+// maxNumberPhases is the largest number of phases an EV can charge on.
+const maxNumberPhases = 3
+
+// ChargingSchedulePeriodType is one period of a charging schedule, during which a
+// single limit applies.
+//
+// NumberPhases is nil when it is not set, in which case the specification assumes 3
+// phases.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.14: ChargingSchedulePeriod
+type ChargingSchedulePeriodType struct {
+	// StartPeriod is the start of the period, in seconds from the start of the
+	// schedule. It also marks the end of the previous period.
+	StartPeriod int
+
+	// Limit is the maximum charging rate during the period, in the
+	// chargingRateUnit of the schedule. It has at most one decimal place.
+	Limit float64
+
+	// NumberPhases is the number of phases that can be used for charging.
+	NumberPhases *int
+}
+
+// ChargingSchedulePeriod constructs a new ChargingSchedulePeriodType starting
+// startPeriod seconds into the schedule, with the given limit.
+//
+// It returns an error if startPeriod or limit is negative, or if limit has more than
+// one decimal place.
+func ChargingSchedulePeriod(startPeriod int, limit float64) (ChargingSchedulePeriodType, error) {
+	period := ChargingSchedulePeriodType{StartPeriod: startPeriod, Limit: limit, NumberPhases: nil}
+	if err := period.Validate(); err != nil {
+		return ChargingSchedulePeriodType{}, err
+	}
+
+	return period, nil
+}
+
+// Validate checks the ChargingSchedulePeriodType and returns the first failure as a
+// *ValidationError with its field path.
+func (p ChargingSchedulePeriodType) Validate() error {
+	return FirstError(p.ValidateAll())
+}
+
+// ValidateAll checks every field of the ChargingSchedulePeriodType and returns all
+// failures joined with errors.Join, each as a *ValidationError with its field path.
+func (p ChargingSchedulePeriodType) ValidateAll() error {
+	errs := []error{startPeriodError(p.StartPeriod), chargingRateError("limit", p.Limit, ErrInvalidLimit)}
+
+	if p.NumberPhases != nil {
+		errs = append(errs, numberPhasesError(*p.NumberPhases))
+	}
+
+	return Join(errs...)
+}
+
+// startPeriodError reports a negative startPeriod.
+func startPeriodError(startPeriod int) error {
+	if startPeriod < 0 {
+		return MinimumError("startPeriod", startPeriod, 0, ErrInvalidStartPeriod)
+	}
+
+	return nil
+}
+
+// numberPhasesError reports a numberPhases outside 1 to 3.
+func numberPhasesError(numberPhases int) error {
+	switch {
+	case numberPhases < 1:
+		return MinimumError("numberPhases", numberPhases, 1, ErrInvalidNumberPhases)
+	case numberPhases > maxNumberPhases:
+		return MaximumError("numberPhases", numberPhases, maxNumberPhases, ErrInvalidNumberPhases)
+	default:
+		return nil
+	}
+}
+
+// chargingRateError reports a charging rate at path that is negative or has more
+// than one decimal place. The error wraps sentinel.
+func chargingRateError(path string, rate float64, sentinel error) error {
+	if rate < 0 {
+		return &ValidationError{
+			Path:       path,
+			Constraint: ConstraintMinimum(0),
+			Value:      rate,
+			Err:        fmt.Errorf("%w: %v is lower than 0", sentinel, rate),
+		}
+	}
+
+	scaled := rate * 10
+	if math.Abs(scaled-math.Round(scaled)) > 1e-9*math.Max(1, scaled) {
+		return &ValidationError{
+			Path:       path,
+			Constraint: ConstraintMultipleOfTenth,
+			Value:      rate,
+			Err:        fmt.Errorf("%w: %v is not a multiple of 0.1", sentinel, rate),
+		}
+	}
+
+	return nil
+}
+
+// formatRate returns the shortest decimal representation of a charging rate.
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(rate, 'f', -1, 64)
+}
+
+// String returns a human-readable representation of the ChargingSchedulePeriodType.
+func (p ChargingSchedulePeriodType) String() string {
+	str := "{startPeriod=" + strconv.Itoa(p.StartPeriod) + ", limit=" + formatRate(p.Limit)
+
+	if p.NumberPhases != nil {
+		str += ", numberPhases=" + strconv.Itoa(*p.NumberPhases)
+	}
+
+	return str + "}"
+}
+
+// chargingSchedulePeriodPayload is the OCPP 1.6J wire representation of
+// ChargingSchedulePeriodType.
+type chargingSchedulePeriodPayload struct {
+	StartPeriod  *int     `json:"startPeriod"`
+	Limit        *float64 `json:"limit"`
+	NumberPhases *int     `json:"numberPhases,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, producing a `chargingSchedulePeriod` object
+// with the OCPP 1.6J field names. The value is validated first, so an invalid
+// ChargingSchedulePeriodType is never encoded.
+func (p ChargingSchedulePeriodType) MarshalJSON() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(chargingSchedulePeriodPayload{
+		StartPeriod:  &p.StartPeriod,
+		Limit:        &p.Limit,
+		NumberPhases: p.NumberPhases,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a `chargingSchedulePeriod`
+// object and reporting all of its failures together. A JSON null leaves the value
+// unchanged.
+func (p *ChargingSchedulePeriodType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return nil
+	}
+
+	var payload chargingSchedulePeriodPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	startPeriod, startPeriodErr := RequiredValue("startPeriod", payload.StartPeriod)
+	limit, limitErr := RequiredValue("limit", payload.Limit)
+
+	if startPeriodErr == nil {
+		startPeriodErr = startPeriodError(startPeriod)
+	}
+
+	if limitErr == nil {
+		limitErr = chargingRateError("limit", limit, ErrInvalidLimit)
+	}
+
+	var numberPhasesErr error
+	if payload.NumberPhases != nil {
+		numberPhasesErr = numberPhasesError(*payload.NumberPhases)
+	}
+
+	if err := Join(startPeriodErr, limitErr, numberPhasesErr); err != nil {
+		return err
+	}
+
+	*p = ChargingSchedulePeriodType{StartPeriod: startPeriod, Limit: limit, NumberPhases: payload.NumberPhases}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestChargingSchedulePeriodValid(t *testing.T) {
+	t.Parallel()
+
+	period, err := ChargingSchedulePeriod(600, 16.1)
+	if err != nil {
+		t.Fatalf("unexpected error creating ChargingSchedulePeriod: %v", err)
+	}
+
+	phases := 1
+	period.NumberPhases = &phases
+
+	if err := period.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	if period.String() != "{startPeriod=600, limit=16.1, numberPhases=1}" {
+		t.Errorf("unexpected String() output: %s", period.String())
+	}
+}
+
+func TestChargingSchedulePeriodInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		startPeriod  int
+		limit        float64
+		numberPhases int
+		constraint   string
+		sentinel     error
+	}{
+		{-1, 16, 3, "minimum=0", ErrInvalidStartPeriod},
+		{0, -6, 3, "minimum=0", ErrInvalidLimit},
+		{0, 16.05, 3, ConstraintMultipleOfTenth, ErrInvalidLimit},
+		{0, 16, 0, "minimum=1", ErrInvalidNumberPhases},
+		{0, 16, 4, "maximum=3", ErrInvalidNumberPhases},
+	}
+
+	for _, tc := range tests {
+		period := ChargingSchedulePeriodType{StartPeriod: tc.startPeriod, Limit: tc.limit, NumberPhases: &tc.numberPhases}
+
+		err := period.Validate()
+
+		var verr *ValidationError
+		if !errors.Is(err, tc.sentinel) || !errors.As(err, &verr) || verr.Constraint != tc.constraint {
+			t.Errorf("%v: expected %s failure wrapping %v, got %v", period, tc.constraint, tc.sentinel, err)
+		}
+	}
+
+	if _, err := ChargingSchedulePeriod(0, 0.3); err != nil {
+		t.Errorf("expected 0.3 to be a multiple of 0.1, got %v", err)
+	}
+}
+
+func TestChargingSchedulePeriodJSON(t *testing.T) {
+	t.Parallel()
+
+	data := `{"startPeriod":0,"limit":11000.5,"numberPhases":3}`
+
+	var period ChargingSchedulePeriodType
+	if err := json.Unmarshal([]byte(data), &period); err != nil {
+		t.Fatalf("unexpected error unmarshaling ChargingSchedulePeriod: %v", err)
+	}
+
+	out, err := json.Marshal(period)
+	if err != nil || string(out) != data {
+		t.Errorf("unexpected JSON round trip: %s, %v", out, err)
+	}
+
+	err = json.Unmarshal([]byte(`{"startPeriod":-1,"numberPhases":5}`), &period)
+	for _, sentinel := range []error{ErrInvalidStartPeriod, ErrMissingRequiredField, ErrInvalidNumberPhases} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"startPeriod":0,"limit":"16"}`), &period); err == nil {
+		t.Error("expected error for malformed ChargingSchedulePeriod, got nil")
+	}
+
+	if _, err := json.Marshal(ChargingSchedulePeriodType{StartPeriod: 0, Limit: 0.01, NumberPhases: nil}); !errors.Is(err, ErrInvalidLimit) {
+		t.Errorf("expected ErrInvalidLimit, got %v", err)
+	}
+}
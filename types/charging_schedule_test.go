@@ -0,0 +1,173 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestChargingScheduleValid(t *testing.T) {
+	t.Parallel()
+
+	first, _ := ChargingSchedulePeriod(0, 32)
+	second, _ := ChargingSchedulePeriod(3600, 16)
+
+	schedule, err := ChargingSchedule(ChargingRateUnitTypeA, first, second)
+	if err != nil {
+		t.Fatalf("unexpected error creating ChargingSchedule: %v", err)
+	}
+
+	duration := 7200
+	minRate := 6.0
+	schedule.Duration = &duration
+	schedule.MinChargingRate = &minRate
+
+	want := "{duration=7200, chargingRateUnit=A, chargingSchedulePeriod=[{startPeriod=0, limit=32}, " +
+		"{startPeriod=3600, limit=16}], minChargingRate=6}"
+	if schedule.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, schedule.String())
+	}
+}
+
+func TestChargingScheduleValidateAll(t *testing.T) {
+	t.Parallel()
+
+	duration := -1
+	minRate := 0.25
+	schedule := ChargingScheduleType{
+		Duration:               &duration,
+		StartSchedule:          &DateTimeType{},
+		ChargingRateUnit:       "kW",
+		ChargingSchedulePeriod: []ChargingSchedulePeriodType{{StartPeriod: 0, Limit: -1, NumberPhases: nil}},
+		MinChargingRate:        &minRate,
+	}
+
+	paths := make([]string, 0, 5)
+
+	for _, e := range splitJoined(schedule.ValidateAll()) {
+		var verr *ValidationError
+		if errors.As(e, &verr) {
+			paths = append(paths, verr.Path)
+		}
+	}
+
+	want := []string{"duration", "startSchedule", "chargingRateUnit", "chargingSchedulePeriod[0].limit", "minChargingRate"}
+	if len(paths) != len(want) {
+		t.Fatalf("expected failures at %v, got %v", want, paths)
+	}
+
+	for i := range want {
+		if paths[i] != want[i] {
+			t.Errorf("expected failures at %v, got %v", want, paths)
+		}
+	}
+
+	_, err := ChargingSchedule(ChargingRateUnitTypeW)
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Path != "chargingSchedulePeriod" || verr.Constraint != "minItems=1" {
+		t.Errorf("expected minItems failure at chargingSchedulePeriod, got %v", err)
+	}
+}
+
+func TestChargingScheduleJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"duration":86400,"startSchedule":"2025-01-02T00:00:00.000Z","chargingRateUnit":"W",` +
+		`"chargingSchedulePeriod":[{"startPeriod":0,"limit":11000},{"startPeriod":28800,"limit":3700,"numberPhases":1}],` +
+		`"minChargingRate":1400.5}`
+
+	var schedule ChargingScheduleType
+	if err := json.Unmarshal([]byte(data), &schedule); err != nil {
+		t.Fatalf("unexpected error unmarshaling ChargingSchedule: %v", err)
+	}
+
+	out, err := json.Marshal(schedule)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling ChargingSchedule: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestChargingScheduleUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		data string
+		path string
+	}{
+		{`{"chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}`, "chargingRateUnit"},
+		{`{"chargingRateUnit":"A"}`, "chargingSchedulePeriod"},
+		{`{"chargingRateUnit":"A","chargingSchedulePeriod":[]}`, "chargingSchedulePeriod"},
+		{`{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0}]}`, "chargingSchedulePeriod[0].limit"},
+		{`{"duration":-5,"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}]}`, "duration"},
+		{`{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16}],"minChargingRate":0.01}`,
+			"minChargingRate"},
+	}
+
+	for _, tc := range tests {
+		var schedule ChargingScheduleType
+
+		err := json.Unmarshal([]byte(tc.data), &schedule)
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Path != tc.path {
+			t.Errorf("%s: expected failure at %s, got %v", tc.data, tc.path, err)
+		}
+	}
+
+	var schedule ChargingScheduleType
+	if err := json.Unmarshal([]byte(`null`), &schedule); err != nil {
+		t.Errorf("expected null to be a no-op, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"chargingRateUnit":1}`), &schedule); err == nil {
+		t.Error("expected error for malformed ChargingSchedule, got nil")
+	}
+}
+
+func TestChargingSchedulePeriodOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		starts     []int
+		path       string
+		constraint string
+	}{
+		{[]int{60}, "chargingSchedulePeriod[0].startPeriod", ConstraintFirstPeriod},
+		{[]int{0, 600, 600}, "chargingSchedulePeriod[2].startPeriod", ConstraintAscendingPeriods},
+		{[]int{0, 600, 300}, "chargingSchedulePeriod[2].startPeriod", ConstraintAscendingPeriods},
+		{[]int{0, -1}, "chargingSchedulePeriod[1].startPeriod", "minimum=0"},
+	}
+
+	for _, tc := range tests {
+		periods := make([]ChargingSchedulePeriodType, 0, len(tc.starts))
+		for _, start := range tc.starts {
+			periods = append(periods, ChargingSchedulePeriodType{StartPeriod: start, Limit: 16, NumberPhases: nil})
+		}
+
+		_, err := ChargingSchedule(ChargingRateUnitTypeA, periods...)
+
+		var verr *ValidationError
+		if !errors.Is(err, ErrInvalidStartPeriod) || !errors.As(err, &verr) || verr.Path != tc.path || verr.Constraint != tc.constraint {
+			t.Errorf("%v: expected %s failure at %s, got %v", tc.starts, tc.constraint, tc.path, err)
+		}
+
+		if errs := splitJoined(ChargingScheduleType{
+			Duration: nil, StartSchedule: nil, ChargingRateUnit: ChargingRateUnitTypeA,
+			ChargingSchedulePeriod: periods, MinChargingRate: nil,
+		}.ValidateAll()); len(errs) > 1 {
+			t.Errorf("%v: expected a single failure, got %v", tc.starts, errs)
+		}
+	}
+
+	data := `{"chargingRateUnit":"A","chargingSchedulePeriod":[{"startPeriod":0,"limit":16},{"startPeriod":0,"limit":8}]}`
+
+	var schedule ChargingScheduleType
+	if err := json.Unmarshal([]byte(data), &schedule); !errors.Is(err, ErrInvalidStartPeriod) {
+		t.Errorf("expected ErrInvalidStartPeriod for duplicate start periods, got %v", err)
+	}
+}
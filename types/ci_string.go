@@ -12,6 +12,7 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 )
@@ -39,6 +40,10 @@ var (
 	// ErrEmptyValueNotAllowed indicates that a CiString value was expected but
 	// found to be empty.
 	ErrEmptyValueNotAllowed = errors.New("value must not be empty")
+
+	// ErrMissingRequiredField indicates that a field required by the OCPP 1.6J
+	// specification is absent from a decoded payload.
+	ErrMissingRequiredField = errors.New("required field is missing")
 )
 
 // ciString is an internal utility type representing a case-insensitive string
@@ -73,23 +78,44 @@ func CiString(value string, maxLen int) (ciString, error) {
 //   - The string does not exceed the maximum length.
 //   - All characters are printable ASCII.
 //
-// If validation fails, a wrapped error is returned to indicate the specific failure.
+// If validation fails, a *ValidationError wrapping the specific sentinel is returned
+// for the first violated rule.
 func (cs ciString) validate() error {
+	return FirstError(cs.validateAll())
+}
+
+// validateAll performs the same checks as validate but reports every violated rule,
+// joined with errors.Join. An empty string is only reported as empty.
+func (cs ciString) validateAll() error {
 	if len(cs.Value) == 0 {
-		return ErrEmptyValueNotAllowed
+		return &ValidationError{Path: "", Constraint: ConstraintNotEmpty, Value: cs.Value, Err: ErrEmptyValueNotAllowed}
 	}
 
+	var errs []error
+
 	if len(cs.Value) > cs.MaxLen {
-		return fmt.Errorf("%w: actual length %d, max %d", ErrExceedsMaxLength, len(cs.Value), cs.MaxLen)
+		errs = append(errs, &ValidationError{
+			Path:       "",
+			Constraint: ConstraintMaxLength(cs.MaxLen),
+			Value:      cs.Value,
+			Err:        fmt.Errorf("%w: actual length %d, max %d", ErrExceedsMaxLength, len(cs.Value), cs.MaxLen),
+		})
 	}
 
 	for _, r := range cs.Value {
 		if r < 32 || r > 126 {
-			return ErrNonPrintableASCII
+			errs = append(errs, &ValidationError{
+				Path:       "",
+				Constraint: ConstraintPrintableASCII,
+				Value:      cs.Value,
+				Err:        ErrNonPrintableASCII,
+			})
+
+			break
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // String returns the string representation of the ciString.
@@ -97,6 +123,42 @@ func (cs ciString) String() string {
 	return cs.Value
 }
 
+// marshalText validates the ciString and returns its raw bytes.
+func (cs ciString) marshalText() ([]byte, error) {
+	if err := cs.validate(); err != nil {
+		return nil, err
+	}
+
+	return []byte(cs.Value), nil
+}
+
+// marshalJSON validates the ciString and encodes it as a JSON string.
+func (cs ciString) marshalJSON() ([]byte, error) {
+	if err := cs.validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(cs.Value)
+}
+
+// unmarshalJSONCiString decodes a JSON string into a ciString with the given
+// maximum length, applying the same validation as CiString.
+//
+// A JSON null leaves the target untouched and is reported with ok=false.
+func unmarshalJSONCiString(data []byte, maxLen int) (cs ciString, ok bool, err error) {
+	value, ok, err := decodeJSONString(data)
+	if err != nil || !ok {
+		return ciString{}, false, err
+	}
+
+	cs, err = CiString(value, maxLen)
+	if err != nil {
+		return ciString{}, false, err
+	}
+
+	return cs, true, nil
+}
+
 // CiString20Type is a case-insensitive string with a maximum length of 20 characters,
 // consisting only of printable ASCII characters.
 //
@@ -122,6 +184,47 @@ func (c CiString20Type) Validate() error {
 	return c.inner.validate()
 }
 
+// ValidateAll reports every violated CiString[20] rule, joined with errors.Join.
+func (c CiString20Type) ValidateAll() error {
+	return c.inner.validateAll()
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails if the value is not a
+// valid CiString[20].
+func (c CiString20Type) MarshalText() ([]byte, error) {
+	return c.inner.marshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the input as a
+// CiString[20].
+func (c *CiString20Type) UnmarshalText(text []byte) error {
+	cs, err := CiString(string(text), maxLenCiString20)
+	if err != nil {
+		return err
+	}
+
+	c.inner = cs
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. It fails if the value is not a valid
+// CiString[20].
+func (c CiString20Type) MarshalJSON() ([]byte, error) {
+	return c.inner.marshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the input as a
+// CiString[20]. A JSON null leaves the value unchanged.
+func (c *CiString20Type) UnmarshalJSON(data []byte) error {
+	cs, ok, err := unmarshalJSONCiString(data, maxLenCiString20)
+	if ok {
+		c.inner = cs
+	}
+
+	return err
+}
+
 // CiString25Type is a case-insensitive string with a maximum length of 25 characters,
 // used frequently for idTags in messages like Authorize.req.
 //
@@ -145,6 +248,47 @@ func (c CiString25Type) Validate() error {
 	return c.inner.validate()
 }
 
+// ValidateAll reports every violated CiString[25] rule, joined with errors.Join.
+func (c CiString25Type) ValidateAll() error {
+	return c.inner.validateAll()
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails if the value is not a
+// valid CiString[25].
+func (c CiString25Type) MarshalText() ([]byte, error) {
+	return c.inner.marshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the input as a
+// CiString[25].
+func (c *CiString25Type) UnmarshalText(text []byte) error {
+	cs, err := CiString(string(text), maxLenCiString25)
+	if err != nil {
+		return err
+	}
+
+	c.inner = cs
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. It fails if the value is not a valid
+// CiString[25].
+func (c CiString25Type) MarshalJSON() ([]byte, error) {
+	return c.inner.marshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the input as a
+// CiString[25]. A JSON null leaves the value unchanged.
+func (c *CiString25Type) UnmarshalJSON(data []byte) error {
+	cs, ok, err := unmarshalJSONCiString(data, maxLenCiString25)
+	if ok {
+		c.inner = cs
+	}
+
+	return err
+}
+
 // CiString50Type is a case-insensitive string with a maximum length of 50 characters,
 // used in OCPP fields requiring slightly longer descriptive strings.
 type CiString50Type struct{ inner ciString }
@@ -166,6 +310,47 @@ func (c CiString50Type) Validate() error {
 	return c.inner.validate()
 }
 
+// ValidateAll reports every violated CiString[50] rule, joined with errors.Join.
+func (c CiString50Type) ValidateAll() error {
+	return c.inner.validateAll()
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails if the value is not a
+// valid CiString[50].
+func (c CiString50Type) MarshalText() ([]byte, error) {
+	return c.inner.marshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the input as a
+// CiString[50].
+func (c *CiString50Type) UnmarshalText(text []byte) error {
+	cs, err := CiString(string(text), maxLenCiString50)
+	if err != nil {
+		return err
+	}
+
+	c.inner = cs
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. It fails if the value is not a valid
+// CiString[50].
+func (c CiString50Type) MarshalJSON() ([]byte, error) {
+	return c.inner.marshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the input as a
+// CiString[50]. A JSON null leaves the value unchanged.
+func (c *CiString50Type) UnmarshalJSON(data []byte) error {
+	cs, ok, err := unmarshalJSONCiString(data, maxLenCiString50)
+	if ok {
+		c.inner = cs
+	}
+
+	return err
+}
+
 // CiString255Type is a case-insensitive string with a maximum length of 255 characters.
 //
 // It supports medium-sized string fields such as vendor identifiers or descriptions
@@ -189,6 +374,47 @@ func (c CiString255Type) Validate() error {
 	return c.inner.validate()
 }
 
+// ValidateAll reports every violated CiString[255] rule, joined with errors.Join.
+func (c CiString255Type) ValidateAll() error {
+	return c.inner.validateAll()
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails if the value is not a
+// valid CiString[255].
+func (c CiString255Type) MarshalText() ([]byte, error) {
+	return c.inner.marshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the input as a
+// CiString[255].
+func (c *CiString255Type) UnmarshalText(text []byte) error {
+	cs, err := CiString(string(text), maxLenCiString255)
+	if err != nil {
+		return err
+	}
+
+	c.inner = cs
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. It fails if the value is not a valid
+// CiString[255].
+func (c CiString255Type) MarshalJSON() ([]byte, error) {
+	return c.inner.marshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the input as a
+// CiString[255]. A JSON null leaves the value unchanged.
+func (c *CiString255Type) UnmarshalJSON(data []byte) error {
+	cs, ok, err := unmarshalJSONCiString(data, maxLenCiString255)
+	if ok {
+		c.inner = cs
+	}
+
+	return err
+}
+
 // CiString500Type is a case-insensitive string with a maximum length of 500 characters.
 //
 // It is suitable for longer free-text fields or extended metadata values allowed by the
@@ -211,3 +437,44 @@ func (c CiString500Type) String() string {
 func (c CiString500Type) Validate() error {
 	return c.inner.validate()
 }
+
+// ValidateAll reports every violated CiString[500] rule, joined with errors.Join.
+func (c CiString500Type) ValidateAll() error {
+	return c.inner.validateAll()
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails if the value is not a
+// valid CiString[500].
+func (c CiString500Type) MarshalText() ([]byte, error) {
+	return c.inner.marshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the input as a
+// CiString[500].
+func (c *CiString500Type) UnmarshalText(text []byte) error {
+	cs, err := CiString(string(text), maxLenCiString500)
+	if err != nil {
+		return err
+	}
+
+	c.inner = cs
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. It fails if the value is not a valid
+// CiString[500].
+func (c CiString500Type) MarshalJSON() ([]byte, error) {
+	return c.inner.marshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the input as a
+// CiString[500]. A JSON null leaves the value unchanged.
+func (c *CiString500Type) UnmarshalJSON(data []byte) error {
+	cs, ok, err := unmarshalJSONCiString(data, maxLenCiString500)
+	if ok {
+		c.inner = cs
+	}
+
+	return err
+}
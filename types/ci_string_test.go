@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -263,3 +265,169 @@ func TestCiStringTypeNullEquivalent(t *testing.T) {
 		t.Errorf("expected error for zero-value string (null equivalent), got nil")
 	}
 }
+
+func TestCiStringTypesMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cs20, _ := CiString20("VENDOR")
+	cs25, _ := CiString25("MODEL")
+	cs50, _ := CiString50("SERIAL")
+	cs255, _ := CiString255("DESCRIPTION")
+	cs500, _ := CiString500("VALUE")
+
+	for _, value := range []json.Marshaler{cs20, cs25, cs50, cs255, cs500} {
+		data, err := value.MarshalJSON()
+		if err != nil {
+			t.Fatalf(errExpectedNoError, err)
+		}
+
+		var decoded string
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("expected JSON string, got %s", data)
+		}
+	}
+}
+
+func TestCiStringTypesMarshalZeroValueFails(t *testing.T) {
+	t.Parallel()
+
+	values := []json.Marshaler{CiString20Type{}, CiString25Type{}, CiString50Type{}, CiString255Type{}, CiString500Type{}}
+	for _, value := range values {
+		if _, err := value.MarshalJSON(); !errors.Is(err, ErrEmptyValueNotAllowed) {
+			t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+		}
+	}
+}
+
+func TestCiStringTypesUnmarshalJSONValid(t *testing.T) {
+	t.Parallel()
+
+	var config struct {
+		Vendor CiString20Type  `json:"vendor"`
+		Model  CiString25Type  `json:"model"`
+		Serial CiString50Type  `json:"serial"`
+		Info   CiString255Type `json:"info"`
+		Value  CiString500Type `json:"value"`
+	}
+
+	data := `{"vendor":"V","model":"M","serial":"S","info":"I","value":"X"}`
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		t.Fatalf(errExpectedNoError, err)
+	}
+
+	got := config.Vendor.String() + config.Model.String() + config.Serial.String() +
+		config.Info.String() + config.Value.String()
+	if got != "VMSIX" {
+		t.Errorf(errExpectedStringOutput, got)
+	}
+}
+
+func TestCiStringTypesUnmarshalJSONTooLong(t *testing.T) {
+	t.Parallel()
+
+	targets := map[int]json.Unmarshaler{
+		maxLenCiString20:  &CiString20Type{},
+		maxLenCiString25:  &CiString25Type{},
+		maxLenCiString50:  &CiString50Type{},
+		maxLenCiString255: &CiString255Type{},
+		maxLenCiString500: &CiString500Type{},
+	}
+
+	for maxLen, target := range targets {
+		data, _ := json.Marshal(strings.Repeat("A", maxLen+1))
+		if err := target.UnmarshalJSON(data); !errors.Is(err, ErrExceedsMaxLength) {
+			t.Errorf("max %d: expected ErrExceedsMaxLength, got %v", maxLen, err)
+		}
+	}
+}
+
+func TestCiStringTypesUnmarshalJSONNonPrintable(t *testing.T) {
+	t.Parallel()
+
+	var cs CiString50Type
+	if err := json.Unmarshal([]byte(`"tab\there"`), &cs); !errors.Is(err, ErrNonPrintableASCII) {
+		t.Errorf("expected ErrNonPrintableASCII, got %v", err)
+	}
+}
+
+func TestCiStringTypesUnmarshalJSONEmpty(t *testing.T) {
+	t.Parallel()
+
+	var cs CiString255Type
+	if err := json.Unmarshal([]byte(`""`), &cs); !errors.Is(err, ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
+
+func TestCiStringTypesUnmarshalJSONNullIsNoOp(t *testing.T) {
+	t.Parallel()
+
+	cs, _ := CiString20("KEEP")
+	if err := json.Unmarshal([]byte(`null`), &cs); err != nil {
+		t.Fatalf(errExpectedNoError, err)
+	}
+
+	if cs.String() != "KEEP" {
+		t.Errorf(errExpectedStringOutput, cs.String())
+	}
+}
+
+func TestCiStringTypesUnmarshalJSONWrongType(t *testing.T) {
+	t.Parallel()
+
+	var cs CiString25Type
+	if err := json.Unmarshal([]byte(`123`), &cs); err == nil {
+		t.Error("expected error for non-string JSON value")
+	}
+}
+
+func TestCiStringTypesTextRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cs20, _ := CiString20("A")
+	cs25, _ := CiString25("B")
+	cs50, _ := CiString50("C")
+	cs255, _ := CiString255("D")
+	cs500, _ := CiString500("E")
+
+	pairs := []struct {
+		in  interface{ MarshalText() ([]byte, error) }
+		out interface{ UnmarshalText(text []byte) error }
+	}{
+		{cs20, &CiString20Type{}},
+		{cs25, &CiString25Type{}},
+		{cs50, &CiString50Type{}},
+		{cs255, &CiString255Type{}},
+		{cs500, &CiString500Type{}},
+	}
+
+	for _, pair := range pairs {
+		text, err := pair.in.MarshalText()
+		if err != nil {
+			t.Fatalf(errExpectedNoError, err)
+		}
+
+		if err := pair.out.UnmarshalText(text); err != nil {
+			t.Errorf(errExpectedNoError, err)
+		}
+	}
+}
+
+func TestCiStringTypesUnmarshalTextInvalid(t *testing.T) {
+	t.Parallel()
+
+	targets := []interface{ UnmarshalText(text []byte) error }{
+		&CiString20Type{}, &CiString25Type{}, &CiString50Type{}, &CiString255Type{}, &CiString500Type{},
+	}
+
+	for _, target := range targets {
+		if err := target.UnmarshalText(nil); !errors.Is(err, ErrEmptyValueNotAllowed) {
+			t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+		}
+	}
+
+	var cs CiString20Type
+	if _, err := cs.MarshalText(); err == nil {
+		t.Error("expected error marshaling zero-value CiString20Type")
+	}
+}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidDateTime indicates that a value is not a valid OCPP dateTime: it could not
+// be parsed in any of the accepted formats, or it is the zero time.
+var ErrInvalidDateTime = errors.New("invalid dateTime")
+
+// dateTimeLayout is the canonical wire format: UTC with millisecond precision.
+const dateTimeLayout = "2006-01-02T15:04:05.000Z"
+
+// dateTimeParseLayouts lists the formats accepted when parsing a dateTime, in order.
+//
+// Fractional seconds are optional in every layout: the time package accepts them
+// after the seconds field even when the layout does not mention them.
+var dateTimeParseLayouts = []string{
+	time.RFC3339,                // 2025-01-02T03:04:05Z, 2025-01-02T03:04:05.123+02:00
+	"2006-01-02T15:04:05Z0700",  // 2025-01-02T03:04:05+0200
+	"2006-01-02T15:04:05",       // 2025-01-02T03:04:05 (no timezone, interpreted as UTC)
+	"2006-01-02 15:04:05Z07:00", // 2025-01-02 03:04:05Z (space separator)
+}
+
+// DateTimeType is an OCPP 1.6J dateTime value.
+//
+// OCPP requires all dateTime fields (expiryDate, currentTime, timestamp, ...) to be
+// exchanged as RFC 3339 strings, and recommends UTC. Real charge points send a range
+// of variants, so DateTimeType accepts RFC 3339 with or without fractional seconds,
+// with `Z` or a numeric offset, and the common variant without any timezone (which is
+// interpreted as UTC). It always emits UTC with millisecond precision, for example
+// "2025-01-02T03:04:05.000Z", and rejects the zero time.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7: Types, dateTime
+//   - OCPP-J 1.6, Section 3.1.4: Time format
+type DateTimeType struct {
+	value time.Time
+}
+
+// DateTime constructs a validated DateTimeType from a time.Time.
+//
+// The time is converted to UTC and truncated to millisecond precision, so the value
+// survives an encode/decode round trip unchanged. It returns an error wrapping
+// ErrInvalidDateTime if t is the zero time.
+func DateTime(t time.Time) (DateTimeType, error) {
+	dt := DateTimeType{value: t.UTC().Truncate(time.Millisecond)}
+	if err := dt.Validate(); err != nil {
+		return DateTimeType{}, err
+	}
+
+	return dt, nil
+}
+
+// ParseDateTime parses an OCPP dateTime string in any of the accepted formats.
+//
+// Example usage:
+//
+//	ts, err := types.ParseDateTime("2025-01-02T03:04:05+02:00")
+//	if err != nil {
+//	    return fmt.Errorf("invalid timestamp: %w", err)
+//	}
+//	fmt.Println(ts) // 2025-01-02T01:04:05.000Z
+func ParseDateTime(value string) (DateTimeType, error) {
+	for _, layout := range dateTimeParseLayouts {
+		parsed, err := time.Parse(layout, value)
+		if err == nil {
+			return DateTime(parsed)
+		}
+	}
+
+	return DateTimeType{}, &ValidationError{
+		Path:       "",
+		Constraint: ConstraintDateTime,
+		Value:      value,
+		Err:        fmt.Errorf("%w: %q", ErrInvalidDateTime, value),
+	}
+}
+
+// Time returns the value as a UTC time.Time.
+func (d DateTimeType) Time() time.Time {
+	return d.value
+}
+
+// String returns the canonical wire representation: UTC with millisecond precision.
+func (d DateTimeType) String() string {
+	return d.value.UTC().Format(dateTimeLayout)
+}
+
+// Validate checks that the DateTimeType holds a non-zero time.
+func (d DateTimeType) Validate() error {
+	if d.value.IsZero() {
+		return &ValidationError{
+			Path:       "",
+			Constraint: ConstraintDateTime,
+			Value:      d.value,
+			Err:        fmt.Errorf("%w: zero time", ErrInvalidDateTime),
+		}
+	}
+
+	return nil
+}
+
+// ValidateAll reports every violated dateTime rule, joined with errors.Join.
+func (d DateTimeType) ValidateAll() error {
+	return errors.Join(d.Validate())
+}
+
+// MarshalText implements encoding.TextMarshaler using the canonical wire format.
+// It fails if the value is the zero time.
+func (d DateTimeType) MarshalText() ([]byte, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the same formats as
+// ParseDateTime.
+func (d *DateTimeType) UnmarshalText(text []byte) error {
+	parsed, err := ParseDateTime(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string in the
+// canonical wire format. It fails if the value is the zero time.
+func (d DateTimeType) MarshalJSON() ([]byte, error) {
+	text, err := d.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the same formats as
+// ParseDateTime. A JSON null leaves the value unchanged.
+func (d *DateTimeType) UnmarshalJSON(data []byte) error {
+	value, ok, err := decodeJSONString(data)
+	if err != nil || !ok {
+		return err
+	}
+
+	return d.UnmarshalText([]byte(value))
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDateTimeNormalizesToUTCMilliseconds(t *testing.T) {
+	t.Parallel()
+
+	zone := time.FixedZone("CEST", 2*60*60)
+
+	dt, err := DateTime(time.Date(2025, 6, 1, 12, 30, 0, 123456789, zone))
+	if err != nil {
+		t.Fatalf(errExpectedNoError, err)
+	}
+
+	if dt.String() != "2025-06-01T10:30:00.123Z" {
+		t.Errorf(errExpectedStringOutput, dt.String())
+	}
+
+	if dt.Time().Location() != time.UTC || dt.Time().Nanosecond() != 123000000 {
+		t.Errorf("expected UTC millisecond value, got %v", dt.Time())
+	}
+}
+
+func TestDateTimeRejectsZero(t *testing.T) {
+	t.Parallel()
+
+	if _, err := DateTime(time.Time{}); !errors.Is(err, ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+
+	if err := (DateTimeType{}).ValidateAll(); !errors.Is(err, ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+
+	if _, err := json.Marshal(DateTimeType{}); !errors.Is(err, ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+}
+
+func TestParseDateTimeAcceptedFormats(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"2025-01-02T03:04:05Z":          "2025-01-02T03:04:05.000Z",
+		"2025-01-02T03:04:05.1Z":        "2025-01-02T03:04:05.100Z",
+		"2025-01-02T03:04:05.123456Z":   "2025-01-02T03:04:05.123Z",
+		"2025-01-02T05:04:05+02:00":     "2025-01-02T03:04:05.000Z",
+		"2025-01-02T01:04:05.250-02:00": "2025-01-02T03:04:05.250Z",
+		"2025-01-02T05:04:05+0200":      "2025-01-02T03:04:05.000Z",
+		"2025-01-02T03:04:05":           "2025-01-02T03:04:05.000Z",
+		"2025-01-02T03:04:05.987":       "2025-01-02T03:04:05.987Z",
+		"2025-01-02 03:04:05Z":          "2025-01-02T03:04:05.000Z",
+	}
+
+	for input, want := range tests {
+		dt, err := ParseDateTime(input)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", input, err)
+
+			continue
+		}
+
+		if dt.String() != want {
+			t.Errorf("%s: expected %s, got %s", input, want, dt.String())
+		}
+	}
+}
+
+func TestParseDateTimeRejectedFormats(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"", "yesterday", "2025-01-02", "2025-13-02T03:04:05Z", "0001-01-01T00:00:00Z"} {
+		_, err := ParseDateTime(input)
+		if !errors.Is(err, ErrInvalidDateTime) {
+			t.Errorf("%q: expected ErrInvalidDateTime, got %v", input, err)
+		}
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Constraint != ConstraintDateTime {
+			t.Errorf("%q: expected dateTime ValidationError, got %v", input, err)
+		}
+	}
+}
+
+func TestDateTimeJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var payload struct {
+		Timestamp DateTimeType `json:"timestamp"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"timestamp":"2025-01-02T03:04:05+01:00"}`), &payload); err != nil {
+		t.Fatalf(errExpectedNoError, err)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf(errExpectedNoError, err)
+	}
+
+	if string(data) != `{"timestamp":"2025-01-02T02:04:05.000Z"}` {
+		t.Errorf("unexpected JSON output: %s", data)
+	}
+
+	if err := json.Unmarshal([]byte(`{"timestamp":1735787045}`), &payload); err == nil {
+		t.Error("expected error for numeric timestamp, got nil")
+	}
+
+	if err := json.Unmarshal([]byte(`{"timestamp":null}`), &payload); err != nil || payload.Timestamp.Validate() != nil {
+		t.Errorf("expected null to leave the value unchanged, got %v", err)
+	}
+}
+
+func TestDateTimeText(t *testing.T) {
+	t.Parallel()
+
+	var dt DateTimeType
+	if err := dt.UnmarshalText([]byte("2025-01-02T03:04:05Z")); err != nil {
+		t.Fatalf(errExpectedNoError, err)
+	}
+
+	text, err := dt.MarshalText()
+	if err != nil || string(text) != "2025-01-02T03:04:05.000Z" {
+		t.Errorf("unexpected MarshalText result: %s, %v", text, err)
+	}
+
+	if err := dt.UnmarshalText([]byte("not a date")); !errors.Is(err, ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+}
@@ -3,9 +3,11 @@
 // This package is responsible for defining shared core types used across all OCPP 1.6 messages.
 // It focuses on strong data modeling, including strict validation of constraints like:
 //
-// These types are designed to be protocol-agnostic, with no dependency on serialization logic
-// (JSON, SOAP, etc.). They are used internally in request and response structures, and can be
-// validated independently.
+// These types are designed to be protocol-agnostic. They are used internally in request and
+// response structures, and can be validated independently. Every type also implements
+// json.Marshaler/json.Unmarshaler and encoding.TextMarshaler/encoding.TextUnmarshaler with
+// the same validation rules, so they can be embedded in configuration structs or API payloads
+// and reject invalid input while decoding.
 //
 // This package should be imported using:
 //
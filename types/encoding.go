@@ -0,0 +1,131 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// jsonNull is the literal JSON null token.
+var jsonNull = []byte("null")
+
+// decodeJSONString decodes a JSON string token into a Go string.
+//
+// It reports ok=false for a JSON null, which callers treat as a no-op to follow
+// the encoding/json convention for Unmarshaler implementations. Any non-string
+// token is returned as a decoding error.
+func decodeJSONString(data []byte) (value string, ok bool, err error) {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return "", false, nil
+	}
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", false, err
+	}
+
+	return value, true, nil
+}
+
+// isAbsent reports whether a raw JSON field value is missing or null.
+func isAbsent(raw json.RawMessage) bool {
+	return len(raw) == 0 || bytes.Equal(bytes.TrimSpace(raw), jsonNull)
+}
+
+// DecodeRequired decodes the raw JSON value of a required field into T, which is
+// typically a nested object or another type implementing json.Unmarshaler.
+//
+// It returns MissingFieldError(path) when the field is absent or null, and reports
+// decoding failures at path. It is used by UnmarshalJSON implementations together
+// with json.RawMessage payload fields.
+func DecodeRequired[T any](path string, raw json.RawMessage) (T, error) {
+	var value T
+
+	if isAbsent(raw) {
+		return value, MissingFieldError(path)
+	}
+
+	if err := json.Unmarshal(raw, &value); err != nil {
+		var zero T
+
+		return zero, WithPath(path, err)
+	}
+
+	return value, nil
+}
+
+// DecodeOptional decodes the raw JSON value of an optional field into T.
+//
+// An absent or null field yields a nil result; decoding failures are reported at path.
+func DecodeOptional[T any](path string, raw json.RawMessage) (*T, error) {
+	if isAbsent(raw) {
+		return nil, nil
+	}
+
+	value, err := DecodeRequired[T](path, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+// DecodeList decodes the raw elements of an optional JSON array into a slice of T.
+//
+// Failures are reported with the element index in the path, for example
+// "meterValue[1].timestamp", and all of them are joined. An absent array yields nil.
+func DecodeList[T any](path string, raw []json.RawMessage) ([]T, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	values := make([]T, 0, len(raw))
+	errs := make([]error, 0, len(raw))
+
+	for i, element := range raw {
+		value, err := DecodeRequired[T](ElementPath(path, i), element)
+		values = append(values, value)
+		errs = append(errs, err)
+	}
+
+	if err := Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// DecodeRequiredList is like DecodeList, but returns MissingFieldError(path) when
+// the array is absent.
+func DecodeRequiredList[T any](path string, raw []json.RawMessage) ([]T, error) {
+	if raw == nil {
+		return nil, MissingFieldError(path)
+	}
+
+	return DecodeList[T](path, raw)
+}
+
+// EncodeOptional encodes an optional value as raw JSON, or returns nil if it is not
+// set, so that the field is omitted from a payload.
+func EncodeOptional[T any](value *T) (json.RawMessage, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(*value)
+}
+
+// EncodeList encodes every element of values as raw JSON. The result is never nil,
+// so that a required empty array is encoded as [] rather than null.
+func EncodeList[T any](values []T) ([]json.RawMessage, error) {
+	raw := make([]json.RawMessage, 0, len(values))
+
+	for _, value := range values {
+		element, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		raw = append(raw, element)
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDecodeRequired(t *testing.T) {
+	t.Parallel()
+
+	for _, raw := range []json.RawMessage{nil, json.RawMessage(`null`)} {
+		if _, err := DecodeRequired[IdTagInfoType]("idTagInfo", raw); !errors.Is(err, ErrMissingRequiredField) {
+			t.Errorf("%s: expected ErrMissingRequiredField, got %v", raw, err)
+		}
+	}
+
+	info, err := DecodeRequired[IdTagInfoType]("idTagInfo", json.RawMessage(`{"status":"Blocked"}`))
+	if err != nil || info.Status != Blocked {
+		t.Errorf("unexpected result: %v, %v", info, err)
+	}
+
+	_, err = DecodeRequired[IdTagInfoType]("idTagInfo", json.RawMessage(`{"status":"Nope"}`))
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Path != "idTagInfo.status" {
+		t.Errorf("expected failure at idTagInfo.status, got %v", err)
+	}
+}
+
+func TestDecodeOptional(t *testing.T) {
+	t.Parallel()
+
+	if value, err := DecodeOptional[IdTokenType]("parentIdTag", json.RawMessage(`null`)); value != nil || err != nil {
+		t.Errorf("expected nil result for null, got %v, %v", value, err)
+	}
+
+	value, err := DecodeOptional[IdTokenType]("parentIdTag", json.RawMessage(`"GROUP"`))
+	if err != nil || value.String() != "GROUP" {
+		t.Errorf("unexpected result: %v, %v", value, err)
+	}
+
+	if _, err := DecodeOptional[IdTokenType]("parentIdTag", json.RawMessage(`""`)); !errors.Is(err, ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
+
+func TestDecodeList(t *testing.T) {
+	t.Parallel()
+
+	if values, err := DecodeList[CiString50Type]("key", nil); values != nil || err != nil {
+		t.Errorf("expected nil result for absent list, got %v, %v", values, err)
+	}
+
+	if _, err := DecodeRequiredList[CiString50Type]("key", nil); !errors.Is(err, ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	values, err := DecodeRequiredList[CiString50Type]("key", []json.RawMessage{[]byte(`"A"`), []byte(`"B"`)})
+	if err != nil || FormatList(values) != "[A, B]" {
+		t.Errorf("unexpected result: %v, %v", values, err)
+	}
+
+	_, err = DecodeList[CiString50Type]("key", []json.RawMessage{[]byte(`"A"`), []byte(`""`), []byte(`1`)})
+
+	errs := splitJoined(err)
+	if len(errs) != 2 {
+		t.Fatalf("expected two failures, got %v", err)
+	}
+
+	var verr *ValidationError
+	if !errors.As(errs[0], &verr) || verr.Path != "key[1]" {
+		t.Errorf("expected failure at key[1], got %v", errs[0])
+	}
+}
+
+func TestEncodeOptionalAndList(t *testing.T) {
+	t.Parallel()
+
+	if raw, err := EncodeOptional[CiString20Type](nil); raw != nil || err != nil {
+		t.Errorf("expected nil for unset value, got %s, %v", raw, err)
+	}
+
+	token, _ := IdToken("ABC")
+	if raw, err := EncodeOptional(&token); err != nil || string(raw) != `"ABC"` {
+		t.Errorf("unexpected result: %s, %v", raw, err)
+	}
+
+	raw, err := EncodeList[CiString20Type](nil)
+	if err != nil || raw == nil || len(raw) != 0 {
+		t.Errorf("expected empty non-nil list, got %v, %v", raw, err)
+	}
+
+	if _, err := EncodeList([]CiString20Type{{}}); !errors.Is(err, ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
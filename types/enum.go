@@ -0,0 +1,49 @@
+package types
+
+// Enumeration is implemented by the string enumerations of OCPP 1.6J, those of
+// this package and those of the message packages.
+type Enumeration interface {
+	~string
+	IsValid() bool
+}
+
+// ParseEnum converts a wire value into the enumeration T, rejecting unknown values
+// with EnumError wrapping sentinel. It implements the ParseX function of every
+// enumeration.
+func ParseEnum[T Enumeration](value string, sentinel error) (T, error) {
+	if !T(value).IsValid() {
+		return "", EnumError("", value, sentinel)
+	}
+
+	return T(value), nil
+}
+
+// MarshalEnum returns the text form of value, failing with EnumError wrapping
+// sentinel if it is not a recognized value. It implements the MarshalText method of
+// every enumeration.
+func MarshalEnum[T Enumeration](value T, sentinel error) ([]byte, error) {
+	if !value.IsValid() {
+		return nil, EnumError("", value, sentinel)
+	}
+
+	return []byte(value), nil
+}
+
+// UnmarshalJSONEnum decodes a JSON string into target with parse. A JSON null
+// leaves target unchanged. It implements the UnmarshalJSON method of every
+// enumeration.
+func UnmarshalJSONEnum[T Enumeration](data []byte, target *T, parse func(string) (T, error)) error {
+	value, ok, err := decodeJSONString(data)
+	if err != nil || !ok {
+		return err
+	}
+
+	parsed, err := parse(value)
+	if err != nil {
+		return err
+	}
+
+	*target = parsed
+
+	return nil
+}
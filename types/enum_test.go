@@ -0,0 +1,217 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// enumCase describes one of the string enumerations of this package.
+type enumCase struct {
+	name     string
+	values   []string
+	invalid  []string
+	sentinel error
+	parse    func(string) (string, error)
+}
+
+// enumCases returns the string enumerations with every valid value.
+func enumCases() []enumCase {
+	return []enumCase{
+		{
+			"ReadingContext",
+			[]string{
+				"Interruption.Begin", "Interruption.End", "Other", "Sample.Clock", "Sample.Periodic",
+				"Transaction.Begin", "Transaction.End", "Trigger",
+			},
+			[]string{"", "Sample.periodic", "Periodic"},
+			ErrInvalidReadingContext,
+			func(v string) (string, error) { r, err := ParseReadingContext(v); return string(r), err },
+		},
+		{
+			"ValueFormat",
+			[]string{"Raw", "SignedData"},
+			[]string{"", "raw", "Signed"},
+			ErrInvalidValueFormat,
+			func(v string) (string, error) { r, err := ParseValueFormat(v); return string(r), err },
+		},
+		{
+			"Measurand",
+			[]string{
+				"Current.Export", "Current.Import", "Current.Offered",
+				"Energy.Active.Export.Register", "Energy.Active.Import.Register",
+				"Energy.Reactive.Export.Register", "Energy.Reactive.Import.Register",
+				"Energy.Active.Export.Interval", "Energy.Active.Import.Interval",
+				"Energy.Reactive.Export.Interval", "Energy.Reactive.Import.Interval",
+				"Frequency", "Power.Active.Export", "Power.Active.Import", "Power.Factor", "Power.Offered",
+				"Power.Reactive.Export", "Power.Reactive.Import", "RPM", "SoC", "Temperature", "Voltage",
+			},
+			[]string{"", "Energy.Active.Import.Registers", "energy.active.import.register", "Energy.Import.Register"},
+			ErrInvalidMeasurand,
+			func(v string) (string, error) { r, err := ParseMeasurand(v); return string(r), err },
+		},
+		{
+			"Phase",
+			[]string{"L1", "L2", "L3", "N", "L1-N", "L2-N", "L3-N", "L1-L2", "L2-L3", "L3-L1"},
+			[]string{"", "L4", "L1-L3", "l1"},
+			ErrInvalidPhase,
+			func(v string) (string, error) { r, err := ParsePhase(v); return string(r), err },
+		},
+		{
+			"Location",
+			[]string{"Body", "Cable", "EV", "Inlet", "Outlet"},
+			[]string{"", "outlet", "Grid"},
+			ErrInvalidLocation,
+			func(v string) (string, error) { r, err := ParseLocation(v); return string(r), err },
+		},
+		{
+			"UnitOfMeasure",
+			[]string{
+				"Wh", "kWh", "varh", "kvarh", "W", "kW", "VA", "kVA", "var", "kvar", "A", "V", "K",
+				"Celcius", "Celsius", "Fahrenheit", "Percent",
+			},
+			[]string{"", "KWH", "kwh", "%", "C"},
+			ErrInvalidUnitOfMeasure,
+			func(v string) (string, error) { r, err := ParseUnitOfMeasure(v); return string(r), err },
+		},
+		{
+			"ChargingProfilePurposeType",
+			[]string{"ChargePointMaxProfile", "TxDefaultProfile", "TxProfile"},
+			[]string{"", "txProfile", "ChargePointProfile"},
+			ErrInvalidChargingProfilePurposeType,
+			func(v string) (string, error) { r, err := ParseChargingProfilePurposeType(v); return string(r), err },
+		},
+		{
+			"ChargingProfileKindType",
+			[]string{"Absolute", "Recurring", "Relative"},
+			[]string{"", "absolute", "Once"},
+			ErrInvalidChargingProfileKindType,
+			func(v string) (string, error) { r, err := ParseChargingProfileKindType(v); return string(r), err },
+		},
+		{
+			"RecurrencyKindType",
+			[]string{"Daily", "Weekly"},
+			[]string{"", "Monthly", "daily"},
+			ErrInvalidRecurrencyKindType,
+			func(v string) (string, error) { r, err := ParseRecurrencyKindType(v); return string(r), err },
+		},
+		{
+			"ChargingRateUnitType",
+			[]string{"A", "W"},
+			[]string{"", "a", "kW"},
+			ErrInvalidChargingRateUnitType,
+			func(v string) (string, error) { r, err := ParseChargingRateUnitType(v); return string(r), err },
+		},
+		{
+			"RemoteStartStopStatus",
+			[]string{"Accepted", "Rejected"},
+			[]string{"", "accepted", "Pending"},
+			ErrInvalidRemoteStartStopStatus,
+			func(v string) (string, error) { r, err := ParseRemoteStartStopStatus(v); return string(r), err },
+		},
+	}
+}
+
+func TestMeterValueEnumsParse(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range enumCases() {
+		for _, value := range tc.values {
+			if parsed, err := tc.parse(value); err != nil || parsed != value {
+				t.Errorf("%s: unexpected result parsing %q: %q, %v", tc.name, value, parsed, err)
+			}
+		}
+
+		for _, value := range tc.invalid {
+			_, err := tc.parse(value)
+
+			var verr *ValidationError
+			if !errors.Is(err, tc.sentinel) || !errors.As(err, &verr) || verr.Constraint != ConstraintEnum {
+				t.Errorf("%s: expected enum error for %q, got %v", tc.name, value, err)
+			}
+		}
+	}
+}
+
+func TestMeasurandHasAllValues(t *testing.T) {
+	t.Parallel()
+
+	if got := len(enumCases()[2].values); got != 22 {
+		t.Errorf("expected 22 measurands, got %d", got)
+	}
+}
+
+func TestMeterValueEnumsIsValid(t *testing.T) {
+	t.Parallel()
+
+	valid := []interface{ IsValid() bool }{
+		ReadingContextTransactionEnd, ValueFormatSignedData, MeasurandEnergyActiveImportRegister,
+		PhaseL1N, LocationOutlet, UnitOfMeasureKWh,
+	}
+	for _, value := range valid {
+		if !value.IsValid() {
+			t.Errorf(errExpectedValid, value)
+		}
+	}
+
+	invalid := []interface{ IsValid() bool }{
+		ReadingContext("End"), ValueFormat(""), Measurand("Energy"), Phase("L0"), Location("Car"), UnitOfMeasure("J"),
+	}
+	for _, value := range invalid {
+		if value.IsValid() {
+			t.Errorf(errExpectedInvalid, value)
+		}
+	}
+}
+
+func TestMeterValueEnumsJSON(t *testing.T) {
+	t.Parallel()
+
+	var measurand Measurand
+	if err := json.Unmarshal([]byte(`"SoC"`), &measurand); err != nil || measurand != MeasurandSoC {
+		t.Errorf("unexpected measurand: %s, %v", measurand, err)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &measurand); err != nil || measurand != MeasurandSoC {
+		t.Errorf("expected null to leave the measurand unchanged, got %s, %v", measurand, err)
+	}
+
+	if err := json.Unmarshal([]byte(`"Energy.Active.Import"`), &measurand); !errors.Is(err, ErrInvalidMeasurand) {
+		t.Errorf("expected ErrInvalidMeasurand, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`42`), &measurand); err == nil {
+		t.Error("expected error for non-string measurand, got nil")
+	}
+
+	out, err := json.Marshal(PhaseL1L2)
+	if err != nil || string(out) != `"L1-L2"` {
+		t.Errorf("unexpected JSON output: %s, %v", out, err)
+	}
+
+	if _, err := json.Marshal(UnitOfMeasure("J")); !errors.Is(err, ErrInvalidUnitOfMeasure) {
+		t.Errorf("expected ErrInvalidUnitOfMeasure, got %v", err)
+	}
+}
+
+func TestMeterValueEnumsText(t *testing.T) {
+	t.Parallel()
+
+	var unit UnitOfMeasure
+	if err := unit.UnmarshalText([]byte("kvarh")); err != nil || unit != UnitOfMeasureKvarh {
+		t.Errorf("unexpected unit: %s, %v", unit, err)
+	}
+
+	if err := unit.UnmarshalText([]byte("kVarh")); !errors.Is(err, ErrInvalidUnitOfMeasure) {
+		t.Errorf("expected ErrInvalidUnitOfMeasure, got %v", err)
+	}
+
+	text, err := LocationEV.MarshalText()
+	if err != nil || string(text) != "EV" {
+		t.Errorf("unexpected MarshalText result: %s, %v", text, err)
+	}
+
+	if LocationEV.String() != "EV" || ReadingContextTrigger.String() != "Trigger" {
+		t.Error("unexpected String() output")
+	}
+}
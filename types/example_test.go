@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -214,3 +216,38 @@ func ExampleIdTokenType() {
 	// Output:
 	// Valid IdToken: ABC1234567890XYZ7890
 }
+
+func ExampleCiString20Type_UnmarshalJSON() {
+	var config struct {
+		Vendor types.CiString20Type `json:"vendor"`
+	}
+
+	err := json.Unmarshal([]byte(`{"vendor":"ThisVendorNameIsFarTooLong"}`), &config)
+	fmt.Println(errors.Is(err, types.ErrExceedsMaxLength))
+	// Output:
+	// true
+}
+
+func ExampleValidationError() {
+	var info types.IdTagInfoType
+
+	err := json.Unmarshal([]byte(`{"status":"Accepted","parentIdTag":"ThisParentIsTooLong123"}`), &info)
+
+	var verr *types.ValidationError
+	if errors.As(err, &verr) {
+		fmt.Println(verr.Path, verr.Constraint, verr.Value)
+	}
+	// Output:
+	// parentIdTag maxLength=20 ThisParentIsTooLong123
+}
+
+func ExampleParseDateTime() {
+	ts, err := types.ParseDateTime("2025-01-02T05:04:05+02:00")
+	if err != nil {
+		log.Fatalf("invalid timestamp: %v", err)
+	}
+
+	fmt.Println(ts)
+	// Output:
+	// 2025-01-02T03:04:05.000Z
+}
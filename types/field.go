@@ -0,0 +1,207 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Validatable is implemented by every type and message of this module that can
+// report all of its validation failures at once.
+type Validatable interface {
+	ValidateAll() error
+}
+
+// ConstraintMinimum returns the constraint name for a numeric lower bound,
+// for example "minimum=0".
+func ConstraintMinimum(n int) string {
+	return "minimum=" + strconv.Itoa(n)
+}
+
+// ConstraintMaximum returns the constraint name for a numeric upper bound,
+// for example "maximum=3".
+func ConstraintMaximum(n int) string {
+	return "maximum=" + strconv.Itoa(n)
+}
+
+// ConstraintMinItems returns the constraint name for the minimum number of elements
+// of an array, for example "minItems=1".
+func ConstraintMinItems(n int) string {
+	return "minItems=" + strconv.Itoa(n)
+}
+
+// Field validates a required value and reports its failures at path.
+func Field(path string, value Validatable) error {
+	return WithPath(path, value.ValidateAll())
+}
+
+// OptionalField validates an optional value and reports its failures at path.
+// A nil value is valid.
+func OptionalField[T Validatable](path string, value *T) error {
+	if value == nil {
+		return nil
+	}
+
+	return Field(path, *value)
+}
+
+// Required converts the raw wire value of a required field with parse.
+//
+// It returns MissingFieldError(path) when raw is nil, and reports parse failures at
+// path. It is used by UnmarshalJSON implementations, for example:
+//
+//	vendor, err := types.Required("chargePointVendor", payload.ChargePointVendor, types.CiString20)
+func Required[T any](path string, raw *string, parse func(string) (T, error)) (T, error) {
+	if raw == nil {
+		var zero T
+
+		return zero, MissingFieldError(path)
+	}
+
+	value, err := parse(*raw)
+	if err != nil {
+		var zero T
+
+		return zero, WithPath(path, err)
+	}
+
+	return value, nil
+}
+
+// RequiredValue returns the decoded wire value of a required field that needs no
+// further conversion, such as an integer. It returns MissingFieldError(path) when
+// raw is nil.
+func RequiredValue[T any](path string, raw *T) (T, error) {
+	if raw == nil {
+		var zero T
+
+		return zero, MissingFieldError(path)
+	}
+
+	return *raw, nil
+}
+
+// Optional converts the raw wire value of an optional field with parse.
+//
+// A nil raw value yields a nil result; parse failures are reported at path.
+func Optional[T any](path string, raw *string, parse func(string) (T, error)) (*T, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	value, err := Required(path, raw, parse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+// ElementPath returns the path of the element at index i of the array at path,
+// for example "meterValue[0]".
+func ElementPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// ListField validates every element of a list and reports their failures with the
+// element index in the path, for example "meterValue[0].timestamp".
+func ListField[T Validatable](path string, values []T) error {
+	errs := make([]error, 0, len(values))
+
+	for i, value := range values {
+		errs = append(errs, Field(ElementPath(path, i), value))
+	}
+
+	return Join(errs...)
+}
+
+// FormatList returns the string form of a list, for example "[A, B]", as used by
+// the String methods of messages.
+func FormatList[T fmt.Stringer](values []T) string {
+	elements := make([]string, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, value.String())
+	}
+
+	return "[" + strings.Join(elements, ", ") + "]"
+}
+
+// OptionalString returns the string form of an optional value, or nil if the value
+// is not set. It is used to build wire payloads with omitted optional fields.
+func OptionalString[T fmt.Stringer](value *T) *string {
+	if value == nil {
+		return nil
+	}
+
+	str := (*value).String()
+
+	return &str
+}
+
+// EnumError returns the ValidationError reported when value at path is not one of
+// the allowed enumeration values. The error wraps sentinel.
+func EnumError(path string, value any, sentinel error) error {
+	return &ValidationError{
+		Path:       path,
+		Constraint: ConstraintEnum,
+		Value:      value,
+		Err:        fmt.Errorf("%w: %v", sentinel, value),
+	}
+}
+
+// MinimumError returns the ValidationError reported when value at path is lower
+// than minimum. The error wraps sentinel.
+func MinimumError(path string, value, minimum int, sentinel error) error {
+	return &ValidationError{
+		Path:       path,
+		Constraint: ConstraintMinimum(minimum),
+		Value:      value,
+		Err:        fmt.Errorf("%w: %d is lower than %d", sentinel, value, minimum),
+	}
+}
+
+// MaximumError returns the ValidationError reported when value at path is greater
+// than maximum. The error wraps sentinel.
+func MaximumError(path string, value, maximum int, sentinel error) error {
+	return &ValidationError{
+		Path:       path,
+		Constraint: ConstraintMaximum(maximum),
+		Value:      value,
+		Err:        fmt.Errorf("%w: %d is greater than %d", sentinel, value, maximum),
+	}
+}
+
+// MinItemsError returns the ValidationError reported when the array at path has
+// fewer than minimum elements. The error wraps sentinel.
+func MinItemsError(path string, count, minimum int, sentinel error) error {
+	return &ValidationError{
+		Path:       path,
+		Constraint: ConstraintMinItems(minimum),
+		Value:      count,
+		Err:        fmt.Errorf("%w: %d elements, at least %d required", sentinel, count, minimum),
+	}
+}
+
+// Join returns an error wrapping every non-nil error in errs, like errors.Join, but
+// flattens nested joined errors so that each failure is a direct child of the result.
+// It returns nil if all errors are nil.
+func Join(errs ...error) error {
+	var flat []error
+
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if nested := splitJoined(err); nested != nil {
+			flat = append(flat, splitJoined(Join(nested...))...)
+
+			continue
+		}
+
+		flat = append(flat, err)
+	}
+
+	return errors.Join(flat...)
+}
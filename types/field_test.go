@@ -0,0 +1,170 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRequiredAndOptional(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Required("vendor", nil, CiString20); !errors.Is(err, ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	empty := ""
+
+	_, err := Required("vendor", &empty, CiString20)
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Path != "vendor" || verr.Constraint != ConstraintNotEmpty {
+		t.Errorf("expected notEmpty failure at vendor, got %v", err)
+	}
+
+	if value, err := Optional("serial", nil, CiString25); value != nil || err != nil {
+		t.Errorf("expected nil result for unset optional field, got %v, %v", value, err)
+	}
+
+	serial := "SN-1"
+	if value, err := Optional("serial", &serial, CiString25); err != nil || value.String() != serial {
+		t.Errorf("unexpected optional result: %v, %v", value, err)
+	}
+
+	if _, err := Optional("serial", &empty, CiString25); !errors.Is(err, ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
+
+func TestFieldAndOptionalField(t *testing.T) {
+	t.Parallel()
+
+	var verr *ValidationError
+	if err := Field("model", CiString20Type{}); !errors.As(err, &verr) || verr.Path != "model" {
+		t.Errorf("expected failure at model, got %v", err)
+	}
+
+	if err := OptionalField[CiString20Type]("imsi", nil); err != nil {
+		t.Errorf("expected nil optional field to be valid, got %v", err)
+	}
+
+	if err := OptionalField("imsi", &CiString20Type{}); !errors.As(err, &verr) || verr.Path != "imsi" {
+		t.Errorf("expected failure at imsi, got %v", err)
+	}
+}
+
+func TestOptionalString(t *testing.T) {
+	t.Parallel()
+
+	if OptionalString[CiString20Type](nil) != nil {
+		t.Error("expected nil for unset value")
+	}
+
+	cs, _ := CiString20("ABC")
+	if got := OptionalString(&cs); got == nil || *got != "ABC" {
+		t.Errorf("unexpected OptionalString result: %v", got)
+	}
+}
+
+func TestEnumAndMinimumError(t *testing.T) {
+	t.Parallel()
+
+	errSentinel := errors.New("sentinel")
+
+	var verr *ValidationError
+	if err := EnumError("status", "Nope", errSentinel); !errors.As(err, &verr) ||
+		verr.Constraint != ConstraintEnum || !errors.Is(err, errSentinel) {
+		t.Errorf("unexpected enum error: %v", err)
+	}
+
+	err := MinimumError("interval", -1, 0, errSentinel)
+	if !errors.As(err, &verr) || verr.Constraint != "minimum=0" || verr.Value != -1 || !errors.Is(err, errSentinel) {
+		t.Errorf("unexpected minimum error: %v", err)
+	}
+
+	if err.Error() != "interval: sentinel: -1 is lower than 0" {
+		t.Errorf("unexpected error message: %s", err.Error())
+	}
+}
+
+func TestMinItemsError(t *testing.T) {
+	t.Parallel()
+
+	errSentinel := errors.New("sentinel")
+
+	err := MinItemsError("sampledValue", 0, 1, errSentinel)
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Constraint != "minItems=1" || verr.Value != 0 || !errors.Is(err, errSentinel) {
+		t.Errorf("unexpected minItems error: %v", err)
+	}
+
+	if err.Error() != "sampledValue: sentinel: 0 elements, at least 1 required" {
+		t.Errorf("unexpected error message: %s", err.Error())
+	}
+}
+
+func TestMaximumError(t *testing.T) {
+	t.Parallel()
+
+	errSentinel := errors.New("sentinel")
+
+	err := MaximumError("numberPhases", 4, 3, errSentinel)
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Constraint != "maximum=3" || verr.Value != 4 || !errors.Is(err, errSentinel) {
+		t.Errorf("unexpected maximum error: %v", err)
+	}
+
+	if err.Error() != "numberPhases: sentinel: 4 is greater than 3" {
+		t.Errorf("unexpected error message: %s", err.Error())
+	}
+}
+
+func TestJoinFlattens(t *testing.T) {
+	t.Parallel()
+
+	first := errors.New("first")
+	second := errors.New("second")
+	third := errors.New("third")
+
+	errs := splitJoined(Join(nil, errors.Join(first, errors.Join(second)), nil, third))
+	if len(errs) != 3 || errs[0] != first || errs[1] != second || errs[2] != third {
+		t.Errorf("unexpected flattened errors: %v", errs)
+	}
+
+	if Join(nil, errors.Join(nil)) != nil {
+		t.Error("expected nil when all errors are nil")
+	}
+}
+
+func TestRequiredValue(t *testing.T) {
+	t.Parallel()
+
+	if _, err := RequiredValue[int]("connectorId", nil); !errors.Is(err, ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	connectorID := 2
+	if value, err := RequiredValue("connectorId", &connectorID); value != 2 || err != nil {
+		t.Errorf("unexpected result: %d, %v", value, err)
+	}
+}
+
+func TestListField(t *testing.T) {
+	t.Parallel()
+
+	valid, _ := CiString20("A")
+
+	if err := ListField("key", []CiString20Type{valid, valid}); err != nil {
+		t.Errorf("expected valid list, got %v", err)
+	}
+
+	var verr *ValidationError
+	if err := ListField("key", []CiString20Type{valid, {}}); !errors.As(err, &verr) || verr.Path != "key[1]" {
+		t.Errorf("expected failure at key[1], got %v", err)
+	}
+
+	if FormatList[CiString20Type](nil) != "[]" {
+		t.Errorf("unexpected empty list format: %s", FormatList[CiString20Type](nil))
+	}
+}
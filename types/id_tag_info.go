@@ -1,9 +1,10 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"time"
 )
 
 // Static error definitions for validation.
@@ -19,7 +20,7 @@ var (
 // This type aligns with the `idTagInfo` structure defined in the OCPP 1.6J specification, Section 5.2.
 type IdTagInfoType struct {
 	Status      AuthorizationStatus
-	ExpiryDate  *time.Time
+	ExpiryDate  *DateTimeType
 	ParentIdTag *IdTokenType
 }
 
@@ -36,23 +37,107 @@ func IdTagInfo(status AuthorizationStatus) (IdTagInfoType, error) {
 	}, nil
 }
 
+// Clone returns a copy of the IdTagInfoType that shares no pointers with it, so
+// changing the ExpiryDate or ParentIdTag of either leaves the other unchanged.
+func (info IdTagInfoType) Clone() IdTagInfoType {
+	clone := info
+
+	if info.ExpiryDate != nil {
+		expiryDate := *info.ExpiryDate
+		clone.ExpiryDate = &expiryDate
+	}
+
+	if info.ParentIdTag != nil {
+		parentIdTag := *info.ParentIdTag
+		clone.ParentIdTag = &parentIdTag
+	}
+
+	return clone
+}
+
 // Validate checks the internal consistency of the IdTagInfoType struct.
+//
+// The returned error is a *ValidationError carrying the path of the first offending
+// field ("status", "expiryDate" or "parentIdTag") and still matches the package
+// sentinels with errors.Is.
 func (info IdTagInfoType) Validate() error {
+	return FirstError(info.ValidateAll())
+}
+
+// ValidateAll checks every field of the IdTagInfoType and returns all failures
+// joined with errors.Join, each as a *ValidationError with its field path.
+func (info IdTagInfoType) ValidateAll() error {
+	var errs []error
+
 	if !info.Status.IsValid() {
-		return fmt.Errorf("%w: %s", ErrInvalidAuthorizationStatus, info.Status)
+		errs = append(errs, &ValidationError{
+			Path:       "status",
+			Constraint: ConstraintEnum,
+			Value:      info.Status,
+			Err:        fmt.Errorf("%w: %s", ErrInvalidAuthorizationStatus, info.Status),
+		})
 	}
 
-	if info.ExpiryDate != nil && info.ExpiryDate.IsZero() {
-		return ErrInvalidExpiryDate
+	if info.ExpiryDate != nil {
+		if err := info.ExpiryDate.Validate(); err != nil {
+			errs = append(errs, expiryDateError(err))
+		}
 	}
 
 	if info.ParentIdTag != nil {
-		if err := info.ParentIdTag.Validate(); err != nil {
-			return fmt.Errorf("%w: %w", ErrInvalidParentIdTag, err)
+		if err := info.ParentIdTag.ValidateAll(); err != nil {
+			errs = append(errs, parentIdTagErrors(err)...)
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
+}
+
+// expiryDateError reports an invalid expiryDate at its field path, wrapping
+// ErrInvalidExpiryDate around the underlying dateTime validation error.
+func expiryDateError(err error) error {
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		verr = &ValidationError{Path: "", Constraint: ConstraintDateTime, Value: nil, Err: err}
+	}
+
+	return &ValidationError{
+		Path:       "expiryDate",
+		Constraint: ConstraintDateTime,
+		Value:      verr.Value,
+		Err:        fmt.Errorf("%w: %w", ErrInvalidExpiryDate, verr.Err),
+	}
+}
+
+// parentIdTagErrors applies parentIdTagError to every failure in err.
+func parentIdTagErrors(err error) []error {
+	joined := splitJoined(err)
+	if joined == nil {
+		return []error{parentIdTagError(err)}
+	}
+
+	errs := make([]error, 0, len(joined))
+	for _, e := range joined {
+		errs = append(errs, parentIdTagError(e))
+	}
+
+	return errs
+}
+
+// parentIdTagError reports an invalid parentIdTag at its field path, wrapping
+// ErrInvalidParentIdTag around the underlying IdToken validation error.
+func parentIdTagError(err error) error {
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		verr = &ValidationError{Path: "", Constraint: "", Value: nil, Err: err}
+	}
+
+	return &ValidationError{
+		Path:       "parentIdTag",
+		Constraint: verr.Constraint,
+		Value:      verr.Value,
+		Err:        fmt.Errorf("%w: %w", ErrInvalidParentIdTag, verr.Err),
+	}
 }
 
 // String returns a human-readable representation of the IdTagInfoType.
@@ -60,7 +145,7 @@ func (info IdTagInfoType) String() string {
 	str := "{status=" + string(info.Status)
 
 	if info.ExpiryDate != nil {
-		str += ", expiryDate=" + info.ExpiryDate.Format(time.RFC3339)
+		str += ", expiryDate=" + info.ExpiryDate.String()
 	}
 
 	if info.ParentIdTag != nil {
@@ -71,3 +156,100 @@ func (info IdTagInfoType) String() string {
 
 	return str
 }
+
+// idTagInfoPayload is the OCPP 1.6J wire representation of IdTagInfoType.
+//
+// Fields are decoded as plain values so that validation errors are reported
+// with the same wrapping as Validate.
+type idTagInfoPayload struct {
+	Status      *string `json:"status"`
+	ExpiryDate  *string `json:"expiryDate,omitempty"`
+	ParentIdTag *string `json:"parentIdTag,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, producing the `idTagInfo` object with
+// the OCPP 1.6J field names. Unset optional fields are omitted. The value is
+// validated first, so an invalid IdTagInfoType is never encoded.
+func (info IdTagInfoType) MarshalJSON() ([]byte, error) {
+	if err := info.Validate(); err != nil {
+		return nil, err
+	}
+
+	status := string(info.Status)
+	payload := idTagInfoPayload{
+		Status:      &status,
+		ExpiryDate:  nil,
+		ParentIdTag: nil,
+	}
+
+	if info.ExpiryDate != nil {
+		expiry := info.ExpiryDate.String()
+		payload.ExpiryDate = &expiry
+	}
+
+	if info.ParentIdTag != nil {
+		parent := info.ParentIdTag.String()
+		payload.ParentIdTag = &parent
+	}
+
+	return json.Marshal(payload)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding an `idTagInfo` object and
+// validating it with the same rules as Validate. A JSON null leaves the value unchanged.
+func (info *IdTagInfoType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return nil
+	}
+
+	var payload idTagInfoPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	if payload.Status == nil {
+		return MissingFieldError("status")
+	}
+
+	decoded := IdTagInfoType{
+		Status:      AuthorizationStatus(*payload.Status),
+		ExpiryDate:  nil,
+		ParentIdTag: nil,
+	}
+
+	if payload.ExpiryDate != nil {
+		expiry, err := ParseDateTime(*payload.ExpiryDate)
+		if err != nil {
+			return expiryDateError(err)
+		}
+
+		decoded.ExpiryDate = &expiry
+	}
+
+	if payload.ParentIdTag != nil {
+		parent, err := IdToken(*payload.ParentIdTag)
+		if err != nil {
+			return parentIdTagError(err)
+		}
+
+		decoded.ParentIdTag = &parent
+	}
+
+	if err := decoded.Validate(); err != nil {
+		return err
+	}
+
+	*info = decoded
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler using the JSON object form.
+func (info IdTagInfoType) MarshalText() ([]byte, error) {
+	return info.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the JSON object form.
+func (info *IdTagInfoType) UnmarshalText(text []byte) error {
+	return info.UnmarshalJSON(text)
+}
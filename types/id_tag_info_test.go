@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -24,7 +26,10 @@ func TestIdTagInfoValidMinimal(t *testing.T) {
 func TestIdTagInfoWithExpiryDate(t *testing.T) {
 	t.Parallel()
 
-	expiry := time.Now().Add(24 * time.Hour).UTC()
+	expiry, err := DateTime(time.Now().Add(24 * time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error creating expiryDate: %v", err)
+	}
 
 	info, err := IdTagInfo(Accepted)
 	if err != nil {
@@ -38,6 +43,31 @@ func TestIdTagInfoWithExpiryDate(t *testing.T) {
 	}
 }
 
+func TestIdTagInfoClone(t *testing.T) {
+	t.Parallel()
+
+	expiry, _ := DateTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	parent, _ := IdToken("PARENT")
+	info := IdTagInfoType{Status: Accepted, ExpiryDate: &expiry, ParentIdTag: &parent}
+
+	clone := info.Clone()
+	if clone.String() != info.String() || clone.ExpiryDate == info.ExpiryDate || clone.ParentIdTag == info.ParentIdTag {
+		t.Fatalf("expected an equal clone without shared pointers, got %s", clone)
+	}
+
+	*info.ExpiryDate, _ = DateTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	*info.ParentIdTag, _ = IdToken("OTHER")
+
+	if !clone.ExpiryDate.Time().Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)) || clone.ParentIdTag.String() != "PARENT" {
+		t.Errorf("expected the clone to be unchanged, got %s", clone)
+	}
+
+	if minimal := (IdTagInfoType{Status: Blocked, ExpiryDate: nil, ParentIdTag: nil}).Clone(); minimal.ExpiryDate != nil ||
+		minimal.ParentIdTag != nil || minimal.Status != Blocked {
+		t.Errorf("unexpected clone of a minimal IdTagInfo: %s", minimal)
+	}
+}
+
 func TestIdTagInfoWithInvalidStatus(t *testing.T) {
 	t.Parallel()
 
@@ -56,7 +86,7 @@ func TestIdTagInfoWithInvalidStatus(t *testing.T) {
 func TestIdTagInfoWithEmptyExpiryDate(t *testing.T) {
 	t.Parallel()
 
-	var zeroTime time.Time
+	var zeroTime DateTimeType
 
 	info, err := IdTagInfo(Accepted)
 	if err != nil {
@@ -125,7 +155,7 @@ func TestIdTagInfoInvalidStatus(t *testing.T) {
 func TestIdTagInfoStringMethod(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now().UTC()
+	now, _ := DateTime(time.Now())
 	parent, _ := IdToken("GROUPID123")
 
 	info := IdTagInfoType{
@@ -137,7 +167,7 @@ func TestIdTagInfoStringMethod(t *testing.T) {
 	expected := fmt.Sprintf(
 		"{status=%s, expiryDate=%s, parentIdTag=%s}",
 		info.Status,
-		now.Format(time.RFC3339),
+		now.String(),
 		parent.String(),
 	)
 
@@ -145,3 +175,167 @@ func TestIdTagInfoStringMethod(t *testing.T) {
 		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", expected, info.String())
 	}
 }
+
+func TestIdTagInfoMarshalJSONStatusOnly(t *testing.T) {
+	t.Parallel()
+
+	info, _ := IdTagInfo(Accepted)
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling IdTagInfo: %v", err)
+	}
+
+	if string(data) != `{"status":"Accepted"}` {
+		t.Errorf("unexpected JSON output: %s", data)
+	}
+}
+
+func TestIdTagInfoJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"status":"Expired","expiryDate":"2025-04-01T10:00:00.000Z","parentIdTag":"GROUP1"}`
+
+	var info IdTagInfoType
+	if err := json.Unmarshal([]byte(data), &info); err != nil {
+		t.Fatalf("unexpected error unmarshaling IdTagInfo: %v", err)
+	}
+
+	out, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling IdTagInfo: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestIdTagInfoUnmarshalJSONInvalidStatus(t *testing.T) {
+	t.Parallel()
+
+	var info IdTagInfoType
+	if err := json.Unmarshal([]byte(`{"status":"Nope"}`), &info); !errors.Is(err, ErrInvalidAuthorizationStatus) {
+		t.Errorf("expected ErrInvalidAuthorizationStatus, got %v", err)
+	}
+}
+
+func TestIdTagInfoUnmarshalJSONInvalidParentIdTag(t *testing.T) {
+	t.Parallel()
+
+	var info IdTagInfoType
+
+	err := json.Unmarshal([]byte(`{"status":"Accepted","parentIdTag":"ABC1234567890123456789"}`), &info)
+	if !errors.Is(err, ErrInvalidParentIdTag) || !errors.Is(err, ErrExceedsMaxLength) {
+		t.Errorf("expected ErrInvalidParentIdTag wrapping ErrExceedsMaxLength, got %v", err)
+	}
+}
+
+func TestIdTagInfoUnmarshalJSONMalformed(t *testing.T) {
+	t.Parallel()
+
+	var info IdTagInfoType
+	if err := json.Unmarshal([]byte(`{"status":true}`), &info); err == nil {
+		t.Error("expected error for malformed idTagInfo, got nil")
+	}
+}
+
+func TestIdTagInfoUnmarshalJSONNullIsNoOp(t *testing.T) {
+	t.Parallel()
+
+	info, _ := IdTagInfo(Blocked)
+	if err := json.Unmarshal([]byte(`null`), &info); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info.Status != Blocked {
+		t.Errorf("expected status to remain %s, got %s", Blocked, info.Status)
+	}
+}
+
+func TestIdTagInfoMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := json.Marshal(IdTagInfoType{Status: "", ExpiryDate: nil, ParentIdTag: nil}); err == nil {
+		t.Error("expected error marshaling invalid IdTagInfo, got nil")
+	}
+}
+
+func TestIdTagInfoText(t *testing.T) {
+	t.Parallel()
+
+	var info IdTagInfoType
+	if err := info.UnmarshalText([]byte(`{"status":"Invalid"}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text, err := info.MarshalText()
+	if err != nil || string(text) != `{"status":"Invalid"}` {
+		t.Errorf("unexpected MarshalText result: %s, %v", text, err)
+	}
+}
+
+func TestIdTagInfoValidateReportsFieldPath(t *testing.T) {
+	t.Parallel()
+
+	parent := IdTokenType{value: CiString20Type{inner: ciString{Value: "ABC1234567890123456789", MaxLen: maxLenCiString20}}}
+	expiry := DateTimeType{}
+
+	tests := []struct {
+		info       IdTagInfoType
+		path       string
+		constraint string
+		sentinel   error
+	}{
+		{IdTagInfoType{Status: "Nope", ExpiryDate: nil, ParentIdTag: nil}, "status", ConstraintEnum, ErrInvalidAuthorizationStatus},
+		{IdTagInfoType{Status: Accepted, ExpiryDate: &expiry, ParentIdTag: nil}, "expiryDate", ConstraintDateTime, ErrInvalidExpiryDate},
+		{IdTagInfoType{Status: Accepted, ExpiryDate: nil, ParentIdTag: &parent}, "parentIdTag", "maxLength=20", ErrExceedsMaxLength},
+	}
+
+	for _, tc := range tests {
+		err := tc.info.Validate()
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Fatalf("expected *ValidationError, got %v", err)
+		}
+
+		if verr.Path != tc.path || verr.Constraint != tc.constraint {
+			t.Errorf("expected %s/%s, got %s/%s", tc.path, tc.constraint, verr.Path, verr.Constraint)
+		}
+
+		if !errors.Is(err, tc.sentinel) {
+			t.Errorf("expected error to match %v, got %v", tc.sentinel, err)
+		}
+	}
+}
+
+func TestIdTagInfoUnmarshalJSONExpiryDateFormats(t *testing.T) {
+	t.Parallel()
+
+	var info IdTagInfoType
+	if err := json.Unmarshal([]byte(`{"status":"Accepted","expiryDate":"2025-04-01T12:00:00+02:00"}`), &info); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out, _ := json.Marshal(info)
+	if string(out) != `{"status":"Accepted","expiryDate":"2025-04-01T10:00:00.000Z"}` {
+		t.Errorf("unexpected JSON output: %s", out)
+	}
+}
+
+func TestIdTagInfoUnmarshalJSONInvalidExpiryDate(t *testing.T) {
+	t.Parallel()
+
+	var info IdTagInfoType
+
+	err := json.Unmarshal([]byte(`{"status":"Accepted","expiryDate":"tomorrow"}`), &info)
+	if !errors.Is(err, ErrInvalidExpiryDate) || !errors.Is(err, ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidExpiryDate wrapping ErrInvalidDateTime, got %v", err)
+	}
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Path != "expiryDate" || verr.Value != "tomorrow" {
+		t.Errorf("unexpected ValidationError: %+v", verr)
+	}
+}
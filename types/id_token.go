@@ -63,3 +63,38 @@ func (id IdTokenType) String() string {
 func (id IdTokenType) Validate() error {
 	return id.value.Validate()
 }
+
+// ValidateAll reports every violated IdToken rule, joined with errors.Join.
+func (id IdTokenType) ValidateAll() error {
+	return id.value.ValidateAll()
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails if the token is not valid.
+func (id IdTokenType) MarshalText() ([]byte, error) {
+	return id.value.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the input with
+// the same rules as IdToken.
+func (id *IdTokenType) UnmarshalText(text []byte) error {
+	tok, err := IdToken(string(text))
+	if err != nil {
+		return err
+	}
+
+	*id = tok
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the token as a JSON string.
+// It fails if the token is not valid.
+func (id IdTokenType) MarshalJSON() ([]byte, error) {
+	return id.value.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the input with the same
+// rules as IdToken. A JSON null leaves the token unchanged.
+func (id *IdTokenType) UnmarshalJSON(data []byte) error {
+	return id.value.UnmarshalJSON(data)
+}
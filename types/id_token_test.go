@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -52,3 +54,68 @@ func TestIdTokenFromStringEmpty(t *testing.T) {
 		t.Errorf("expected error for empty input, got nil")
 	}
 }
+
+func TestIdTokenJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	token, _ := IdToken("RFID0001")
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if string(data) != `"RFID0001"` {
+		t.Errorf("unexpected JSON output: %s", data)
+	}
+
+	var decoded IdTokenType
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if decoded.String() != "RFID0001" {
+		t.Errorf("expected %s, got %s", "RFID0001", decoded.String())
+	}
+}
+
+func TestIdTokenUnmarshalJSONTooLong(t *testing.T) {
+	t.Parallel()
+
+	var token IdTokenType
+	if err := json.Unmarshal([]byte(`"ABC1234567890123456789"`), &token); !errors.Is(err, ErrExceedsMaxLength) {
+		t.Errorf("expected ErrExceedsMaxLength, got %v", err)
+	}
+}
+
+func TestIdTokenMarshalJSONZeroValue(t *testing.T) {
+	t.Parallel()
+
+	if _, err := json.Marshal(IdTokenType{}); err == nil {
+		t.Error("expected error marshaling zero-value IdTokenType, got nil")
+	}
+}
+
+func TestIdTokenTextRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	token, _ := IdToken("RFID0002")
+
+	text, err := token.MarshalText()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var decoded IdTokenType
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if decoded.String() != "RFID0002" {
+		t.Errorf("expected %s, got %s", "RFID0002", decoded.String())
+	}
+
+	if err := decoded.UnmarshalText([]byte("你好")); !errors.Is(err, ErrNonPrintableASCII) {
+		t.Errorf("expected ErrNonPrintableASCII, got %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// KeyValueType is a configuration key of a Charge Point together with its value, as
+// reported in a GetConfiguration.conf.
+//
+// Value is nil when the key is known but has no value set.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.29: KeyValue
+type KeyValueType struct {
+	// Key is the name of the configuration key.
+	Key CiString50Type
+
+	// Readonly is true when the value cannot be changed with a
+	// ChangeConfiguration.req.
+	Readonly bool
+
+	// Value is the current value of the key.
+	Value *CiString500Type
+}
+
+// KeyValue constructs a new KeyValueType for the given configuration key.
+//
+// Value is nil and can be set on the returned value afterwards. It returns an error
+// if key is not a valid CiString50.
+func KeyValue(key string, readonly bool) (KeyValueType, error) {
+	ciKey, err := CiString50(key)
+	if err != nil {
+		return KeyValueType{}, WithPath("key", err)
+	}
+
+	return KeyValueType{Key: ciKey, Readonly: readonly, Value: nil}, nil
+}
+
+// Validate checks the KeyValueType and returns the first failure as a
+// *ValidationError with its field path.
+func (kv KeyValueType) Validate() error {
+	return FirstError(kv.ValidateAll())
+}
+
+// ValidateAll checks every field of the KeyValueType and returns all failures
+// joined with errors.Join, each as a *ValidationError with its field path.
+func (kv KeyValueType) ValidateAll() error {
+	return Join(Field("key", kv.Key), OptionalField("value", kv.Value))
+}
+
+// String returns a human-readable representation of the KeyValueType.
+func (kv KeyValueType) String() string {
+	str := "{key=" + kv.Key.String() + ", readonly=" + strconv.FormatBool(kv.Readonly)
+
+	if kv.Value != nil {
+		str += ", value=" + kv.Value.String()
+	}
+
+	return str + "}"
+}
+
+// keyValuePayload is the OCPP 1.6J wire representation of KeyValueType.
+type keyValuePayload struct {
+	Key      *string `json:"key"`
+	Readonly *bool   `json:"readonly"`
+	Value    *string `json:"value,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, producing a `configurationKey` object with
+// the OCPP 1.6J field names. An unset value is omitted. The value is validated
+// first, so an invalid KeyValueType is never encoded.
+func (kv KeyValueType) MarshalJSON() ([]byte, error) {
+	if err := kv.Validate(); err != nil {
+		return nil, err
+	}
+
+	key := kv.Key.String()
+
+	return json.Marshal(keyValuePayload{Key: &key, Readonly: &kv.Readonly, Value: OptionalString(kv.Value)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a `configurationKey` object
+// and reporting all of its failures together. A JSON null leaves the value
+// unchanged.
+func (kv *KeyValueType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return nil
+	}
+
+	var payload keyValuePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	key, keyErr := Required("key", payload.Key, CiString50)
+	readonly, readonlyErr := RequiredValue("readonly", payload.Readonly)
+	value, valueErr := Optional("value", payload.Value, CiString500)
+
+	if err := Join(keyErr, readonlyErr, valueErr); err != nil {
+		return err
+	}
+
+	*kv = KeyValueType{Key: key, Readonly: readonly, Value: value}
+
+	return nil
+}
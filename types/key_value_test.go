@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestKeyValueValid(t *testing.T) {
+	t.Parallel()
+
+	kv, err := KeyValue("HeartbeatInterval", false)
+	if err != nil {
+		t.Fatalf("unexpected error creating KeyValue: %v", err)
+	}
+
+	value, _ := CiString500("300")
+	kv.Value = &value
+
+	if err := kv.Validate(); err != nil {
+		t.Errorf("expected Validate() to succeed, got error: %v", err)
+	}
+
+	if kv.String() != "{key=HeartbeatInterval, readonly=false, value=300}" {
+		t.Errorf("unexpected String() output: %s", kv.String())
+	}
+}
+
+func TestKeyValueInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := KeyValue("", true)
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Path != "key" {
+		t.Errorf("expected failure at key, got %v", err)
+	}
+
+	value := CiString500Type{}
+	kv := KeyValueType{Key: CiString50Type{}, Readonly: true, Value: &value}
+
+	paths := make([]string, 0, 2)
+
+	for _, e := range splitJoined(kv.ValidateAll()) {
+		if errors.As(e, &verr) {
+			paths = append(paths, verr.Path)
+		}
+	}
+
+	if len(paths) != 2 || paths[0] != "key" || paths[1] != "value" {
+		t.Errorf("expected failures at key and value, got %v", paths)
+	}
+}
+
+func TestKeyValueJSON(t *testing.T) {
+	t.Parallel()
+
+	data := `{"key":"NumberOfConnectors","readonly":true,"value":"2"}`
+
+	var kv KeyValueType
+	if err := json.Unmarshal([]byte(data), &kv); err != nil {
+		t.Fatalf("unexpected error unmarshaling KeyValue: %v", err)
+	}
+
+	out, err := json.Marshal(kv)
+	if err != nil || string(out) != data {
+		t.Errorf("unexpected JSON round trip: %s, %v", out, err)
+	}
+
+	err = json.Unmarshal([]byte(`{"value":"`+strings.Repeat("x", 501)+`"}`), &kv)
+	if !errors.Is(err, ErrMissingRequiredField) || !errors.Is(err, ErrExceedsMaxLength) {
+		t.Errorf("expected missing key and readonly and a too long value, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"key":"A","readonly":"yes"}`), &kv); err == nil {
+		t.Error("expected error for malformed KeyValue, got nil")
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &kv); err != nil {
+		t.Errorf("expected null to be a no-op, got %v", err)
+	}
+
+	if _, err := json.Marshal(KeyValueType{}); err == nil {
+		t.Error("expected error marshaling invalid KeyValue, got nil")
+	}
+}
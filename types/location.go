@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidLocation indicates that a Location is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidLocation = errors.New("invalid location")
+
+// Location is where a SampledValue was measured, reported in its `location` field.
+// When absent, Outlet is assumed.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.30: Location
+type Location string
+
+const (
+	// LocationBody is measured inside the body of the Charge Point, for example a
+	// temperature.
+	LocationBody Location = "Body"
+
+	// LocationCable is measured at the cable between the Charge Point and the EV.
+	LocationCable Location = "Cable"
+
+	// LocationEV is measured by the EV.
+	LocationEV Location = "EV"
+
+	// LocationInlet is measured at the network (grid) inlet connection.
+	LocationInlet Location = "Inlet"
+
+	// LocationOutlet is measured at a connector. This is the default location.
+	LocationOutlet Location = "Outlet"
+)
+
+// IsValid returns true if the Location is one of the values defined by OCPP 1.6J.
+func (l Location) IsValid() bool {
+	switch l {
+	case LocationBody, LocationCable, LocationEV, LocationInlet, LocationOutlet:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the Location.
+func (l Location) String() string {
+	return string(l)
+}
+
+// ParseLocation converts a wire value into a Location. Unknown values are rejected
+// with a *ValidationError wrapping ErrInvalidLocation.
+func ParseLocation(value string) (Location, error) {
+	return ParseEnum[Location](value, ErrInvalidLocation)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with ErrInvalidLocation
+// if the value is not recognized.
+func (l Location) MarshalText() ([]byte, error) {
+	return MarshalEnum(l, ErrInvalidLocation)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidLocation if the input is not recognized.
+func (l *Location) UnmarshalText(text []byte) error {
+	parsed, err := ParseLocation(string(text))
+	if err != nil {
+		return err
+	}
+
+	*l = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (l Location) MarshalJSON() ([]byte, error) {
+	text, err := l.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (l *Location) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, l, ParseLocation)
+}
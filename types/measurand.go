@@ -0,0 +1,166 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidMeasurand indicates that a Measurand is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidMeasurand = errors.New("invalid measurand")
+
+// Measurand is the quantity measured by a SampledValue, reported in its `measurand`
+// field. When absent, Energy.Active.Import.Register is assumed.
+//
+// "Import" is energy flowing from the grid to the EV, "Export" is energy flowing
+// from the EV to the grid. "Register" values are absolute meter readings, while
+// "Interval" values cover the period since the previous sample.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.31: Measurand
+type Measurand string
+
+const (
+	// MeasurandCurrentExport is the instantaneous current flow from the EV, in A.
+	MeasurandCurrentExport Measurand = "Current.Export"
+
+	// MeasurandCurrentImport is the instantaneous current flow to the EV, in A.
+	MeasurandCurrentImport Measurand = "Current.Import"
+
+	// MeasurandCurrentOffered is the maximum current offered to the EV, in A.
+	MeasurandCurrentOffered Measurand = "Current.Offered"
+
+	// MeasurandEnergyActiveExportRegister is the numerical value read from the active electrical
+	// energy meter for energy exported to the grid, in Wh or kWh.
+	MeasurandEnergyActiveExportRegister Measurand = "Energy.Active.Export.Register"
+
+	// MeasurandEnergyActiveImportRegister is the numerical value read from the active electrical
+	// energy meter for energy imported from the grid, in Wh or kWh. This is the
+	// default measurand.
+	MeasurandEnergyActiveImportRegister Measurand = "Energy.Active.Import.Register"
+
+	// MeasurandEnergyReactiveExportRegister is the numerical value read from the reactive
+	// electrical energy meter for energy exported to the grid, in varh or kvarh.
+	MeasurandEnergyReactiveExportRegister Measurand = "Energy.Reactive.Export.Register"
+
+	// MeasurandEnergyReactiveImportRegister is the numerical value read from the reactive
+	// electrical energy meter for energy imported from the grid, in varh or kvarh.
+	MeasurandEnergyReactiveImportRegister Measurand = "Energy.Reactive.Import.Register"
+
+	// MeasurandEnergyActiveExportInterval is the active energy exported to the grid during the
+	// last interval, in Wh or kWh.
+	MeasurandEnergyActiveExportInterval Measurand = "Energy.Active.Export.Interval"
+
+	// MeasurandEnergyActiveImportInterval is the active energy imported from the grid during the
+	// last interval, in Wh or kWh.
+	MeasurandEnergyActiveImportInterval Measurand = "Energy.Active.Import.Interval"
+
+	// MeasurandEnergyReactiveExportInterval is the reactive energy exported to the grid during
+	// the last interval, in varh or kvarh.
+	MeasurandEnergyReactiveExportInterval Measurand = "Energy.Reactive.Export.Interval"
+
+	// MeasurandEnergyReactiveImportInterval is the reactive energy imported from the grid during
+	// the last interval, in varh or kvarh.
+	MeasurandEnergyReactiveImportInterval Measurand = "Energy.Reactive.Import.Interval"
+
+	// MeasurandFrequency is the instantaneous reading of the powerline frequency. OCPP 1.6
+	// has no unit for it; the value is in Hz.
+	MeasurandFrequency Measurand = "Frequency"
+
+	// MeasurandPowerActiveExport is the instantaneous active power exported by the EV, in W
+	// or kW.
+	MeasurandPowerActiveExport Measurand = "Power.Active.Export"
+
+	// MeasurandPowerActiveImport is the instantaneous active power imported by the EV, in W
+	// or kW.
+	MeasurandPowerActiveImport Measurand = "Power.Active.Import"
+
+	// MeasurandPowerFactor is the instantaneous power factor of the total energy flow.
+	MeasurandPowerFactor Measurand = "Power.Factor"
+
+	// MeasurandPowerOffered is the maximum power offered to the EV, in W or kW.
+	MeasurandPowerOffered Measurand = "Power.Offered"
+
+	// MeasurandPowerReactiveExport is the instantaneous reactive power exported by the EV,
+	// in var or kvar.
+	MeasurandPowerReactiveExport Measurand = "Power.Reactive.Export"
+
+	// MeasurandPowerReactiveImport is the instantaneous reactive power imported by the EV,
+	// in var or kvar.
+	MeasurandPowerReactiveImport Measurand = "Power.Reactive.Import"
+
+	// MeasurandRPM is the fan speed, in revolutions per minute.
+	MeasurandRPM Measurand = "RPM"
+
+	// MeasurandSoC is the state of charge of the EV battery, in Percent.
+	MeasurandSoC Measurand = "SoC"
+
+	// MeasurandTemperature is the temperature reading inside the Charge Point.
+	MeasurandTemperature Measurand = "Temperature"
+
+	// MeasurandVoltage is the instantaneous AC RMS supply voltage, in V.
+	MeasurandVoltage Measurand = "Voltage"
+)
+
+// IsValid returns true if the Measurand is one of the values defined by OCPP 1.6J.
+func (m Measurand) IsValid() bool {
+	switch m {
+	case MeasurandCurrentExport, MeasurandCurrentImport, MeasurandCurrentOffered,
+		MeasurandEnergyActiveExportRegister, MeasurandEnergyActiveImportRegister,
+		MeasurandEnergyReactiveExportRegister, MeasurandEnergyReactiveImportRegister,
+		MeasurandEnergyActiveExportInterval, MeasurandEnergyActiveImportInterval,
+		MeasurandEnergyReactiveExportInterval, MeasurandEnergyReactiveImportInterval,
+		MeasurandFrequency, MeasurandPowerActiveExport, MeasurandPowerActiveImport,
+		MeasurandPowerFactor, MeasurandPowerOffered, MeasurandPowerReactiveExport,
+		MeasurandPowerReactiveImport, MeasurandRPM, MeasurandSoC, MeasurandTemperature,
+		MeasurandVoltage:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the Measurand.
+func (m Measurand) String() string {
+	return string(m)
+}
+
+// ParseMeasurand converts a wire value into a Measurand. Unknown values are rejected
+// with a *ValidationError wrapping ErrInvalidMeasurand.
+func ParseMeasurand(value string) (Measurand, error) {
+	return ParseEnum[Measurand](value, ErrInvalidMeasurand)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with ErrInvalidMeasurand
+// if the value is not recognized.
+func (m Measurand) MarshalText() ([]byte, error) {
+	return MarshalEnum(m, ErrInvalidMeasurand)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidMeasurand if the input is not recognized.
+func (m *Measurand) UnmarshalText(text []byte) error {
+	parsed, err := ParseMeasurand(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (m Measurand) MarshalJSON() ([]byte, error) {
+	text, err := m.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (m *Measurand) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, m, ParseMeasurand)
+}
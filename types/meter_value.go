@@ -0,0 +1,132 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// MeterValueType is a collection of one or more values sampled at the same point in
+// time, as carried in MeterValues.req and in the transactionData of
+// StopTransaction.req.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.33: MeterValue
+type MeterValueType struct {
+	// Timestamp is the time at which the values were sampled.
+	Timestamp DateTimeType
+
+	// SampledValue holds the values sampled at Timestamp. At least one is required.
+	SampledValue []SampledValueType
+}
+
+// MeterValue constructs a new MeterValueType from the sampling time and the values
+// sampled at that time.
+//
+// It returns an error if the timestamp is the zero time, if no sampled value is
+// given or if a sampled value is invalid.
+//
+// Example usage:
+//
+//	energy, _ := types.SampledValue("1500")
+//	mv, err := types.MeterValue(time.Now(), energy)
+//	if err != nil {
+//	    log.Fatalf("invalid meter value: %v", err)
+//	}
+func MeterValue(timestamp time.Time, sampledValues ...SampledValueType) (MeterValueType, error) {
+	ts, err := DateTime(timestamp)
+	if err != nil {
+		return MeterValueType{}, WithPath("timestamp", err)
+	}
+
+	mv := MeterValueType{Timestamp: ts, SampledValue: sampledValues}
+	if err := mv.Validate(); err != nil {
+		return MeterValueType{}, err
+	}
+
+	return mv, nil
+}
+
+// Validate checks the MeterValueType and returns the first failure as a
+// *ValidationError with its field path.
+func (m MeterValueType) Validate() error {
+	return FirstError(m.ValidateAll())
+}
+
+// ValidateAll checks every field of the MeterValueType and returns all failures
+// joined with errors.Join, each as a *ValidationError with its field path, for
+// example "sampledValue[1].measurand".
+func (m MeterValueType) ValidateAll() error {
+	return Join(
+		Field("timestamp", m.Timestamp),
+		sampledValueCountError(len(m.SampledValue)),
+		ListField("sampledValue", m.SampledValue),
+	)
+}
+
+// sampledValueCountError reports a MeterValue without sampled values.
+func sampledValueCountError(count int) error {
+	if count < 1 {
+		return MinItemsError("sampledValue", count, 1, ErrEmptyValueNotAllowed)
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the MeterValueType.
+func (m MeterValueType) String() string {
+	return "{timestamp=" + m.Timestamp.String() + ", sampledValue=" + FormatList(m.SampledValue) + "}"
+}
+
+// meterValuePayload is the OCPP 1.6J wire representation of MeterValueType.
+type meterValuePayload struct {
+	Timestamp    *string           `json:"timestamp"`
+	SampledValue []json.RawMessage `json:"sampledValue"`
+}
+
+// MarshalJSON implements json.Marshaler, producing a `meterValue` object with the
+// OCPP 1.6J field names. The value is validated first, so an invalid MeterValueType
+// is never encoded.
+func (m MeterValueType) MarshalJSON() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	timestamp := m.Timestamp.String()
+
+	sampledValue, err := EncodeList(m.SampledValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(meterValuePayload{Timestamp: &timestamp, SampledValue: sampledValue})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a `meterValue` object and
+// reporting all of its failures together, including those of every sampled value.
+// A JSON null leaves the value unchanged.
+func (m *MeterValueType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return nil
+	}
+
+	var payload meterValuePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	timestamp, timestampErr := Required("timestamp", payload.Timestamp, ParseDateTime)
+	sampledValue, sampledValueErr := DecodeRequiredList[SampledValueType]("sampledValue", payload.SampledValue)
+
+	if payload.SampledValue != nil && sampledValueErr == nil {
+		sampledValueErr = sampledValueCountError(len(sampledValue))
+	}
+
+	if err := Join(timestampErr, sampledValueErr); err != nil {
+		return err
+	}
+
+	*m = MeterValueType{Timestamp: timestamp, SampledValue: sampledValue}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+var sampleTime = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func TestMeterValueValid(t *testing.T) {
+	t.Parallel()
+
+	energy, _ := SampledValue("1500")
+
+	mv, err := MeterValue(sampleTime, energy)
+	if err != nil {
+		t.Fatalf("unexpected error creating MeterValue: %v", err)
+	}
+
+	want := "{timestamp=2025-01-02T03:04:05.000Z, sampledValue=[{value=1500}]}"
+	if mv.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, mv.String())
+	}
+}
+
+func TestMeterValueInvalid(t *testing.T) {
+	t.Parallel()
+
+	energy, _ := SampledValue("1500")
+
+	if _, err := MeterValue(time.Time{}, energy); !errors.Is(err, ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+
+	_, err := MeterValue(sampleTime)
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Path != "sampledValue" || verr.Constraint != "minItems=1" {
+		t.Errorf("expected minItems failure at sampledValue, got %v", err)
+	}
+}
+
+func TestMeterValueValidateAllReportsElementPaths(t *testing.T) {
+	t.Parallel()
+
+	energy, _ := SampledValue("1500")
+	phase := Phase("L4")
+	invalid := SampledValueType{Value: "1", Context: nil, Format: nil, Measurand: nil, Phase: &phase, Location: nil, Unit: nil}
+
+	mv := MeterValueType{Timestamp: DateTimeType{}, SampledValue: []SampledValueType{energy, invalid}}
+
+	err := mv.ValidateAll()
+	if !errors.Is(err, ErrInvalidDateTime) || !errors.Is(err, ErrInvalidPhase) {
+		t.Errorf("expected timestamp and phase failures, got %v", err)
+	}
+
+	paths := make([]string, 0, 2)
+
+	for _, e := range splitJoined(err) {
+		var verr *ValidationError
+		if errors.As(e, &verr) {
+			paths = append(paths, verr.Path)
+		}
+	}
+
+	if len(paths) != 2 || paths[0] != "timestamp" || paths[1] != "sampledValue[1].phase" {
+		t.Errorf("expected failures at timestamp and sampledValue[1].phase, got %v", paths)
+	}
+}
+
+func TestMeterValueJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"timestamp":"2025-01-02T03:04:05.000Z","sampledValue":[{"value":"1500"},` +
+		`{"value":"32","measurand":"Current.Import","phase":"L1","unit":"A"}]}`
+
+	var mv MeterValueType
+	if err := json.Unmarshal([]byte(data), &mv); err != nil {
+		t.Fatalf("unexpected error unmarshaling MeterValue: %v", err)
+	}
+
+	out, err := json.Marshal(mv)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling MeterValue: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestMeterValueUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		data string
+		path string
+	}{
+		{`{"sampledValue":[{"value":"1"}]}`, "timestamp"},
+		{`{"timestamp":"2025-01-02T03:04:05Z"}`, "sampledValue"},
+		{`{"timestamp":"2025-01-02T03:04:05Z","sampledValue":[]}`, "sampledValue"},
+		{`{"timestamp":"2025-01-02T03:04:05Z","sampledValue":[{"value":"1"},{"value":"1","unit":"kwh"}]}`, "sampledValue[1].unit"},
+	}
+
+	for _, tc := range tests {
+		var mv MeterValueType
+
+		err := json.Unmarshal([]byte(tc.data), &mv)
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Path != tc.path {
+			t.Errorf("%s: expected failure at %s, got %v", tc.data, tc.path, err)
+		}
+	}
+
+	var mv MeterValueType
+	if err := json.Unmarshal([]byte(`{"timestamp":1}`), &mv); err == nil {
+		t.Error("expected error for malformed MeterValue, got nil")
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &mv); err != nil {
+		t.Errorf("expected null to be a no-op, got %v", err)
+	}
+}
+
+func TestMeterValueMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := json.Marshal(MeterValueType{Timestamp: DateTimeType{}, SampledValue: nil}); err == nil {
+		t.Error("expected error marshaling invalid MeterValue, got nil")
+	}
+}
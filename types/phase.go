@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidPhase indicates that a Phase is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidPhase = errors.New("invalid phase")
+
+// Phase is the phase or phases to which a SampledValue applies, reported in its
+// `phase` field. Values with a "-N" suffix are measured between a phase and
+// neutral, values such as "L1-L2" between two phases.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.34: Phase
+type Phase string
+
+const (
+	// PhaseL1 is measured on L1.
+	PhaseL1 Phase = "L1"
+
+	// PhaseL2 is measured on L2.
+	PhaseL2 Phase = "L2"
+
+	// PhaseL3 is measured on L3.
+	PhaseL3 Phase = "L3"
+
+	// PhaseN is measured on the neutral conductor.
+	PhaseN Phase = "N"
+
+	// PhaseL1N is measured between L1 and neutral.
+	PhaseL1N Phase = "L1-N"
+
+	// PhaseL2N is measured between L2 and neutral.
+	PhaseL2N Phase = "L2-N"
+
+	// PhaseL3N is measured between L3 and neutral.
+	PhaseL3N Phase = "L3-N"
+
+	// PhaseL1L2 is measured between L1 and L2.
+	PhaseL1L2 Phase = "L1-L2"
+
+	// PhaseL2L3 is measured between L2 and L3.
+	PhaseL2L3 Phase = "L2-L3"
+
+	// PhaseL3L1 is measured between L3 and L1.
+	PhaseL3L1 Phase = "L3-L1"
+)
+
+// IsValid returns true if the Phase is one of the values defined by OCPP 1.6J.
+func (p Phase) IsValid() bool {
+	switch p {
+	case PhaseL1, PhaseL2, PhaseL3, PhaseN, PhaseL1N, PhaseL2N, PhaseL3N, PhaseL1L2,
+		PhaseL2L3, PhaseL3L1:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the Phase.
+func (p Phase) String() string {
+	return string(p)
+}
+
+// ParsePhase converts a wire value into a Phase. Unknown values are rejected
+// with a *ValidationError wrapping ErrInvalidPhase.
+func ParsePhase(value string) (Phase, error) {
+	return ParseEnum[Phase](value, ErrInvalidPhase)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with ErrInvalidPhase
+// if the value is not recognized.
+func (p Phase) MarshalText() ([]byte, error) {
+	return MarshalEnum(p, ErrInvalidPhase)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidPhase if the input is not recognized.
+func (p *Phase) UnmarshalText(text []byte) error {
+	parsed, err := ParsePhase(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (p Phase) MarshalJSON() ([]byte, error) {
+	text, err := p.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (p *Phase) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, p, ParsePhase)
+}
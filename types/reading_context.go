@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidReadingContext indicates that a ReadingContext is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidReadingContext = errors.New("invalid reading context")
+
+// ReadingContext is the reason for reading a sampled value, reported in the
+// `context` field of a SampledValue. When absent, Sample.Periodic is assumed.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.35: ReadingContext
+type ReadingContext string
+
+const (
+	// ReadingContextInterruptionBegin indicates a value taken at the start of an interruption of
+	// the transaction.
+	ReadingContextInterruptionBegin ReadingContext = "Interruption.Begin"
+
+	// ReadingContextInterruptionEnd indicates a value taken when resuming after an interruption of
+	// the transaction.
+	ReadingContextInterruptionEnd ReadingContext = "Interruption.End"
+
+	// ReadingContextOther indicates a value taken for any other reason.
+	ReadingContextOther ReadingContext = "Other"
+
+	// ReadingContextSampleClock indicates a value taken at a clock aligned interval.
+	ReadingContextSampleClock ReadingContext = "Sample.Clock"
+
+	// ReadingContextSamplePeriodic indicates a value taken as a periodic sample relative to the
+	// start of the transaction. This is the default context.
+	ReadingContextSamplePeriodic ReadingContext = "Sample.Periodic"
+
+	// ReadingContextTransactionBegin indicates a value taken at the start of the transaction.
+	ReadingContextTransactionBegin ReadingContext = "Transaction.Begin"
+
+	// ReadingContextTransactionEnd indicates a value taken at the end of the transaction.
+	ReadingContextTransactionEnd ReadingContext = "Transaction.End"
+
+	// ReadingContextTrigger indicates a value taken in response to a TriggerMessage.req.
+	ReadingContextTrigger ReadingContext = "Trigger"
+)
+
+// IsValid returns true if the ReadingContext is one of the values defined by OCPP 1.6J.
+func (r ReadingContext) IsValid() bool {
+	switch r {
+	case ReadingContextInterruptionBegin, ReadingContextInterruptionEnd,
+		ReadingContextOther, ReadingContextSampleClock, ReadingContextSamplePeriodic,
+		ReadingContextTransactionBegin, ReadingContextTransactionEnd, ReadingContextTrigger:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ReadingContext.
+func (r ReadingContext) String() string {
+	return string(r)
+}
+
+// ParseReadingContext converts a wire value into a ReadingContext. Unknown values are rejected
+// with a *ValidationError wrapping ErrInvalidReadingContext.
+func ParseReadingContext(value string) (ReadingContext, error) {
+	return ParseEnum[ReadingContext](value, ErrInvalidReadingContext)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with ErrInvalidReadingContext
+// if the value is not recognized.
+func (r ReadingContext) MarshalText() ([]byte, error) {
+	return MarshalEnum(r, ErrInvalidReadingContext)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidReadingContext if the input is not recognized.
+func (r *ReadingContext) UnmarshalText(text []byte) error {
+	parsed, err := ParseReadingContext(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (r ReadingContext) MarshalJSON() ([]byte, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (r *ReadingContext) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, r, ParseReadingContext)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidRecurrencyKindType indicates that a RecurrencyKindType is not
+// one of the values defined by OCPP 1.6J.
+var ErrInvalidRecurrencyKindType = errors.New("invalid recurrency kind type")
+
+// RecurrencyKindType is the period after which a Recurring charging profile repeats,
+// reported in its `recurrencyKind` field.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.37: RecurrencyKindType
+type RecurrencyKindType string
+
+const (
+	// RecurrencyKindTypeDaily repeats the schedule every 24 hours.
+	RecurrencyKindTypeDaily RecurrencyKindType = "Daily"
+
+	// RecurrencyKindTypeWeekly repeats the schedule every 7 days.
+	RecurrencyKindTypeWeekly RecurrencyKindType = "Weekly"
+)
+
+// IsValid returns true if the RecurrencyKindType is one of the values
+// defined by OCPP 1.6J.
+func (r RecurrencyKindType) IsValid() bool {
+	switch r {
+	case RecurrencyKindTypeDaily, RecurrencyKindTypeWeekly:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the RecurrencyKindType.
+func (r RecurrencyKindType) String() string {
+	return string(r)
+}
+
+// ParseRecurrencyKindType converts a wire value into a RecurrencyKindType. Unknown values are
+// rejected with a *ValidationError wrapping ErrInvalidRecurrencyKindType.
+func ParseRecurrencyKindType(value string) (RecurrencyKindType, error) {
+	return ParseEnum[RecurrencyKindType](value, ErrInvalidRecurrencyKindType)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidRecurrencyKindType if the value is not recognized.
+func (r RecurrencyKindType) MarshalText() ([]byte, error) {
+	return MarshalEnum(r, ErrInvalidRecurrencyKindType)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidRecurrencyKindType if the input is not recognized.
+func (r *RecurrencyKindType) UnmarshalText(text []byte) error {
+	parsed, err := ParseRecurrencyKindType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (r RecurrencyKindType) MarshalJSON() ([]byte, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (r *RecurrencyKindType) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, r, ParseRecurrencyKindType)
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidRemoteStartStopStatus indicates that a RemoteStartStopStatus is not one
+// of the values defined by OCPP 1.6J.
+var ErrInvalidRemoteStartStopStatus = errors.New("invalid remote start stop status")
+
+// RemoteStartStopStatus is the result of a RemoteStartTransaction.req or
+// RemoteStopTransaction.req, reported in the `status` field of their confirmations.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.39: RemoteStartStopStatus
+type RemoteStartStopStatus string
+
+const (
+	// RemoteStartStopStatusAccepted means that the command will be executed.
+	RemoteStartStopStatusAccepted RemoteStartStopStatus = "Accepted"
+
+	// RemoteStartStopStatusRejected means that the command will not be executed.
+	RemoteStartStopStatusRejected RemoteStartStopStatus = "Rejected"
+)
+
+// IsValid returns true if the RemoteStartStopStatus is one of the values
+// defined by OCPP 1.6J.
+func (r RemoteStartStopStatus) IsValid() bool {
+	switch r {
+	case RemoteStartStopStatusAccepted, RemoteStartStopStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the RemoteStartStopStatus.
+func (r RemoteStartStopStatus) String() string {
+	return string(r)
+}
+
+// ParseRemoteStartStopStatus converts a wire value into a RemoteStartStopStatus.
+// Unknown values are rejected with a *ValidationError wrapping
+// ErrInvalidRemoteStartStopStatus.
+func ParseRemoteStartStopStatus(value string) (RemoteStartStopStatus, error) {
+	return ParseEnum[RemoteStartStopStatus](value, ErrInvalidRemoteStartStopStatus)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with
+// ErrInvalidRemoteStartStopStatus if the value is not recognized.
+func (r RemoteStartStopStatus) MarshalText() ([]byte, error) {
+	return MarshalEnum(r, ErrInvalidRemoteStartStopStatus)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidRemoteStartStopStatus if the input is not recognized.
+func (r *RemoteStartStopStatus) UnmarshalText(text []byte) error {
+	parsed, err := ParseRemoteStartStopStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*r = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (r RemoteStartStopStatus) MarshalJSON() ([]byte, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (r *RemoteStartStopStatus) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, r, ParseRemoteStartStopStatus)
+}
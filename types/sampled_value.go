@@ -0,0 +1,237 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidSampledValue indicates that the value of a SampledValue in Raw format is
+// not a decimal number.
+var ErrInvalidSampledValue = errors.New("sampled value is not a decimal number")
+
+// decimalPattern matches the decimal numbers accepted as Raw sampled values, such as
+// "1500", "-0.5" or "2.3e3".
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+
+// SampledValueType is a single value sampled by a meter, as carried in the
+// `sampledValue` array of a MeterValue.
+//
+// Only Value is required. Optional fields are nil when they are not set, in which
+// case the specification defaults apply: context Sample.Periodic, format Raw,
+// measurand Energy.Active.Import.Register, location Outlet and unit Wh.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.43: SampledValue
+type SampledValueType struct {
+	// Value is the sampled value. In Raw format it must be a decimal number; in
+	// SignedData format it is a signed binary block encoded as a hex string.
+	Value string
+
+	// Context is the reason for sampling the value.
+	Context *ReadingContext
+
+	// Format tells how Value is encoded.
+	Format *ValueFormat
+
+	// Measurand is the quantity that was measured.
+	Measurand *Measurand
+
+	// Phase is the phase or phases to which the value applies. It is nil for values
+	// that are not phase specific.
+	Phase *Phase
+
+	// Location is where the value was measured.
+	Location *Location
+
+	// Unit is the unit of Value.
+	Unit *UnitOfMeasure
+}
+
+// SampledValue constructs a new SampledValueType with the given value and no optional
+// fields, that is a Raw Energy.Active.Import.Register reading in Wh.
+//
+// It returns an error if the value is not a decimal number.
+func SampledValue(value string) (SampledValueType, error) {
+	sampled := SampledValueType{
+		Value:     value,
+		Context:   nil,
+		Format:    nil,
+		Measurand: nil,
+		Phase:     nil,
+		Location:  nil,
+		Unit:      nil,
+	}
+
+	if err := sampled.Validate(); err != nil {
+		return SampledValueType{}, err
+	}
+
+	return sampled, nil
+}
+
+// Validate checks the SampledValueType and returns the first failure as a
+// *ValidationError with its field path.
+func (s SampledValueType) Validate() error {
+	return FirstError(s.ValidateAll())
+}
+
+// ValidateAll checks every field of the SampledValueType and returns all failures
+// joined with errors.Join, each as a *ValidationError with its field path.
+func (s SampledValueType) ValidateAll() error {
+	errs := []error{sampledValueError(s.Value, s.Format)}
+
+	if s.Context != nil && !s.Context.IsValid() {
+		errs = append(errs, EnumError("context", *s.Context, ErrInvalidReadingContext))
+	}
+
+	if s.Format != nil && !s.Format.IsValid() {
+		errs = append(errs, EnumError("format", *s.Format, ErrInvalidValueFormat))
+	}
+
+	if s.Measurand != nil && !s.Measurand.IsValid() {
+		errs = append(errs, EnumError("measurand", *s.Measurand, ErrInvalidMeasurand))
+	}
+
+	if s.Phase != nil && !s.Phase.IsValid() {
+		errs = append(errs, EnumError("phase", *s.Phase, ErrInvalidPhase))
+	}
+
+	if s.Location != nil && !s.Location.IsValid() {
+		errs = append(errs, EnumError("location", *s.Location, ErrInvalidLocation))
+	}
+
+	if s.Unit != nil && !s.Unit.IsValid() {
+		errs = append(errs, EnumError("unit", *s.Unit, ErrInvalidUnitOfMeasure))
+	}
+
+	return Join(errs...)
+}
+
+// sampledValueError reports an empty value, or a Raw value that is not a decimal
+// number. Signed data and values in an unknown format are only required to be
+// non-empty.
+func sampledValueError(value string, format *ValueFormat) error {
+	if value == "" {
+		return &ValidationError{
+			Path:       "value",
+			Constraint: ConstraintNotEmpty,
+			Value:      value,
+			Err:        ErrEmptyValueNotAllowed,
+		}
+	}
+
+	if (format == nil || *format == ValueFormatRaw) && !decimalPattern.MatchString(value) {
+		return &ValidationError{
+			Path:       "value",
+			Constraint: ConstraintDecimal,
+			Value:      value,
+			Err:        fmt.Errorf("%w: %q", ErrInvalidSampledValue, value),
+		}
+	}
+
+	return nil
+}
+
+// String returns a human-readable representation of the SampledValueType.
+//
+// Optional fields are only included when set.
+func (s SampledValueType) String() string {
+	fields := []string{"value=" + s.Value}
+
+	optional := []struct {
+		name  string
+		value fmt.Stringer
+		isSet bool
+	}{
+		{"context", s.Context, s.Context != nil},
+		{"format", s.Format, s.Format != nil},
+		{"measurand", s.Measurand, s.Measurand != nil},
+		{"phase", s.Phase, s.Phase != nil},
+		{"location", s.Location, s.Location != nil},
+		{"unit", s.Unit, s.Unit != nil},
+	}
+
+	for _, field := range optional {
+		if field.isSet {
+			fields = append(fields, field.name+"="+field.value.String())
+		}
+	}
+
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// sampledValuePayload is the OCPP 1.6J wire representation of SampledValueType.
+type sampledValuePayload struct {
+	Value     *string `json:"value"`
+	Context   *string `json:"context,omitempty"`
+	Format    *string `json:"format,omitempty"`
+	Measurand *string `json:"measurand,omitempty"`
+	Phase     *string `json:"phase,omitempty"`
+	Location  *string `json:"location,omitempty"`
+	Unit      *string `json:"unit,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, producing a `sampledValue` object with the
+// OCPP 1.6J field names. Unset optional fields are omitted. The value is validated
+// first, so an invalid SampledValueType is never encoded.
+func (s SampledValueType) MarshalJSON() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(sampledValuePayload{
+		Value:     &s.Value,
+		Context:   OptionalString(s.Context),
+		Format:    OptionalString(s.Format),
+		Measurand: OptionalString(s.Measurand),
+		Phase:     OptionalString(s.Phase),
+		Location:  OptionalString(s.Location),
+		Unit:      OptionalString(s.Unit),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a `sampledValue` object and
+// reporting all of its failures together. A JSON null leaves the value unchanged.
+func (s *SampledValueType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		return nil
+	}
+
+	var payload sampledValuePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	value, valueErr := RequiredValue("value", payload.Value)
+	context, contextErr := Optional("context", payload.Context, ParseReadingContext)
+	format, formatErr := Optional("format", payload.Format, ParseValueFormat)
+	measurand, measurandErr := Optional("measurand", payload.Measurand, ParseMeasurand)
+	phase, phaseErr := Optional("phase", payload.Phase, ParsePhase)
+	location, locationErr := Optional("location", payload.Location, ParseLocation)
+	unit, unitErr := Optional("unit", payload.Unit, ParseUnitOfMeasure)
+
+	if valueErr == nil && formatErr == nil {
+		valueErr = sampledValueError(value, format)
+	}
+
+	err := Join(valueErr, contextErr, formatErr, measurandErr, phaseErr, locationErr, unitErr)
+	if err != nil {
+		return err
+	}
+
+	*s = SampledValueType{
+		Value:     value,
+		Context:   context,
+		Format:    format,
+		Measurand: measurand,
+		Phase:     phase,
+		Location:  location,
+		Unit:      unit,
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestSampledValueValid(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"1500", "-0.5", "+12.", ".25", "2.3e3", "1E-2"} {
+		if _, err := SampledValue(value); err != nil {
+			t.Errorf("unexpected error for %q: %v", value, err)
+		}
+	}
+}
+
+func TestSampledValueInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value      string
+		constraint string
+		sentinel   error
+	}{
+		{"", ConstraintNotEmpty, ErrEmptyValueNotAllowed},
+		{"1,5", ConstraintDecimal, ErrInvalidSampledValue},
+		{"NaN", ConstraintDecimal, ErrInvalidSampledValue},
+		{"0x1F", ConstraintDecimal, ErrInvalidSampledValue},
+		{" 12", ConstraintDecimal, ErrInvalidSampledValue},
+	}
+
+	for _, tc := range tests {
+		_, err := SampledValue(tc.value)
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Path != "value" || verr.Constraint != tc.constraint || !errors.Is(err, tc.sentinel) {
+			t.Errorf("%q: expected %s failure at value, got %v", tc.value, tc.constraint, err)
+		}
+	}
+}
+
+func TestSampledValueSignedData(t *testing.T) {
+	t.Parallel()
+
+	format := ValueFormatSignedData
+	sampled := SampledValueType{
+		Value: "A1B2C3", Context: nil, Format: &format, Measurand: nil, Phase: nil, Location: nil, Unit: nil,
+	}
+
+	if err := sampled.Validate(); err != nil {
+		t.Errorf("expected signed data to be valid, got %v", err)
+	}
+}
+
+func TestSampledValueValidateAll(t *testing.T) {
+	t.Parallel()
+
+	context := ReadingContext("Periodic")
+	format := ValueFormat("Hex")
+	measurand := Measurand("Energy")
+	phase := Phase("L4")
+	location := Location("Car")
+	unit := UnitOfMeasure("J")
+
+	sampled := SampledValueType{
+		Value: "", Context: &context, Format: &format, Measurand: &measurand, Phase: &phase, Location: &location, Unit: &unit,
+	}
+
+	err := sampled.ValidateAll()
+	for _, sentinel := range []error{
+		ErrEmptyValueNotAllowed, ErrInvalidReadingContext, ErrInvalidValueFormat, ErrInvalidMeasurand,
+		ErrInvalidPhase, ErrInvalidLocation, ErrInvalidUnitOfMeasure,
+	} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+}
+
+func TestSampledValueString(t *testing.T) {
+	t.Parallel()
+
+	measurand := MeasurandPowerActiveImport
+	unit := UnitOfMeasureKW
+	sampled := SampledValueType{
+		Value: "7.2", Context: nil, Format: nil, Measurand: &measurand, Phase: nil, Location: nil, Unit: &unit,
+	}
+
+	want := "{value=7.2, measurand=Power.Active.Import, unit=kW}"
+	if sampled.String() != want {
+		t.Errorf("unexpected String() output:\nwant: %s\ngot : %s", want, sampled.String())
+	}
+}
+
+func TestSampledValueJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := `{"value":"230.1","context":"Sample.Clock","format":"Raw","measurand":"Voltage","phase":"L1-N",` +
+		`"location":"Inlet","unit":"V"}`
+
+	var sampled SampledValueType
+	if err := json.Unmarshal([]byte(data), &sampled); err != nil {
+		t.Fatalf("unexpected error unmarshaling sampled value: %v", err)
+	}
+
+	out, err := json.Marshal(sampled)
+	if err != nil {
+		t.Fatalf("unexpected error marshaling sampled value: %v", err)
+	}
+
+	if string(out) != data {
+		t.Errorf("unexpected JSON round trip:\nwant: %s\ngot : %s", data, out)
+	}
+}
+
+func TestSampledValueUnmarshalJSONReportsAllFailures(t *testing.T) {
+	t.Parallel()
+
+	var sampled SampledValueType
+
+	err := json.Unmarshal([]byte(`{"value":"12 kWh","measurand":"Energy.Active.Import.Registers","unit":"KWH"}`), &sampled)
+	for _, sentinel := range []error{ErrInvalidSampledValue, ErrInvalidMeasurand, ErrInvalidUnitOfMeasure} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("expected %v in %v", sentinel, err)
+		}
+	}
+
+	var verr *ValidationError
+	if !errors.As(FirstError(err), &verr) || verr.Path != "value" {
+		t.Errorf("expected first failure at value, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"measurand":"SoC"}`), &sampled); !errors.Is(err, ErrMissingRequiredField) {
+		t.Errorf("expected ErrMissingRequiredField, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"value":12}`), &sampled); err == nil {
+		t.Error("expected error for numeric value, got nil")
+	}
+}
+
+func TestSampledValueUnmarshalJSONNullIsNoOp(t *testing.T) {
+	t.Parallel()
+
+	sampled, _ := SampledValue("1")
+	if err := json.Unmarshal([]byte(`null`), &sampled); err != nil || sampled.Value != "1" {
+		t.Errorf("expected null to leave the value unchanged, got %s, %v", sampled, err)
+	}
+}
+
+func TestSampledValueMarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := json.Marshal(SampledValueType{}); !errors.Is(err, ErrEmptyValueNotAllowed) {
+		t.Errorf("expected ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
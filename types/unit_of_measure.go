@@ -0,0 +1,134 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidUnitOfMeasure indicates that a UnitOfMeasure is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidUnitOfMeasure = errors.New("invalid unit of measure")
+
+// UnitOfMeasure is the unit of a SampledValue, reported in its `unit` field. When
+// absent, Wh is assumed.
+//
+// The official JSON schemas enumerate both "Celcius" and "Celsius" for the degree
+// Celsius. Both are accepted; "Celcius" is kept for compatibility with Charge
+// Points that send the misspelling.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.45: UnitOfMeasure
+type UnitOfMeasure string
+
+const (
+	// UnitOfMeasureWh is watt-hours (energy). This is the default unit.
+	UnitOfMeasureWh UnitOfMeasure = "Wh"
+
+	// UnitOfMeasureKWh is kilowatt-hours (energy).
+	UnitOfMeasureKWh UnitOfMeasure = "kWh"
+
+	// UnitOfMeasureVarh is var-hours (reactive energy).
+	UnitOfMeasureVarh UnitOfMeasure = "varh"
+
+	// UnitOfMeasureKvarh is kilovar-hours (reactive energy).
+	UnitOfMeasureKvarh UnitOfMeasure = "kvarh"
+
+	// UnitOfMeasureW is watts (power).
+	UnitOfMeasureW UnitOfMeasure = "W"
+
+	// UnitOfMeasureKW is kilowatts (power).
+	UnitOfMeasureKW UnitOfMeasure = "kW"
+
+	// UnitOfMeasureVA is volt-amperes (apparent power).
+	UnitOfMeasureVA UnitOfMeasure = "VA"
+
+	// UnitOfMeasureKVA is kilovolt-amperes (apparent power).
+	UnitOfMeasureKVA UnitOfMeasure = "kVA"
+
+	// UnitOfMeasureVar is vars (reactive power).
+	UnitOfMeasureVar UnitOfMeasure = "var"
+
+	// UnitOfMeasureKvar is kilovars (reactive power).
+	UnitOfMeasureKvar UnitOfMeasure = "kvar"
+
+	// UnitOfMeasureA is amperes (current).
+	UnitOfMeasureA UnitOfMeasure = "A"
+
+	// UnitOfMeasureV is volts (AC RMS voltage).
+	UnitOfMeasureV UnitOfMeasure = "V"
+
+	// UnitOfMeasureK is degrees Kelvin (temperature).
+	UnitOfMeasureK UnitOfMeasure = "K"
+
+	// UnitOfMeasureCelcius is degrees Celsius (temperature), misspelled. The JSON
+	// schemas list it next to UnitOfMeasureCelsius, and it is kept for compatibility.
+	UnitOfMeasureCelcius UnitOfMeasure = "Celcius"
+
+	// UnitOfMeasureCelsius is degrees Celsius (temperature).
+	UnitOfMeasureCelsius UnitOfMeasure = "Celsius"
+
+	// UnitOfMeasureFahrenheit is degrees Fahrenheit (temperature).
+	UnitOfMeasureFahrenheit UnitOfMeasure = "Fahrenheit"
+
+	// UnitOfMeasurePercent is a percentage.
+	UnitOfMeasurePercent UnitOfMeasure = "Percent"
+)
+
+// IsValid returns true if the UnitOfMeasure is one of the values defined by OCPP 1.6J.
+func (u UnitOfMeasure) IsValid() bool {
+	switch u {
+	case UnitOfMeasureWh, UnitOfMeasureKWh, UnitOfMeasureVarh, UnitOfMeasureKvarh,
+		UnitOfMeasureW, UnitOfMeasureKW, UnitOfMeasureVA, UnitOfMeasureKVA, UnitOfMeasureVar,
+		UnitOfMeasureKvar, UnitOfMeasureA, UnitOfMeasureV, UnitOfMeasureK,
+		UnitOfMeasureCelcius, UnitOfMeasureCelsius, UnitOfMeasureFahrenheit,
+		UnitOfMeasurePercent:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the UnitOfMeasure.
+func (u UnitOfMeasure) String() string {
+	return string(u)
+}
+
+// ParseUnitOfMeasure converts a wire value into a UnitOfMeasure. Unknown values are rejected
+// with a *ValidationError wrapping ErrInvalidUnitOfMeasure.
+func ParseUnitOfMeasure(value string) (UnitOfMeasure, error) {
+	return ParseEnum[UnitOfMeasure](value, ErrInvalidUnitOfMeasure)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with ErrInvalidUnitOfMeasure
+// if the value is not recognized.
+func (u UnitOfMeasure) MarshalText() ([]byte, error) {
+	return MarshalEnum(u, ErrInvalidUnitOfMeasure)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidUnitOfMeasure if the input is not recognized.
+func (u *UnitOfMeasure) UnmarshalText(text []byte) error {
+	parsed, err := ParseUnitOfMeasure(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (u UnitOfMeasure) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (u *UnitOfMeasure) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, u, ParseUnitOfMeasure)
+}
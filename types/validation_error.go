@@ -0,0 +1,156 @@
+package types
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Constraint names reported in ValidationError.Constraint.
+//
+// Length constraints are reported as "maxLength=<n>", see ConstraintMaxLength.
+const (
+	// ConstraintRequired is reported when a required field is absent.
+	ConstraintRequired = "required"
+
+	// ConstraintNotEmpty is reported when a string field is present but empty.
+	ConstraintNotEmpty = "notEmpty"
+
+	// ConstraintPrintableASCII is reported when a string contains characters outside
+	// the printable ASCII range.
+	ConstraintPrintableASCII = "printableASCII"
+
+	// ConstraintEnum is reported when a value is not one of the allowed enumeration values.
+	ConstraintEnum = "enum"
+
+	// ConstraintDateTime is reported when a value is not a valid OCPP dateTime.
+	ConstraintDateTime = "dateTime"
+
+	// ConstraintDecimal is reported when a value must be a decimal number but is not.
+	ConstraintDecimal = "decimal"
+
+	// ConstraintMultipleOfTenth is reported when a charging rate has more than one
+	// decimal place, which the schemas express as "multipleOf": 0.1.
+	ConstraintMultipleOfTenth = "multipleOf=0.1"
+)
+
+// ConstraintMaxLength returns the constraint name for a maximum length of n characters,
+// for example "maxLength=20".
+func ConstraintMaxLength(n int) string {
+	return "maxLength=" + strconv.Itoa(n)
+}
+
+// ValidationError describes a single field of a type or message that violates an
+// OCPP 1.6J constraint.
+//
+// It carries the JSON path of the field (for example "idTagInfo.parentIdTag"), the
+// violated constraint (for example "maxLength=20", "enum" or "required") and the
+// offending value. ValidationError unwraps to the underlying error, so errors.Is
+// keeps working against the sentinels of this package, such as ErrExceedsMaxLength.
+//
+// Use errors.As to extract it from an error returned by Validate:
+//
+//	var verr *types.ValidationError
+//	if errors.As(err, &verr) {
+//	    fmt.Println(verr.Path, verr.Constraint, verr.Value)
+//	}
+type ValidationError struct {
+	// Path is the dot-separated JSON path of the field. It is empty when a value is
+	// validated on its own, outside of any message.
+	Path string
+
+	// Constraint names the violated rule.
+	Constraint string
+
+	// Value is the offending value, or nil when the field is missing.
+	Value any
+
+	// Err is the underlying error, typically wrapping one of the package sentinels.
+	Err error
+}
+
+// Error returns the underlying error message, prefixed with the field path if known.
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// MissingFieldError returns the ValidationError reported when the required field at
+// path is absent from a payload. It wraps ErrMissingRequiredField.
+func MissingFieldError(path string) error {
+	return &ValidationError{
+		Path:       path,
+		Constraint: ConstraintRequired,
+		Value:      nil,
+		Err:        ErrMissingRequiredField,
+	}
+}
+
+// joinedError is implemented by errors created with errors.Join.
+type joinedError interface {
+	error
+	Unwrap() []error
+}
+
+// splitJoined returns the errors joined in err, or nil if err itself was not
+// created with errors.Join.
+func splitJoined(err error) []error {
+	var joined joinedError
+	if errors.As(err, &joined) && joined == err {
+		return joined.Unwrap()
+	}
+
+	return nil
+}
+
+// WithPath prefixes the path of the ValidationError in err with the given field
+// path, so that errors from nested types report their full JSON path.
+//
+// For example, a "parentIdTag" error returned by IdTagInfoType.Validate becomes
+// "idTagInfo.parentIdTag" once wrapped with WithPath("idTagInfo", err). Errors
+// joined with errors.Join, as returned by ValidateAll, are prefixed one by one.
+// Other errors are returned unchanged, and a nil error stays nil.
+func WithPath(path string, err error) error {
+	if errs := splitJoined(err); errs != nil {
+		prefixed := make([]error, 0, len(errs))
+
+		for _, e := range errs {
+			prefixed = append(prefixed, WithPath(path, e))
+		}
+
+		return errors.Join(prefixed...)
+	}
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr != err {
+		return err
+	}
+
+	prefixed := *verr
+	if prefixed.Path == "" {
+		prefixed.Path = path
+	} else {
+		prefixed.Path = path + "." + prefixed.Path
+	}
+
+	return &prefixed
+}
+
+// FirstError returns the first failure of an error returned by ValidateAll.
+//
+// Joined errors are unwrapped recursively until a single error is found. Any other
+// error, including nil, is returned unchanged.
+func FirstError(err error) error {
+	if errs := splitJoined(err); len(errs) > 0 {
+		return FirstError(errs[0])
+	}
+
+	return err
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidationErrorFromCiString(t *testing.T) {
+	t.Parallel()
+
+	_, err := CiString20(strings.Repeat("A", 21))
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+
+	if verr.Constraint != "maxLength=20" || verr.Value != strings.Repeat("A", 21) || verr.Path != "" {
+		t.Errorf("unexpected ValidationError: %+v", verr)
+	}
+
+	if !errors.Is(err, ErrExceedsMaxLength) {
+		t.Error("expected ValidationError to match ErrExceedsMaxLength")
+	}
+}
+
+func TestValidationErrorConstraints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", ConstraintNotEmpty},
+		{"tab\there", ConstraintPrintableASCII},
+	}
+
+	for _, tc := range tests {
+		_, err := CiString50(tc.input)
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Constraint != tc.want {
+			t.Errorf("input %q: expected constraint %s, got %v", tc.input, tc.want, err)
+		}
+	}
+}
+
+func TestValidationErrorWithPath(t *testing.T) {
+	t.Parallel()
+
+	_, err := IdToken("")
+	err = WithPath("idTagInfo", WithPath("parentIdTag", err))
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+
+	if verr.Path != "idTagInfo.parentIdTag" {
+		t.Errorf("expected path idTagInfo.parentIdTag, got %s", verr.Path)
+	}
+
+	if err.Error() != "idTagInfo.parentIdTag: value must not be empty" {
+		t.Errorf("unexpected error message: %s", err.Error())
+	}
+}
+
+func TestValidationErrorWithPathPassThrough(t *testing.T) {
+	t.Parallel()
+
+	if WithPath("idTag", nil) != nil {
+		t.Error("expected nil error to stay nil")
+	}
+
+	plain := errors.New("plain")
+	if !errors.Is(WithPath("idTag", plain), plain) || WithPath("idTag", plain).Error() != "plain" {
+		t.Error("expected non-ValidationError to be returned unchanged")
+	}
+}
+
+func TestMissingFieldError(t *testing.T) {
+	t.Parallel()
+
+	err := MissingFieldError("status")
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Constraint != ConstraintRequired || verr.Value != nil {
+		t.Errorf("unexpected ValidationError: %+v", verr)
+	}
+
+	if !errors.Is(err, ErrMissingRequiredField) {
+		t.Error("expected error to match ErrMissingRequiredField")
+	}
+
+	if err.Error() != "status: required field is missing" {
+		t.Errorf("unexpected error message: %s", err.Error())
+	}
+}
+
+func TestCiStringValidateAllReportsEveryRule(t *testing.T) {
+	t.Parallel()
+
+	cs := CiString20Type{inner: ciString{Value: strings.Repeat("é", 11), MaxLen: maxLenCiString20}}
+
+	err := cs.ValidateAll()
+	if !errors.Is(err, ErrExceedsMaxLength) || !errors.Is(err, ErrNonPrintableASCII) {
+		t.Errorf("expected both length and character violations, got %v", err)
+	}
+
+	if !errors.Is(cs.Validate(), ErrExceedsMaxLength) || errors.Is(cs.Validate(), ErrNonPrintableASCII) {
+		t.Errorf("expected Validate to report only the first violation, got %v", cs.Validate())
+	}
+
+	empty := CiString500Type{}
+	if err := empty.ValidateAll(); !errors.Is(err, ErrEmptyValueNotAllowed) || errors.Is(err, ErrExceedsMaxLength) {
+		t.Errorf("expected only ErrEmptyValueNotAllowed, got %v", err)
+	}
+}
+
+func TestCiStringTypesValidateAllValid(t *testing.T) {
+	t.Parallel()
+
+	cs20, _ := CiString20("A")
+	cs25, _ := CiString25("A")
+	cs50, _ := CiString50("A")
+	cs255, _ := CiString255("A")
+	cs500, _ := CiString500("A")
+	token, _ := IdToken("A")
+
+	for _, value := range []interface{ ValidateAll() error }{cs20, cs25, cs50, cs255, cs500, token} {
+		if err := value.ValidateAll(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	}
+}
+
+func TestIdTagInfoValidateAll(t *testing.T) {
+	t.Parallel()
+
+	zero := DateTimeType{}
+	parent := IdTokenType{value: CiString20Type{inner: ciString{Value: strings.Repeat("\x01", 21), MaxLen: maxLenCiString20}}}
+	info := IdTagInfoType{Status: "Bogus", ExpiryDate: &zero, ParentIdTag: &parent}
+
+	errs := splitJoined(WithPath("idTagInfo", info.ValidateAll()))
+	if len(errs) != 4 {
+		t.Fatalf("expected 4 failures, got %d: %v", len(errs), errs)
+	}
+
+	want := []string{
+		"idTagInfo.status/enum",
+		"idTagInfo.expiryDate/dateTime",
+		"idTagInfo.parentIdTag/maxLength=20",
+		"idTagInfo.parentIdTag/printableASCII",
+	}
+
+	for i, err := range errs {
+		var verr *ValidationError
+		if !errors.As(err, &verr) || verr.Path+"/"+verr.Constraint != want[i] {
+			t.Errorf("failure %d: expected %s, got %v", i, want[i], err)
+		}
+
+		if i >= 2 && !errors.Is(err, ErrInvalidParentIdTag) {
+			t.Errorf("failure %d: expected ErrInvalidParentIdTag, got %v", i, err)
+		}
+	}
+
+	if FirstError(info.ValidateAll()).Error() != info.Validate().Error() {
+		t.Errorf("expected Validate to return the first failure, got %v", info.Validate())
+	}
+}
+
+func TestFirstError(t *testing.T) {
+	t.Parallel()
+
+	first := errors.New("first")
+	second := errors.New("second")
+
+	if FirstError(errors.Join(errors.Join(first, second), second)) != first {
+		t.Error("expected FirstError to unwrap nested joins")
+	}
+
+	if FirstError(nil) != nil || FirstError(second) != second {
+		t.Error("expected FirstError to return non-joined errors unchanged")
+	}
+}
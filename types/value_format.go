@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidValueFormat indicates that a ValueFormat is not one of the values defined by
+// OCPP 1.6J.
+var ErrInvalidValueFormat = errors.New("invalid value format")
+
+// ValueFormat tells how the value of a SampledValue is encoded, reported in its
+// `format` field. When absent, Raw is assumed.
+//
+// Specification Reference:
+//   - OCPP 1.6J, Section 7.49: ValueFormat
+type ValueFormat string
+
+const (
+	// ValueFormatRaw indicates that the value is a plain decimal number.
+	ValueFormatRaw ValueFormat = "Raw"
+
+	// ValueFormatSignedData indicates that the value is a digitally signed binary data
+	// block, encoded as a hex string.
+	ValueFormatSignedData ValueFormat = "SignedData"
+)
+
+// IsValid returns true if the ValueFormat is one of the values defined by OCPP 1.6J.
+func (v ValueFormat) IsValid() bool {
+	switch v {
+	case ValueFormatRaw, ValueFormatSignedData:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the wire value of the ValueFormat.
+func (v ValueFormat) String() string {
+	return string(v)
+}
+
+// ParseValueFormat converts a wire value into a ValueFormat. Unknown values are rejected
+// with a *ValidationError wrapping ErrInvalidValueFormat.
+func ParseValueFormat(value string) (ValueFormat, error) {
+	return ParseEnum[ValueFormat](value, ErrInvalidValueFormat)
+}
+
+// MarshalText implements encoding.TextMarshaler. It fails with ErrInvalidValueFormat
+// if the value is not recognized.
+func (v ValueFormat) MarshalText() ([]byte, error) {
+	return MarshalEnum(v, ErrInvalidValueFormat)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It fails with
+// ErrInvalidValueFormat if the input is not recognized.
+func (v *ValueFormat) UnmarshalText(text []byte) error {
+	parsed, err := ParseValueFormat(string(text))
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the value as a JSON string.
+func (v ValueFormat) MarshalJSON() ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the value unchanged.
+func (v *ValueFormat) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONEnum(data, v, ParseValueFormat)
+}